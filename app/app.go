@@ -36,6 +36,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -47,15 +51,10 @@ import (
 	// unnamed import of statik for swagger UI support
 	_ "github.com/cosmos/cosmos-sdk/client/docs/statik"
 
-	// "github.com/cosmos/cosmos-sdk/x/slashing"
-	// slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
-	// slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
-
 	hmparams "github.com/maticnetwork/heimdall/app/params"
 	"github.com/maticnetwork/heimdall/helper"
 	hmtypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/common"
-	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
 	hmmodule "github.com/maticnetwork/heimdall/types/module"
 	"github.com/maticnetwork/heimdall/x/chainmanager"
 	chainKeeper "github.com/maticnetwork/heimdall/x/chainmanager/keeper"
@@ -69,15 +68,22 @@ import (
 	"github.com/maticnetwork/heimdall/x/gov"
 	govkeeper "github.com/maticnetwork/heimdall/x/gov/keeper"
 	govtypes "github.com/maticnetwork/heimdall/x/gov/types"
+	paramshandler "github.com/maticnetwork/heimdall/x/params"
+	paramsclient "github.com/maticnetwork/heimdall/x/params/client"
 	"github.com/maticnetwork/heimdall/x/sidechannel"
 	sidechannelkeeper "github.com/maticnetwork/heimdall/x/sidechannel/keeper"
 	sidechanneltypes "github.com/maticnetwork/heimdall/x/sidechannel/types"
+	"github.com/maticnetwork/heimdall/x/slashing"
+	slashingkeeper "github.com/maticnetwork/heimdall/x/slashing/keeper"
+	slashingtypes "github.com/maticnetwork/heimdall/x/slashing/types"
 	"github.com/maticnetwork/heimdall/x/staking"
 	stakingkeeper "github.com/maticnetwork/heimdall/x/staking/keeper"
 	stakingtypes "github.com/maticnetwork/heimdall/x/staking/types"
 	"github.com/maticnetwork/heimdall/x/topup"
 	topupkeeper "github.com/maticnetwork/heimdall/x/topup/keeper"
 	topuptypes "github.com/maticnetwork/heimdall/x/topup/types"
+	upgradehandler "github.com/maticnetwork/heimdall/x/upgrade"
+	upgradeclient "github.com/maticnetwork/heimdall/x/upgrade/client"
 
 	borkeeper "github.com/maticnetwork/heimdall/x/bor/keeper"
 	bortypes "github.com/maticnetwork/heimdall/x/bor/types"
@@ -102,8 +108,14 @@ var (
 		chainmanager.AppModuleBasic{},
 		sidechannel.AppModuleBasic{},
 		staking.AppModuleBasic{},
+		slashing.AppModuleBasic{},
 		params.AppModuleBasic{},
-		gov.AppModuleBasic{},
+		gov.NewAppModuleBasic(
+			paramsclient.ProposalHandler,
+			upgradeclient.ProposalHandler,
+			upgradeclient.CancelProposalHandler,
+		),
+		upgrade.AppModuleBasic{},
 		checkpoint.AppModuleBasic{},
 		topup.AppModuleBasic{},
 		clerk.AppModuleBasic{},
@@ -113,7 +125,7 @@ var (
 	// module account permissions
 	maccPerms = map[string][]string{
 		authtypes.FeeCollectorName: nil,
-		govtypes.ModuleName:        {},
+		govtypes.ModuleName:        {authtypes.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -147,11 +159,13 @@ type HeimdallApp struct {
 	ClerkKeeper       clerkkeeper.Keeper
 	SidechannelKeeper sidechannelkeeper.Keeper
 	StakingKeeper     stakingkeeper.Keeper
+	SlashingKeeper    slashingkeeper.Keeper
 	ParamsKeeper      paramskeeper.Keeper
 	GovKeeper         govkeeper.Keeper
 	CheckpointKeeper  checkpointkeeper.Keeper
 	TopupKeeper       topupkeeper.Keeper
 	BorKeeper         borkeeper.Keeper
+	UpgradeKeeper     upgradekeeper.Keeper
 
 	// side router
 	sideRouter hmtypes.SideRouter
@@ -213,11 +227,12 @@ func NewHeimdallApp(
 		stakingtypes.StoreKey,
 		checkpointtypes.StoreKey,
 		// distrtypes.StoreKey,
-		// slashingtypes.StoreKey,
+		slashingtypes.StoreKey,
 		govtypes.StoreKey,
 		paramstypes.StoreKey,
 		topuptypes.StoreKey,
 		bortypes.StoreKey,
+		upgradetypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 
@@ -274,6 +289,7 @@ func NewHeimdallApp(
 	app.SidechannelKeeper = sidechannelkeeper.NewKeeper(
 		appCodec,
 		keys[sidechanneltypes.StoreKey],
+		app.GetSubspace(sidechanneltypes.ModuleName),
 	)
 
 	app.StakingKeeper = stakingkeeper.NewKeeper(
@@ -284,6 +300,13 @@ func NewHeimdallApp(
 		app.BankKeeper,
 		moduleCommunicator,
 	)
+	app.SlashingKeeper = slashingkeeper.NewKeeper(
+		appCodec,
+		keys[slashingtypes.StoreKey], // target store
+		app.GetSubspace(slashingtypes.ModuleName),
+		app.StakingKeeper,
+		app.ChainKeeper,
+	)
 	app.CheckpointKeeper = checkpointkeeper.NewKeeper(
 		appCodec,
 		keys[checkpointtypes.StoreKey], // target store
@@ -293,8 +316,12 @@ func NewHeimdallApp(
 		moduleCommunicator,
 	)
 
+	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath)
+
 	govRouter := govtypes.NewRouter()
-	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler)
+	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
+		AddRoute(paramproposal.RouterKey, paramshandler.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(upgradetypes.RouterKey, upgradehandler.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
 
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -309,6 +336,7 @@ func NewHeimdallApp(
 	app.ClerkKeeper = clerkkeeper.NewKeeper(
 		appCodec,
 		keys[clerktypes.StoreKey], // target store
+		app.GetSubspace(clerktypes.ModuleName),
 		app.ChainKeeper,
 	)
 
@@ -357,12 +385,14 @@ func NewHeimdallApp(
 		sidechannel.NewAppModule(appCodec, app.SidechannelKeeper),
 		chainmanager.NewAppModule(appCodec, app.ChainKeeper),
 		staking.NewAppModule(appCodec, app.StakingKeeper, &app.caller),
+		slashing.NewAppModule(appCodec, app.SlashingKeeper, &app.caller),
 		clerk.NewAppModule(appCodec, app.ClerkKeeper, &app.caller),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		checkpoint.NewAppModule(appCodec, app.CheckpointKeeper, &app.caller),
 		bor.NewAppModule(appCodec, app.BorKeeper, &app.caller),
 		topup.NewAppModule(appCodec, app.TopupKeeper, &app.caller),
+		upgrade.NewAppModule(app.UpgradeKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -370,18 +400,17 @@ func NewHeimdallApp(
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	app.mm.SetOrderBeginBlockers(
+		upgradetypes.ModuleName,
 		sidechanneltypes.ModuleName,
 		stakingtypes.ModuleName,
+		slashingtypes.ModuleName,
+		topuptypes.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		sidechanneltypes.ModuleName,
 		stakingtypes.ModuleName,
 		govtypes.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(
-		stakingtypes.ModuleName,
-		govtypes.ModuleName,
-	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -394,6 +423,7 @@ func NewHeimdallApp(
 		sidechanneltypes.ModuleName,
 		chainmanagerTypes.ModuleName,
 		stakingtypes.ModuleName,
+		slashingtypes.ModuleName,
 		checkpointtypes.ModuleName,
 		clerktypes.ModuleName,
 		genutiltypes.ModuleName,
@@ -441,6 +471,9 @@ func NewHeimdallApp(
 	// TODO : uncomment later
 	// app.sm.RegisterStoreDecoders()
 
+	// register the upgrade handlers and the store loader of a pending upgrade
+	app.registerUpgradeHandlers()
+
 	// initialize stores
 	app.MountKVStores(keys)
 	app.MountTransientStores(tkeys)
@@ -495,84 +528,12 @@ func (app *HeimdallApp) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker application updates every begin block
 func (app *HeimdallApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
-	if len(req.Header.GetProposerAddress()) != 0 {
-		proposerAddress, _ := sdk.AccAddressFromHex(string(req.Header.GetProposerAddress()))
-		app.ChainKeeper.SetBlockProposer(
-			ctx,
-			proposerAddress,
-		)
-	}
-
 	return app.mm.BeginBlock(ctx, req)
 }
 
 // EndBlocker application updates every end block
 func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-
-	if proposer, ok := app.ChainKeeper.GetBlockProposer(ctx); ok {
-		moduleAccount := app.AccountKeeper.GetModuleAccount(ctx, authtypes.FeeCollectorName)
-		amount := app.BankKeeper.GetBalance(ctx, moduleAccount.GetAddress(), stakingtypes.FeeToken)
-		if !amount.IsZero() {
-			coins := sdk.Coins{sdk.Coin{Denom: stakingtypes.FeeToken, Amount: sdk.NewInt(1000)}} //check amount
-			if err := app.BankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, proposer, coins); err != nil {
-				logger.Error("EndBlocker | SendCoinsFromModuleToAccount", "Error", err)
-			}
-		}
-
-		// remove block proposer
-		app.ChainKeeper.RemoveBlockProposer(ctx)
-	}
-	var tmValUpdates []abci.ValidatorUpdate
-
-	// --- Start update to new validators
-	currentValidatorSet := app.StakingKeeper.GetValidatorSet(ctx)
-	allValidators := app.StakingKeeper.GetAllValidators(ctx)
-	ackCount := app.CheckpointKeeper.GetACKCount(ctx)
-
-	// get validator updates
-	setUpdates := helper.GetUpdatedValidators(
-		currentValidatorSet, // pointer to current validator set -- UpdateValidators will modify it
-		allValidators,       // All validators
-		ackCount,            // ack count
-	)
-
-	if len(setUpdates) > 0 {
-		// create new validator set
-		if err := currentValidatorSet.UpdateWithChangeSet(setUpdates); err != nil {
-			// return with nothing
-			logger.Error("Unable to update current validator set", "Error", err)
-			return abci.ResponseEndBlock{}
-		}
-
-		// increment proposer priority
-		currentValidatorSet.IncrementProposerPriority(1)
-
-		// validator set change
-		logger.Debug("[ENDBLOCK] Updated current validator set", "proposer", currentValidatorSet.GetProposer())
-
-		// save set in store
-		if err := app.StakingKeeper.UpdateValidatorSetInStore(ctx, currentValidatorSet); err != nil {
-			// return with nothing
-			logger.Error("Unable to update current validator set in state", "Error", err)
-			return abci.ResponseEndBlock{}
-		}
-
-		// convert updates from map to array
-		for _, v := range setUpdates {
-			tmValUpdates = append(tmValUpdates, abci.ValidatorUpdate{
-				Power:  int64(v.VotingPower),
-				PubKey: hmCommonTypes.NewPubKeyFromHex(v.PubKey).TMProtoCryptoPubKey(),
-			})
-		}
-	}
-
-	// end block
-	app.mm.EndBlock(ctx, req)
-
-	// send validator updates to peppermint
-	return abci.ResponseEndBlock{
-		ValidatorUpdates: tmValUpdates,
-	}
+	return app.mm.EndBlock(ctx, req)
 }
 
 // InitChainer application update at chain initialization
@@ -776,6 +737,7 @@ func initParamsKeeper(appCodec codec.BinaryMarshaler, legacyAmino *codec.LegacyA
 	paramsKeeper.Subspace(authtypes.ModuleName)
 	paramsKeeper.Subspace(banktypes.ModuleName)
 	paramsKeeper.Subspace(stakingtypes.ModuleName)
+	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(chainmanagerTypes.ModuleName)
 	paramsKeeper.Subspace(sidechanneltypes.ModuleName)
 	paramsKeeper.Subspace(checkpointtypes.ModuleName)
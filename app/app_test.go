@@ -0,0 +1,59 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	sidechanneltypes "github.com/maticnetwork/heimdall/x/sidechannel/types"
+)
+
+func TestEndBlockerPrunesTxVotes(t *testing.T) {
+	happ := app.Setup(false)
+
+	height := sidechanneltypes.DefaultTxVotesRetention + 10
+	ctx := happ.BaseApp.NewContext(false, tmproto.Header{Height: int64(height)})
+
+	oldHash := []byte("old-tx-hash")
+	recentHash := []byte("recent-tx-hash")
+	require.NoError(t, happ.SidechannelKeeper.SetTxVotes(ctx, oldHash, &sidechanneltypes.SideTxVotes{Height: 5}))
+	require.NoError(t, happ.SidechannelKeeper.SetTxVotes(ctx, recentHash, &sidechanneltypes.SideTxVotes{Height: height - 1}))
+
+	happ.EndBlocker(ctx, abci.RequestEndBlock{Height: int64(height)})
+
+	_, found := happ.SidechannelKeeper.GetTxVotes(ctx, oldHash)
+	require.False(t, found, "votes past retention should be pruned")
+
+	_, found = happ.SidechannelKeeper.GetTxVotes(ctx, recentHash)
+	require.True(t, found, "votes within retention should be kept")
+}
+
+func TestEndBlockerPrunesTxVotesWithRetentionParam(t *testing.T) {
+	happ := app.Setup(false)
+
+	height := uint64(1000)
+	ctx := happ.BaseApp.NewContext(false, tmproto.Header{Height: int64(height)})
+
+	params := happ.SidechannelKeeper.GetParams(ctx)
+	params.TxVotesRetention = 100
+	happ.SidechannelKeeper.SetParams(ctx, params)
+
+	oldHash := []byte("old-tx-hash")
+	recentHash := []byte("recent-tx-hash")
+	require.NoError(t, happ.SidechannelKeeper.SetTxVotes(ctx, oldHash, &sidechanneltypes.SideTxVotes{Height: height - 200}))
+	require.NoError(t, happ.SidechannelKeeper.SetTxVotes(ctx, recentHash, &sidechanneltypes.SideTxVotes{Height: height - 50}))
+
+	happ.EndBlocker(ctx, abci.RequestEndBlock{Height: int64(height)})
+
+	_, found := happ.SidechannelKeeper.GetTxVotes(ctx, oldHash)
+	require.False(t, found, "votes past retention should be pruned")
+
+	_, found = happ.SidechannelKeeper.GetTxVotes(ctx, recentHash)
+	require.True(t, found, "votes within retention should be kept")
+
+	params.TxVotesRetention = 0
+	require.Error(t, params.Validate())
+}
@@ -11,11 +11,17 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/maticnetwork/heimdall/types"
+	sidechanneltypes "github.com/maticnetwork/heimdall/x/sidechannel/types"
 )
 
+// errStopIteration stops iterating side-txs in the store
+var errStopIteration = errors.New("stop iteration")
+
 // PostDeliverTxHandler runs after deliver tx handler
 func (app *HeimdallApp) PostDeliverTxHandler(ctx sdk.Context, tx sdk.Tx, result *sdk.Result) {
 	height := ctx.BlockHeader().Height
@@ -67,89 +73,101 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 	// get empty events
 	events := sdk.EmptyEvents()
 
+	// sidechannel params, route params of a tx can override threshold and voting window
+	params := app.SidechannelKeeper.GetParams(ctx)
+
 	for _, sideTxResult := range req.SideTxResults {
 		txHash := sideTxResult.TxHash
-		// get tx from the store
-		tx := app.SidechannelKeeper.GetTx(ctx, targetHeight, txHash)
-		if tx != nil {
-			// remove tx to avoid duplicate execution
-			app.SidechannelKeeper.RemoveTx(ctx, targetHeight, txHash)
-
-			usedValidator := make(map[int]bool)
-
-			// signed power
-			signedPower := make(map[tmprototypes.SideTxResultType]int64)
-			signedPower[tmprototypes.SideTxResultType_YES] = 0
-			signedPower[tmprototypes.SideTxResultType_SKIP] = 0
-			signedPower[tmprototypes.SideTxResultType_NO] = 0
-
-			for _, sigObj := range sideTxResult.Sigs {
-				// get validator by sig address
-				if i := getValidatorIndexByAddress(sigObj.Address, validators); i != -1 {
-					// check if validator already voted on tx
-					if _, ok := usedValidator[i]; !ok {
-						signedPower[sigObj.Result] = signedPower[sigObj.Result] + validators[i].Power
-						usedValidator[i] = true
-					}
-				}
-			}
 
-			var result *sdk.Result
-			var rerr error
-
-			// check vote majority
-			if signedPower[tmprototypes.SideTxResultType_YES] >= (totalPower*2/3 + 1) {
-				// approved
-				logger.Debug("[sidechannel] Approved side-tx", "txHash", hex.EncodeToString(tx.Hash()))
-
-				// execute tx with `yes`
-				result, rerr = app.runTx(ctx, tx, tmprototypes.SideTxResultType_YES)
-			} else if signedPower[tmprototypes.SideTxResultType_NO] >= (totalPower*2/3 + 1) {
-				// rejected
-				logger.Debug("[sidechannel] Rejected side-tx", "txHash", hex.EncodeToString(tx.Hash()))
+		// votes accumulated on the tx in its voting window
+		votes, found := app.SidechannelKeeper.GetPendingTxVotes(ctx, txHash)
+		if !found {
+			votes = &sidechanneltypes.SideTxVotes{
+				Height: targetHeight,
+				Votes:  []sidechanneltypes.SideTxVote{},
+			}
+		}
 
-				// execute tx with `no`
-				result, rerr = app.runTx(ctx, tx, tmprototypes.SideTxResultType_NO)
-			} else {
-				// skipped
-				logger.Debug("[sidechannel] Skipped side-tx", "txHash", hex.EncodeToString(tx.Hash()))
+		// get tx from the store
+		tx := app.SidechannelKeeper.GetTx(ctx, votes.Height, txHash)
+		if tx == nil {
+			continue
+		}
 
-				// execute tx with `skip`
-				result, rerr = app.runTx(ctx, tx, tmprototypes.SideTxResultType_SKIP)
+		for _, sigObj := range sideTxResult.Sigs {
+			// get validator by sig address
+			if i := getValidatorIndexByAddress(sigObj.Address, validators); i != -1 {
+				// check if validator already voted on tx
+				addVote(votes, sigObj.Address, validators[i].Power, sigObj.Result)
 			}
-
-			if rerr != nil {
-				logger.Error("[sidechannel] Error while processing side-tx in begin side block",
-					"txHash", hex.EncodeToString(tx.Hash()),
-					"totalPower", totalPower,
-					"yesVotes", signedPower[tmprototypes.SideTxResultType_YES],
-					"noVotes", signedPower[tmprototypes.SideTxResultType_NO],
-					"skipVotes", signedPower[tmprototypes.SideTxResultType_SKIP],
-					"err", rerr,
-				)
-			} else {
-				// add events
-				events = events.AppendEvents(result.GetEvents())
+		}
+		votes.TotalPower = totalPower
+
+		// check vote majority
+		routeParams := params.ParamsForRoute(app.sideTxRoute(tx))
+		if routeParams.HasMajority(votes.YesPower, totalPower) {
+			// approved
+			logger.Debug("[sidechannel] Approved side-tx", "txHash", hex.EncodeToString(tx.Hash()))
+			votes.Result = tmprototypes.SideTxResultType_YES
+		} else if routeParams.HasMajority(votes.NoPower, totalPower) {
+			// rejected
+			logger.Debug("[sidechannel] Rejected side-tx", "txHash", hex.EncodeToString(tx.Hash()))
+			votes.Result = tmprototypes.SideTxResultType_NO
+		} else if isVotingWindowOver(votes.Height, routeParams, targetHeight) {
+			// skipped
+			logger.Debug("[sidechannel] Skipped side-tx", "txHash", hex.EncodeToString(tx.Hash()))
+			votes.Result = tmprototypes.SideTxResultType_SKIP
+		} else {
+			// wait for more votes
+			logger.Debug("[sidechannel] Pending side-tx", "txHash", hex.EncodeToString(tx.Hash()),
+				"height", votes.Height, "votingWindow", routeParams.VotingWindow)
+			if err := app.SidechannelKeeper.SetPendingTxVotes(ctx, txHash, votes); err != nil {
+				logger.Error("[sidechannel] Error while storing pending side-tx votes", "txHash", hex.EncodeToString(tx.Hash()), "error", err)
 			}
+			continue
 		}
+
+		// execute tx with the result
+		events = events.AppendEvents(app.processSideTx(ctx, tx, votes))
+	}
+
+	// skip pending txs with voting window over before exiting
+	type pendingTx struct {
+		height uint64
+		tx     tmtypes.Tx
 	}
+	var expiredTxs []pendingTx
+	app.SidechannelKeeper.IterateTxsAndApplyFn(ctx, func(txHeight uint64, tx tmtypes.Tx) error {
+		// txs are sorted by height
+		if txHeight > targetHeight {
+			return errStopIteration
+		}
+
+		if isVotingWindowOver(txHeight, params.ParamsForRoute(app.sideTxRoute(tx)), targetHeight) {
+			expiredTxs = append(expiredTxs, pendingTx{height: txHeight, tx: tx})
+		}
+		return nil
+	})
 
-	// remove all pending txs before exiting
-	txs := app.SidechannelKeeper.GetTxs(ctx, targetHeight)
-	for _, tx := range txs {
-		app.SidechannelKeeper.RemoveTx(ctx, targetHeight, tx.Hash())
+	for _, expired := range expiredTxs {
+		tx := expired.tx
 
 		// skipped
 		logger.Debug("[sidechannel] Skipped side-tx", "txHash", hex.EncodeToString(tx.Hash()))
 
-		// execute tx with `skip`
-		result, serr := app.runTx(ctx, tx, tmprototypes.SideTxResultType_SKIP)
-		if serr != nil {
-			logger.Error("[sidechannel] Error while processing skipped side-tx in beginside block", "txHash", hex.EncodeToString(tx.Hash()))
-		} else {
-			// add events
-			events = events.AppendEvents(result.GetEvents())
+		// record side-tx with the votes it got, if any
+		votes, found := app.SidechannelKeeper.GetPendingTxVotes(ctx, tx.Hash())
+		if !found {
+			votes = &sidechanneltypes.SideTxVotes{
+				Height: expired.height,
+				Votes:  []sidechanneltypes.SideTxVote{},
+			}
 		}
+		votes.TotalPower = totalPower
+		votes.Result = tmprototypes.SideTxResultType_SKIP
+
+		// execute tx with `skip`
+		events = events.AppendEvents(app.processSideTx(ctx, tx, votes))
 	}
 
 	// set event to response
@@ -163,7 +181,7 @@ func (app *HeimdallApp) DeliverSideTxHandler(ctx sdk.Context, tx sdk.Tx, req abc
 	var code uint32
 	var codespace string
 
-	result := tmprototypes.SideTxResultType_SKIP
+	results := make([]tmprototypes.SideTxResultType, 0, len(tx.GetMsgs()))
 	data := make([]byte, 0)
 
 	for _, msg := range tx.GetMsgs() {
@@ -186,14 +204,14 @@ func (app *HeimdallApp) DeliverSideTxHandler(ctx sdk.Context, tx sdk.Tx, req abc
 				code = msgResult.Code
 				codespace = msgResult.Codespace
 				// skip side-tx if result is error
-				result = tmprototypes.SideTxResultType_SKIP
+				results = []tmprototypes.SideTxResultType{tmprototypes.SideTxResultType_SKIP}
 				break
 			}
 
 			// Each message result's Data must be length prefixed in order to separate
 			// each result.
 			data = append(data, msgResult.Data...)
-			result = msgResult.Result
+			results = append(results, msgResult.Result)
 
 			// msg result is empty, get side sign bytes and append into data
 			if len(msgResult.Data) == 0 {
@@ -206,7 +224,7 @@ func (app *HeimdallApp) DeliverSideTxHandler(ctx sdk.Context, tx sdk.Tx, req abc
 		Code:      uint32(code),
 		Codespace: string(codespace),
 		Data:      data,
-		Result:    result,
+		Result:    aggregateSideTxResult(results),
 	}
 }
 
@@ -214,6 +232,55 @@ func (app *HeimdallApp) DeliverSideTxHandler(ctx sdk.Context, tx sdk.Tx, req abc
 // Internal functions
 //
 
+// processSideTx removes the side-tx from the store, records its votes and
+// executes it with the vote result, returning events of the execution
+func (app *HeimdallApp) processSideTx(ctx sdk.Context, tx tmtypes.Tx, votes *sidechanneltypes.SideTxVotes) sdk.Events {
+	logger := app.Logger()
+
+	// remove tx to avoid duplicate execution
+	app.SidechannelKeeper.RemoveTx(ctx, votes.Height, tx.Hash())
+	app.SidechannelKeeper.RemovePendingTxVotes(ctx, tx.Hash())
+
+	// record vote breakdown
+	votes.TxHash = tmbytes.HexBytes(tx.Hash()).String()
+	if err := app.SidechannelKeeper.SetTxVotes(ctx, tx.Hash(), votes); err != nil {
+		logger.Error("[sidechannel] Error while storing side-tx votes", "txHash", hex.EncodeToString(tx.Hash()), "error", err)
+	}
+
+	result, err := app.runTx(ctx, tx, votes.Result)
+	if err != nil {
+		logger.Error("[sidechannel] Error while processing side-tx in begin side block",
+			"txHash", hex.EncodeToString(tx.Hash()),
+			"result", votes.Result,
+			"totalPower", votes.TotalPower,
+			"yesVotes", votes.YesPower,
+			"noVotes", votes.NoPower,
+			"skipVotes", votes.SkipPower,
+			"err", err,
+		)
+		return sdk.EmptyEvents()
+	}
+
+	// add events
+	return sdk.EmptyEvents().AppendEvents(result.GetEvents())
+}
+
+// sideTxRoute returns the route of the first side msg of the tx
+func (app *HeimdallApp) sideTxRoute(txBytes tmtypes.Tx) string {
+	tx, err := app.txDecoder(txBytes)
+	if err != nil {
+		return ""
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := IsSideMsg(msg); ok {
+			return msg.Route()
+		}
+	}
+
+	return ""
+}
+
 func (app *HeimdallApp) runTx(ctx sdk.Context, txBytes []byte, sideTxResult tmprototypes.SideTxResultType) (result *sdk.Result, err error) {
 	// decode tx
 	tx, err := app.txDecoder(txBytes)
@@ -275,10 +342,11 @@ func (app *HeimdallApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, sideTxResult tm
 			})
 
 			// msg events
-			events = events.AppendEvents(msgResult.GetEvents())
+			msgEvents := msgResult.GetEvents()
+			events = events.AppendEvents(msgEvents)
 
 			// add msg logs
-			msgLogs = append(msgLogs, sdk.NewABCIMessageLog(uint32(i), msgResult.Log, events))
+			msgLogs = append(msgLogs, sdk.NewABCIMessageLog(uint32(i), msgResult.Log, msgEvents))
 		}
 	}
 
@@ -307,6 +375,26 @@ func (app *HeimdallApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Con
 // utils
 //
 
+// aggregateSideTxResult returns the vote on a side-tx from the results of its msgs,
+// the tx is approved only if all its msgs are approved
+func aggregateSideTxResult(results []tmprototypes.SideTxResultType) tmprototypes.SideTxResultType {
+	if len(results) == 0 {
+		return tmprototypes.SideTxResultType_SKIP
+	}
+
+	result := tmprototypes.SideTxResultType_YES
+	for _, msgResult := range results {
+		switch msgResult {
+		case tmprototypes.SideTxResultType_SKIP:
+			return tmprototypes.SideTxResultType_SKIP
+		case tmprototypes.SideTxResultType_NO:
+			result = tmprototypes.SideTxResultType_NO
+		}
+	}
+
+	return result
+}
+
 // IsSideMsg is side msg
 func IsSideMsg(msg sdk.Msg) (types.SideTxMsg, bool) {
 	if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
@@ -320,6 +408,36 @@ func IsSideMsg(msg sdk.Msg) (types.SideTxMsg, bool) {
 	return nil, false
 }
 
+// addVote adds the vote of the validator unless it already voted on the tx
+func addVote(votes *sidechanneltypes.SideTxVotes, address []byte, power int64, result tmprototypes.SideTxResultType) {
+	voter := tmbytes.HexBytes(address).String()
+	for _, vote := range votes.Votes {
+		if vote.Address == voter {
+			return
+		}
+	}
+
+	votes.Votes = append(votes.Votes, sidechanneltypes.SideTxVote{
+		Address: voter,
+		Power:   power,
+		Result:  result,
+	})
+
+	switch result {
+	case tmprototypes.SideTxResultType_YES:
+		votes.YesPower += power
+	case tmprototypes.SideTxResultType_NO:
+		votes.NoPower += power
+	default:
+		votes.SkipPower += power
+	}
+}
+
+// isVotingWindowOver checks if target height is the last height of the voting window of the tx
+func isVotingWindowOver(txHeight uint64, routeParams sidechanneltypes.RouteParams, targetHeight uint64) bool {
+	return txHeight+routeParams.VotingWindow <= targetHeight+1
+}
+
 func getValidatorIndexByAddress(address []byte, validators []*abci.Validator) int {
 	for i, v := range validators {
 		if bytes.Equal(address, v.Address) {
@@ -8,10 +8,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/store"
 	testdata "github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -25,6 +27,7 @@ import (
 	hmtypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/x/gov/types"
 	sidechannelkeeper "github.com/maticnetwork/heimdall/x/sidechannel/keeper"
+	sidechanneltypes "github.com/maticnetwork/heimdall/x/sidechannel/types"
 )
 
 var testTxStateData1 = []byte("test-tx-state1")
@@ -272,6 +275,52 @@ func (suite *SideTxProcessorTestSuite) TestDeliverSideTxHandler() {
 
 		require.Equal(t, tmproto.SideTxResultType_SKIP, res.GetResult(), "It should return `skip` vote due to panic")
 	})
+
+	t.Run("MultiMsg", func(t *testing.T) {
+		encodingConfig := suite.encodingConfig
+
+		txBuilder := encodingConfig.NewTxBuilder()
+		err := txBuilder.SetMsgs(
+			hmtestdata.NewServiceSideMsgCreateDog(&hmtestdata.SideMsgCreateDog{Dog: &hmtestdata.Dog{Name: "Spot"}}),
+			hmtestdata.NewServiceSideMsgCreateDog(&hmtestdata.SideMsgCreateDog{Dog: &hmtestdata.Dog{Name: "Rex"}}),
+		)
+		require.Nil(t, err, "It should be no error while setting msgs")
+		multiTxBytes, err := encodingConfig.TxEncoder()(txBuilder.GetTx())
+		require.Nil(t, err, "It should be no error while encoding tx")
+		multiTx, err := encodingConfig.TxDecoder()(multiTxBytes)
+		require.Nil(t, err, "It should throw no error while decoding tx")
+
+		// votes per dog name
+		votes := map[string]tmproto.SideTxResultType{}
+		router := hmtypes.NewSideRouter()
+		router.AddRoute(msg.Route(), &hmtypes.SideHandlers{
+			SideTxHandler: func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+				sideMsg := msg.(sdk.ServiceMsg).Request.(*hmtestdata.SideMsgCreateDog)
+				return abci.ResponseDeliverSideTx{
+					Result: votes[sideMsg.Dog.Name],
+				}
+			},
+			PostTxHandler: func(ctx sdk.Context, msg sdk.Msg, sideTxResult tmproto.SideTxResultType) (*sdk.Result, error) {
+				return &sdk.Result{}, nil
+			},
+		})
+		happ.SetSideRouter(router)
+
+		for _, tc := range []struct {
+			spot, rex, expected tmproto.SideTxResultType
+		}{
+			{tmproto.SideTxResultType_YES, tmproto.SideTxResultType_YES, tmproto.SideTxResultType_YES},
+			{tmproto.SideTxResultType_NO, tmproto.SideTxResultType_YES, tmproto.SideTxResultType_NO},
+			{tmproto.SideTxResultType_YES, tmproto.SideTxResultType_NO, tmproto.SideTxResultType_NO},
+			{tmproto.SideTxResultType_NO, tmproto.SideTxResultType_SKIP, tmproto.SideTxResultType_SKIP},
+		} {
+			votes["Spot"], votes["Rex"] = tc.spot, tc.rex
+			res := happ.DeliverSideTxHandler(ctx, multiTx, abci.RequestDeliverSideTx{
+				Tx: multiTxBytes,
+			})
+			require.Equal(t, tc.expected, res.GetResult(), "Result should be %s with votes %s and %s", tc.expected, tc.spot, tc.rex)
+		}
+	})
 }
 
 func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
@@ -525,6 +574,187 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 	})
 }
 
+func (suite *SideTxProcessorTestSuite) TestBeginSideBlockerRouteThreshold() {
+	t, keeper, ctx, happ := suite.T(), suite.keeper, suite.ctx, suite.happ
+
+	// get tx and txbytes
+	txBytes, tx := suite.getTx()
+	msg := tx.GetMsgs()[0]
+	txHash := tmtypes.Tx(txBytes).Hash()
+
+	addr1 := []byte("hello-1")
+	addr2 := []byte("hello-2")
+	addr3 := []byte("hello-3")
+	addr4 := []byte("hello-4")
+	validators := []*abci.Validator{
+		{Address: addr1, Power: 10},
+		{Address: addr2, Power: 20},
+		{Address: addr3, Power: 30},
+		{Address: addr4, Power: 40},
+	}
+
+	// setup router and handler
+	var results []tmproto.SideTxResultType
+	router := hmtypes.NewSideRouter()
+	router.AddRoute(msg.Route(), &hmtypes.SideHandlers{
+		SideTxHandler: func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+			return abci.ResponseDeliverSideTx{}
+		},
+		PostTxHandler: func(ctx sdk.Context, msg sdk.Msg, sideTxResult tmproto.SideTxResultType) (*sdk.Result, error) {
+			results = append(results, sideTxResult)
+			return &sdk.Result{}, nil
+		},
+	})
+	happ.SetSideRouter(router)
+
+	beginSideBlock := func(height uint64, sigs []tmproto.SideTxResponse) {
+		ctx = ctx.WithBlockHeight(int64(height))
+		require.NoError(t, keeper.SetValidators(ctx, height, validators))
+		keeper.SetTx(ctx, height-2, txBytes)
+
+		happ.BeginSideBlocker(ctx, abci.RequestBeginSideBlock{
+			SideTxResults: []tmproto.SideTxResponses{{TxHash: txHash, Sigs: sigs}},
+		})
+		require.Nil(t, keeper.GetTx(ctx, height-2, txHash), "Tx should not be present in store after begin side block")
+	}
+
+	// 60% of the power
+	sigs := []tmproto.SideTxResponse{
+		{Result: tmproto.SideTxResultType_YES, Address: addr1},
+		{Result: tmproto.SideTxResultType_YES, Address: addr2},
+		{Result: tmproto.SideTxResultType_YES, Address: addr3},
+	}
+
+	t.Run("DefaultThreshold", func(t *testing.T) {
+		results = nil
+		beginSideBlock(20, sigs)
+		require.Equal(t, []tmproto.SideTxResultType{tmproto.SideTxResultType_SKIP}, results)
+	})
+
+	t.Run("RouteThreshold", func(t *testing.T) {
+		results = nil
+		keeper.SetParams(ctx, sidechanneltypes.NewParams(true, sidechanneltypes.DefaultThreshold, 1, []sidechanneltypes.RouteParams{
+			{Route: msg.Route(), Threshold: sdk.NewDecWithPrec(5, 1), VotingWindow: 1},
+		}, sidechanneltypes.DefaultTxVotesRetention))
+
+		beginSideBlock(21, sigs)
+		require.Equal(t, []tmproto.SideTxResultType{tmproto.SideTxResultType_YES}, results)
+
+		votes, found := keeper.GetTxVotes(ctx, txHash)
+		require.True(t, found)
+		require.Equal(t, uint64(19), votes.Height)
+		require.Equal(t, int64(60), votes.YesPower)
+		require.Equal(t, int64(100), votes.TotalPower)
+		require.Len(t, votes.Votes, 3)
+	})
+}
+
+func (suite *SideTxProcessorTestSuite) TestBeginSideBlockerVotingWindow() {
+	t, keeper, ctx, happ := suite.T(), suite.keeper, suite.ctx, suite.happ
+
+	// get tx and txbytes
+	txBytes, tx := suite.getTx()
+	msg := tx.GetMsgs()[0]
+	txHash := tmtypes.Tx(txBytes).Hash()
+
+	addr1 := []byte("hello-1")
+	addr2 := []byte("hello-2")
+	addr3 := []byte("hello-3")
+	addr4 := []byte("hello-4")
+	validators := []*abci.Validator{
+		{Address: addr1, Power: 10},
+		{Address: addr2, Power: 20},
+		{Address: addr3, Power: 30},
+		{Address: addr4, Power: 40},
+	}
+
+	// route votes are accumulated for 3 blocks
+	keeper.SetParams(ctx, sidechanneltypes.NewParams(true, sidechanneltypes.DefaultThreshold, 1, []sidechanneltypes.RouteParams{
+		{Route: msg.Route(), Threshold: sidechanneltypes.DefaultThreshold, VotingWindow: 3},
+	}, sidechanneltypes.DefaultTxVotesRetention))
+
+	// setup router and handler
+	var results []tmproto.SideTxResultType
+	router := hmtypes.NewSideRouter()
+	router.AddRoute(msg.Route(), &hmtypes.SideHandlers{
+		SideTxHandler: func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+			return abci.ResponseDeliverSideTx{}
+		},
+		PostTxHandler: func(ctx sdk.Context, msg sdk.Msg, sideTxResult tmproto.SideTxResultType) (*sdk.Result, error) {
+			results = append(results, sideTxResult)
+			return &sdk.Result{}, nil
+		},
+	})
+	happ.SetSideRouter(router)
+
+	beginSideBlock := func(height uint64, sigs []tmproto.SideTxResponse) {
+		ctx = ctx.WithBlockHeight(int64(height))
+		require.NoError(t, keeper.SetValidators(ctx, height, validators))
+
+		req := abci.RequestBeginSideBlock{}
+		if len(sigs) > 0 {
+			req.SideTxResults = []tmproto.SideTxResponses{{TxHash: txHash, Sigs: sigs}}
+		}
+		happ.BeginSideBlocker(ctx, req)
+	}
+
+	t.Run("Approved", func(t *testing.T) {
+		keeper.SetTx(ctx, 18, txBytes)
+
+		// not enough power in the first block
+		beginSideBlock(20, []tmproto.SideTxResponse{
+			{Result: tmproto.SideTxResultType_YES, Address: addr1},
+			{Result: tmproto.SideTxResultType_YES, Address: addr2},
+		})
+		require.Empty(t, results)
+		require.NotNil(t, keeper.GetTx(ctx, 18, txHash), "Tx should be pending in its voting window")
+
+		votes, found := keeper.GetPendingTxVotes(ctx, txHash)
+		require.True(t, found)
+		require.Equal(t, int64(30), votes.YesPower)
+
+		// votes accumulate, repeated votes are ignored
+		beginSideBlock(21, []tmproto.SideTxResponse{
+			{Result: tmproto.SideTxResultType_YES, Address: addr1},
+			{Result: tmproto.SideTxResultType_YES, Address: addr4},
+		})
+		require.Equal(t, []tmproto.SideTxResultType{tmproto.SideTxResultType_YES}, results)
+		require.Nil(t, keeper.GetTx(ctx, 18, txHash), "Tx should not be present in store after approval")
+
+		_, found = keeper.GetPendingTxVotes(ctx, txHash)
+		require.False(t, found)
+
+		votes, found = keeper.GetTxVotes(ctx, txHash)
+		require.True(t, found)
+		require.Equal(t, uint64(18), votes.Height)
+		require.Equal(t, int64(70), votes.YesPower)
+		require.Equal(t, int64(100), votes.TotalPower)
+		require.Len(t, votes.Votes, 3)
+	})
+
+	t.Run("Skipped", func(t *testing.T) {
+		results = nil
+		keeper.SetTx(ctx, 19, txBytes)
+
+		beginSideBlock(21, []tmproto.SideTxResponse{
+			{Result: tmproto.SideTxResultType_NO, Address: addr3},
+		})
+		beginSideBlock(22, nil)
+		require.Empty(t, results)
+		require.NotNil(t, keeper.GetTx(ctx, 19, txHash), "Tx should be pending in its voting window")
+
+		// skipped at the end of the voting window
+		beginSideBlock(23, nil)
+		require.Equal(t, []tmproto.SideTxResultType{tmproto.SideTxResultType_SKIP}, results)
+		require.Nil(t, keeper.GetTx(ctx, 19, txHash), "Tx should not be present in store after voting window")
+
+		votes, found := keeper.GetTxVotes(ctx, txHash)
+		require.True(t, found)
+		require.Equal(t, tmproto.SideTxResultType_SKIP, votes.Result)
+		require.Equal(t, int64(30), votes.NoPower)
+	})
+}
+
 //
 // Internal setup keeper
 //
@@ -532,11 +762,19 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 func setupKeeper(t *testing.T) (sdk.Context, sidechannelkeeper.Keeper) {
 	t.Helper()
 	key := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
 	err := ms.LoadLatestVersion()
 	require.NoError(t, err)
 	ctx := sdk.NewContext(ms, tmproto.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
-	return ctx, sidechannelkeeper.NewKeeper(types.ModuleCdc, key)
+
+	subspace := paramstypes.NewSubspace(types.ModuleCdc, codec.NewLegacyAmino(), paramsKey, paramsTKey, sidechanneltypes.ModuleName)
+	k := sidechannelkeeper.NewKeeper(types.ModuleCdc, key, subspace)
+	k.SetParams(ctx, sidechanneltypes.DefaultParams())
+	return ctx, k
 }
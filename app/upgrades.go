@@ -0,0 +1,82 @@
+package app
+
+import (
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	clerktypes "github.com/maticnetwork/heimdall/x/clerk/types"
+	sidechanneltypes "github.com/maticnetwork/heimdall/x/sidechannel/types"
+)
+
+// UpgradeNameV030 is the upgrade setting the parameters and the state of the modules
+// which got them after genesis
+const UpgradeNameV030 = "v0.3.0"
+
+// Upgrade defines a software upgrade known to this binary. The Name must match
+// the name of the plan passed through a SoftwareUpgradeProposal; the Handler is
+// executed at the plan height and StoreUpgrades lists the stores added, renamed
+// or deleted by the upgrade.
+type Upgrade struct {
+	Name          string
+	Handler       upgradetypes.UpgradeHandler
+	StoreUpgrades storetypes.StoreUpgrades
+}
+
+// Upgrades lists all the upgrades handled by this binary, in the order they
+// were released.
+func (app *HeimdallApp) Upgrades() []Upgrade {
+	return []Upgrade{
+		{
+			Name:    UpgradeNameV030,
+			Handler: app.upgradeV030,
+		},
+	}
+}
+
+// upgradeV030 sets the parameters added to existing modules to their defaults,
+// reading them panics on chains started before, moves the next clerk record id
+// past the records stored before it was tracked and switches the dividend account
+// leaves to the root chain claimFee encoding
+func (app *HeimdallApp) upgradeV030(ctx sdk.Context, plan upgradetypes.Plan) {
+	app.SidechannelKeeper.SetParams(ctx, sidechanneltypes.DefaultParams())
+	app.ClerkKeeper.SetParams(ctx, clerktypes.DefaultParams())
+	app.BorKeeper.SetMissingParams(ctx)
+	app.TopupKeeper.SetABIAccountLeaves(ctx)
+
+	if err := app.ClerkKeeper.AdvanceNextRecordID(ctx); err != nil {
+		panic(fmt.Sprintf("failed to set the next clerk record id: %s", err))
+	}
+}
+
+// registerUpgradeHandlers registers the handlers of all known upgrades with the
+// upgrade keeper and, when the node was halted for one of them, sets the store
+// loader applying its store migrations.
+func (app *HeimdallApp) registerUpgradeHandlers() {
+	upgrades := app.Upgrades()
+	if len(upgrades) == 0 {
+		return
+	}
+
+	for _, u := range upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(u.Name, u.Handler)
+	}
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk: %s", err))
+	}
+
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	for i := range upgrades {
+		if upgrades[i].Name == upgradeInfo.Name {
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &upgrades[i].StoreUpgrades))
+			return
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	hmCommon "github.com/maticnetwork/heimdall/types/common"
+	bortypes "github.com/maticnetwork/heimdall/x/bor/types"
+	clerkkeeper "github.com/maticnetwork/heimdall/x/clerk/keeper"
+	clerktypes "github.com/maticnetwork/heimdall/x/clerk/types"
+	sidechanneltypes "github.com/maticnetwork/heimdall/x/sidechannel/types"
+	topupkeeper "github.com/maticnetwork/heimdall/x/topup/keeper"
+	topuptypes "github.com/maticnetwork/heimdall/x/topup/types"
+)
+
+// deleteParams removes the params of a module, as on a chain started before they existed
+func deleteParams(t *testing.T, happ *app.HeimdallApp, ctx sdk.Context, moduleName string) {
+	store := prefix.NewStore(ctx.KVStore(happ.GetKey(paramstypes.StoreKey)), []byte(moduleName+"/"))
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	require.NotEmpty(t, keys)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func TestUpgradeV030SetsParams(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	deleteParams(t, happ, ctx, sidechanneltypes.ModuleName)
+	deleteParams(t, happ, ctx, clerktypes.ModuleName)
+	require.Panics(t, func() { happ.SidechannelKeeper.GetParams(ctx) })
+	require.Panics(t, func() { happ.ClerkKeeper.GetParams(ctx) })
+
+	happ.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: app.UpgradeNameV030, Height: 10})
+
+	require.Equal(t, sidechanneltypes.DefaultParams(), happ.SidechannelKeeper.GetParams(ctx))
+	clerkParams, defaultClerkParams := happ.ClerkKeeper.GetParams(ctx), clerktypes.DefaultParams()
+	require.Equal(t, defaultClerkParams.String(), clerkParams.String())
+	require.Equal(t, int64(10), happ.UpgradeKeeper.GetDoneHeight(ctx, app.UpgradeNameV030))
+}
+
+func TestUpgradeV030SetsMissingBorParams(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	params := happ.BorKeeper.GetParams(ctx)
+	params.SprintDuration = 16
+	happ.BorKeeper.SetParams(ctx, &params)
+
+	// bor params of a chain started before the params were added
+	store := prefix.NewStore(ctx.KVStore(happ.GetKey(paramstypes.StoreKey)), []byte(bortypes.ModuleName+"/"))
+	store.Delete(bortypes.KeySelectionAlgorithms)
+	store.Delete(bortypes.KeyReplacementMissedSprints)
+	require.Panics(t, func() { happ.BorKeeper.GetParams(ctx) })
+
+	happ.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: app.UpgradeNameV030, Height: 10})
+
+	// existing params are kept, span replacement stays disabled
+	params = happ.BorKeeper.GetParams(ctx)
+	require.Equal(t, uint64(16), params.SprintDuration)
+	require.Equal(t, bortypes.DefaultSpanDuration, params.SpanDuration)
+	require.Empty(t, params.SelectionAlgorithms)
+	require.Equal(t, uint64(0), params.ReplacementMissedSprints)
+}
+
+func TestUpgradeV030SetsABIAccountLeaves(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	// dividend account leaves of a chain started before the upgrade
+	ctx.KVStore(happ.GetKey(topuptypes.StoreKey)).Delete(topupkeeper.ABIAccountLeavesKey)
+	require.False(t, happ.TopupKeeper.HasABIAccountLeaves(ctx))
+
+	happ.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: app.UpgradeNameV030, Height: 10})
+	require.True(t, happ.TopupKeeper.HasABIAccountLeaves(ctx))
+}
+
+func TestUpgradeV030SetsNextRecordID(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	ck := happ.ClerkKeeper
+
+	hAddr, _ := sdk.AccAddressFromHex("0x1121212121219")
+	hHash := hmCommon.BytesToHeimdallHash([]byte("some-address"))
+	newRecord := func(id uint64) clerktypes.EventRecord {
+		return clerktypes.NewEventRecord(hHash, id, id, hAddr, make([]byte, 0), "1", time.Unix(int64(id), 0))
+	}
+
+	// records stored before the next record id was tracked
+	ctx.KVStore(happ.GetKey(clerktypes.StoreKey)).Delete(clerkkeeper.NextRecordIDKey)
+	for _, id := range []uint64{1, 2, 3, 5} {
+		require.NoError(t, ck.SetEventRecord(ctx, newRecord(id)))
+	}
+	require.Equal(t, clerkkeeper.FirstRecordID, ck.GetNextRecordID(ctx))
+
+	happ.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: app.UpgradeNameV030, Height: 10})
+	require.Equal(t, uint64(4), ck.GetNextRecordID(ctx))
+
+	missingIDs, latestID := ck.GetMissingRecordIDs(ctx, 0)
+	require.Equal(t, []uint64{4}, missingIDs)
+	require.Equal(t, uint64(5), latestID)
+
+	// new records are sequenced from the stored ones
+	params := ck.GetParams(ctx)
+	params.BufferRecords = true
+	ck.SetParams(ctx, params)
+
+	buffered, err := ck.AddEventRecord(ctx, newRecord(6))
+	require.NoError(t, err)
+	require.True(t, buffered)
+
+	buffered, err = ck.AddEventRecord(ctx, newRecord(4))
+	require.NoError(t, err)
+	require.False(t, buffered)
+	require.Equal(t, uint64(7), ck.GetNextRecordID(ctx))
+	require.True(t, ck.HasEventRecord(ctx, 6))
+}
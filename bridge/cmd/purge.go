@@ -1,10 +1,10 @@
 package cmd
 
 import (
-	"github.com/maticnetwork/heimdall/bridge/setu/queue"
-	"github.com/maticnetwork/heimdall/helper"
 	"github.com/spf13/cobra"
-	"github.com/streadway/amqp"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
 )
 
 // purgeCmd represents the reset of queue
@@ -19,22 +19,15 @@ var purgeCmd = &cobra.Command{
 
 func purgeQueue() {
 	var logger = helper.Logger.With("module", "bridge/cmd/")
-	dialer := helper.GetConfig().AmqpURL
-
-	// amqp dialer
-	conn, err := amqp.Dial(dialer)
-	if err != nil {
-		panic(err)
-	}
 
-	// initialize exchange
-	channel, err := conn.Channel()
+	queueConnector, err := newQueueConnector()
 	if err != nil {
 		panic(err)
 	}
+	defer util.CloseBridgeDBInstance()
 
-	if _, err := channel.QueuePurge(queue.QueueName, false); err != nil {
-		logger.Error("purgeQueue | QueuePurge", "Error", err)
+	if err := queueConnector.Purge(); err != nil {
+		logger.Error("purgeQueue | Purge", "Error", err)
 	}
 }
 
@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/bridge/setu/listener"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
@@ -46,7 +47,14 @@ func GetStartCmd() *cobra.Command {
 			// encoding
 			encoding := app.MakeEncodingConfig()
 			// queue connector & http client
-			_queueConnector := queue.NewQueueConnector(helper.GetConfig().AmqpURL)
+			_queueConnector, err := queue.NewQueueConnector(
+				helper.GetConfig().QueueBackend,
+				helper.GetConfig().AmqpURL,
+				util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)),
+			)
+			if err != nil {
+				panic(err)
+			}
 			_queueConnector.StartWorker()
 
 			_httpClient, _ := httpClient.New(helper.GetConfig().TendermintRPCUrl, "/websocket")
@@ -66,17 +74,37 @@ func GetStartCmd() *cobra.Command {
 
 			cliCtx.BroadcastMode = flags.BroadcastAsync
 
-			_txBroadcaster := broadcaster.NewTxBroadcaster(cliCtx, cdc, cmd.Flags())
+			// heimdall gRPC client
+			if err := util.InitHeimdallClient(cliCtx.InterfaceRegistry); err != nil {
+				panic(err)
+			}
+
+			_txBroadcaster, err := broadcaster.NewTxBroadcaster(cliCtx, cdc, cmd.Flags())
+			if err != nil {
+				logger.Error("Error creating tx broadcaster", "error", err)
+				_queueConnector.Stop()
+				util.CloseHeimdallClient()
+				util.CloseBridgeDBInstance()
+				os.Exit(1)
+			}
+			_txBroadcaster.Start()
 
 			// params context
 			_paramsContext := util.NewParamsContext(cliCtx)
 
 			// selected services to start
+			listenerService := listener.NewListenerService(cliCtx, cdc, _queueConnector, _httpClient)
+			processorService := processor.NewProcessorService(cliCtx, cdc, _queueConnector, _httpClient, _txBroadcaster, _paramsContext)
+
 			var services []service.Service
-			services = append(services,
-				listener.NewListenerService(cliCtx, cdc, _queueConnector, _httpClient),
-				processor.NewProcessorService(cliCtx, cdc, _queueConnector, _httpClient, _txBroadcaster, _paramsContext),
-			)
+			services = append(services, listenerService, processorService)
+
+			// metrics and health server
+			var metricsServer *metrics.Server
+			if addr := helper.GetConfig().BridgeMetricsServerURL; addr != "" {
+				metricsServer = metrics.NewServer(addr, logger, listenerService, processorService)
+				metricsServer.Start()
+			}
 
 			// sync group
 			var wg sync.WaitGroup
@@ -95,11 +123,27 @@ func GetStartCmd() *cobra.Command {
 						}
 					}
 
+					// stop tracking rootchain txs and send the queued heimdall msgs
+					_txBroadcaster.Stop()
+
+					// stop metrics server
+					if metricsServer != nil {
+						if err := metricsServer.Stop(); err != nil {
+							logger.Error("GetStartCmd | metricsServer.Stop", "Error", err)
+						}
+					}
+
 					// stop http client
 					if err := _httpClient.Stop(); err != nil {
 						logger.Error("GetStartCmd | _httpClient.Stop", "Error", err)
 					}
 
+					// stop queue worker
+					_queueConnector.Stop()
+
+					// close heimdall gRPC connection
+					util.CloseHeimdallClient()
+
 					// stop db instance
 					util.CloseBridgeDBInstance()
 
@@ -109,7 +153,7 @@ func GetStartCmd() *cobra.Command {
 			}()
 
 			// Start http client
-			err := _httpClient.Start()
+			err = _httpClient.Start()
 			if err != nil {
 				panic(fmt.Sprintf("Error connecting to server %v", err))
 			}
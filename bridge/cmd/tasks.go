@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const allTasksFlag = "all"
+
+// tasksCmd represents the dead-letter tasks commands
+var tasksCmd = &cobra.Command{
+	Use:   "tasks",
+	Short: "Inspect, retry or drop bridge tasks which failed all their attempts",
+	Long: `Inspect, retry or drop bridge tasks which failed all their attempts.
+The bridge db is locked by a running bridge, stop the bridge before using these commands.`,
+}
+
+var listTasksCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List dead-letter tasks",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		defer util.CloseBridgeDBInstance()
+
+		deadLetters, err := newDeadLetterStore().List()
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tNAME\tATTEMPTS\tFAILED AT\tERROR")
+		for _, deadLetter := range deadLetters {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n",
+				deadLetter.Signature.UUID,
+				deadLetter.Signature.Name,
+				deadLetter.Attempts,
+				deadLetter.FailedAt.Format(time.RFC3339),
+				deadLetter.Error,
+			)
+		}
+
+		return w.Flush()
+	},
+}
+
+var retryTasksCmd = &cobra.Command{
+	Use:   "retry [task-id]",
+	Short: "Send dead-letter tasks to the queue again",
+	Args:  deadLetterArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// retried tasks are sent to the broker
+		queueConnector, err := newQueueConnector()
+		if err != nil {
+			return err
+		}
+		defer util.CloseBridgeDBInstance()
+
+		return forEachDeadLetter(cmd, args, queueConnector.RetryDeadLetter)
+	},
+}
+
+var dropTasksCmd = &cobra.Command{
+	Use:   "drop [task-id]",
+	Short: "Delete dead-letter tasks",
+	Args:  deadLetterArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		defer util.CloseBridgeDBInstance()
+
+		return forEachDeadLetter(cmd, args, newDeadLetterStore().Delete)
+	},
+}
+
+// deadLetterArgs checks that either a task id or --all is given
+func deadLetterArgs(cmd *cobra.Command, args []string) error {
+	all, _ := cmd.Flags().GetBool(allTasksFlag)
+	if len(args) > 1 || all == (len(args) == 1) {
+		return errors.New("either a task id or --all is required")
+	}
+
+	return nil
+}
+
+// forEachDeadLetter runs fn for the task given as argument or all dead-letter tasks
+func forEachDeadLetter(cmd *cobra.Command, args []string, fn func(string) error) error {
+	all, _ := cmd.Flags().GetBool(allTasksFlag)
+
+	taskIDs := args
+	if all {
+		deadLetters, err := newDeadLetterStore().List()
+		if err != nil {
+			return err
+		}
+
+		taskIDs = make([]string, 0, len(deadLetters))
+		for _, deadLetter := range deadLetters {
+			taskIDs = append(taskIDs, deadLetter.Signature.UUID)
+		}
+	}
+
+	for _, taskID := range taskIDs {
+		if err := fn(taskID); err != nil {
+			return fmt.Errorf("task %s: %w", taskID, err)
+		}
+		fmt.Println(taskID)
+	}
+
+	return nil
+}
+
+// newDeadLetterStore returns the dead-letter store on the bridge db, it doesn't
+// need the broker
+func newDeadLetterStore() *queue.DeadLetterStore {
+	return queue.NewDeadLetterStore(util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)))
+}
+
+// newQueueConnector returns the queue connector of the configured backend
+func newQueueConnector() (*queue.QueueConnector, error) {
+	return queue.NewQueueConnector(
+		helper.GetConfig().QueueBackend,
+		helper.GetConfig().AmqpURL,
+		util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)),
+	)
+}
+
+func init() {
+	retryTasksCmd.Flags().Bool(allTasksFlag, false, "retry all dead-letter tasks")
+	dropTasksCmd.Flags().Bool(allTasksFlag, false, "drop all dead-letter tasks")
+
+	tasksCmd.AddCommand(listTasksCmd, retryTasksCmd, dropTasksCmd)
+	rootCmd.AddCommand(tasksCmd)
+}
@@ -3,23 +3,30 @@ package broadcaster
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"sync"
+	"time"
 
 	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
 	bor "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/gogo/protobuf/proto"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
+	clerkTypes "github.com/maticnetwork/heimdall/x/clerk/types"
+	topupTypes "github.com/maticnetwork/heimdall/x/topup/types"
 
-	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -29,74 +36,201 @@ type TxBroadcaster struct {
 
 	cliCtx client.Context
 
-	heimdallMutex sync.Mutex
-	maticMutex    sync.Mutex
+	maticMutex sync.Mutex
 
-	lastSeqNo uint64
-	accNum    uint64
-	flagSet   *pflag.FlagSet
+	rootchainTxManager *RootChainTxManager
+	heimdallTxManager  *HeimdallTxManager
+	heimdallBatcher    *HeimdallBatcher
 }
 
 // NewTxBroadcaster creates new broadcaster
-func NewTxBroadcaster(cliCtx client.Context, cdc codec.Marshaler, flagSet *pflag.FlagSet) *TxBroadcaster {
-	// current address
-	address := hmCommonTypes.BytesToHeimdallAddress(helper.GetAddress())
-	account, err := util.GetAccount(cliCtx, address)
+func NewTxBroadcaster(cliCtx client.Context, cdc codec.Marshaler, flagSet *pflag.FlagSet) (*TxBroadcaster, error) {
+	// heimdall tx client of the validator account
+	heimdallTxClient, err := NewHeimdallTxClient(cliCtx, flagSet)
 	if err != nil {
-		panic("Error connecting to rest-server, please start server before bridge.")
+		return nil, fmt.Errorf("error connecting to heimdall gRPC server, please start heimdall before bridge: %w", err)
 	}
 
 	txBroadcaster := TxBroadcaster{
-		logger:    util.Logger().With("module", "txBroadcaster"),
-		cliCtx:    cliCtx,
-		lastSeqNo: account.GetSequence(),
-		accNum:    account.GetAccountNumber(),
-		flagSet:   flagSet,
+		logger: util.Logger().With("module", "txBroadcaster"),
+		cliCtx: cliCtx,
 	}
 
-	return &txBroadcaster
+	// rootchain tx manager
+	chainmanagerParams, err := util.GetHeimdallClient().ChainmanagerParams()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching chainmanager params from heimdall gRPC server: %w", err)
+	}
+
+	txBroadcaster.rootchainTxManager, err = NewRootChainTxManager(
+		txBroadcaster.logger.With("chain", "rootchain"),
+		NewRootChainClient(helper.GetMainClient(), helper.GetMainChainRPCClient()),
+		helper.GetSigner(),
+		util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)),
+		rootChainTxManagerConfig(chainmanagerParams.MainchainTxConfirmations),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating rootchain tx manager: %w", err)
+	}
+
+	txBroadcaster.heimdallTxManager, err = NewHeimdallTxManager(
+		txBroadcaster.logger.With("chain", "heimdall"),
+		heimdallTxClient,
+		util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)),
+		heimdallTxManagerConfig(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating heimdall tx manager: %w", err)
+	}
+
+	txBroadcaster.heimdallBatcher = NewHeimdallBatcher(
+		txBroadcaster.logger.With("chain", "heimdall"),
+		heimdallBatchConfig(tx.NewFactoryCLI(cliCtx, flagSet).Gas()),
+		txBroadcaster.broadcastToHeimdall,
+		isHeimdallMsgApplied,
+	)
+
+	return &txBroadcaster, nil
 }
 
-//
-// BroadcastToHeimdall broadcast to heimdall
-func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg) error {
-	tb.heimdallMutex.Lock()
-	defer tb.heimdallMutex.Unlock()
+// heimdallTxManagerConfig returns the heimdall tx manager config from heimdall config
+func heimdallTxManagerConfig() HeimdallTxManagerConfig {
+	config := HeimdallTxManagerConfig{
+		ResubmitInterval: helper.GetConfig().HeimdallTxResubmitInterval,
+		PollInterval:     HeimdallTxPollInterval,
+		MaxResubmits:     HeimdallTxMaxResubmits,
+	}
 
-	//chain id
-	chainID := helper.GetGenesisDoc().ChainID
+	// config files written before the tx manager lack its settings
+	if config.ResubmitInterval == 0 {
+		config.ResubmitInterval = helper.DefaultHeimdallTxResubmitInterval
+	}
 
-	//tx factory
-	txf := tx.NewFactoryCLI(tb.cliCtx, tb.flagSet).
-		WithAccountNumber(tb.accNum).
-		WithSequence(tb.lastSeqNo).
-		WithChainID(chainID).
-		WithTxConfig(tb.cliCtx.TxConfig).
-		WithAccountRetriever(tb.cliCtx.AccountRetriever)
+	return config
+}
 
-	txResponse, err := helper.BuildAndBroadcastMsgs(tb.cliCtx, txf, []sdk.Msg{msg})
-	if err != nil {
-		tb.logger.Error("Error while broadcasting the heimdall transaction", "error", err)
-		// current address
-		address := hmCommonTypes.BytesToHeimdallAddress(helper.GetAddress())
-
-		// fetch from APIs
-		account, errAcc := util.GetAccount(tb.cliCtx, address)
-		if errAcc != nil {
-			tb.logger.Error("Error fetching account from rest-api", "url", helper.GetHeimdallServerEndpoint(fmt.Sprintf(util.AccountDetailsURL, helper.GetAddress())))
-			return errAcc
+// heimdallBatchConfig returns the heimdall batch config from heimdall config
+func heimdallBatchConfig(msgGas uint64) HeimdallBatchConfig {
+	config := HeimdallBatchConfig{
+		Interval: helper.GetConfig().HeimdallTxBatchInterval,
+		MaxMsgs:  helper.GetConfig().HeimdallTxBatchMaxMsgs,
+		MaxBytes: helper.GetConfig().HeimdallTxBatchMaxBytes,
+		MaxGas:   helper.GetConfig().HeimdallTxBatchMaxGas,
+		MsgGas:   msgGas,
+
+		ResultTimeout:      HeimdallBatchResultTimeout,
+		ResultPollInterval: HeimdallBatchResultPollInterval,
+	}
+
+	// config files written before batching lack its settings
+	if config.Interval == 0 {
+		config.Interval = helper.DefaultHeimdallTxBatchInterval
+	}
+	if config.MaxMsgs == 0 {
+		config.MaxMsgs = helper.DefaultHeimdallTxBatchMaxMsgs
+	}
+	if config.MaxBytes == 0 {
+		config.MaxBytes = helper.DefaultHeimdallTxBatchMaxBytes
+	}
+	if config.MaxGas == 0 {
+		config.MaxGas = helper.DefaultHeimdallTxBatchMaxGas
+	}
+
+	return config
+}
+
+// isHeimdallMsgApplied checks if the event of a batchable msg was processed on heimdall
+func isHeimdallMsgApplied(msg sdk.Msg) (bool, error) {
+	switch m := msg.(type) {
+	case *clerkTypes.MsgEventRecordRequest:
+		return util.GetHeimdallClient().ClerkOldTx(m.TxHash, m.LogIndex)
+	case *topupTypes.MsgTopup:
+		return util.GetHeimdallClient().TopupOldTx(m.TxHash, m.LogIndex)
+	default:
+		return false, fmt.Errorf("unknown batchable msg %s", proto.MessageName(msg))
+	}
+}
+
+// heimdallMsgsApplied checks if any of the msgs took effect on heimdall, known is
+// false when it can't be told for one of them
+func heimdallMsgsApplied(msgs []sdk.Msg) (bool, bool, error) {
+	for _, msg := range msgs {
+		if !IsBatchable(msg) {
+			return false, false, nil
+		}
+	}
+
+	for _, msg := range msgs {
+		applied, err := isHeimdallMsgApplied(msg)
+		if err != nil {
+			return false, true, err
+		}
+
+		if applied {
+			return true, true, nil
 		}
+	}
+
+	return false, true, nil
+}
+
+// rootChainTxManagerConfig returns the rootchain tx manager config from heimdall config
+func rootChainTxManagerConfig(confirmations uint64) RootChainTxManagerConfig {
+	config := RootChainTxManagerConfig{
+		Confirmations:    confirmations,
+		ResubmitInterval: helper.GetConfig().MainchainTxResubmitInterval,
+		GasPriceBump:     helper.GetConfig().MainchainGasPriceBump,
+		DefaultGasLimit:  helper.GetConfig().MainchainGasLimit,
+		DynamicFee:       helper.GetConfig().MainchainDynamicFee,
+		PollInterval:     RootChainTxPollInterval,
+	}
 
-		// update seqNo for safety
-		tb.lastSeqNo = account.GetSequence()
+	// config files written before the tx manager lack its settings
+	if config.ResubmitInterval == 0 {
+		config.ResubmitInterval = helper.DefaultMainchainTxResubmitInterval
+	}
+	if config.GasPriceBump == 0 {
+		config.GasPriceBump = helper.DefaultMainchainGasPriceBump
+	}
+	if maxGasPrice := helper.GetConfig().MainchainMaxGasPrice; maxGasPrice > 0 {
+		config.MaxGasPrice = big.NewInt(maxGasPrice)
+	}
+
+	return config
+}
+
+// Start starts tracking the pending rootchain and heimdall txs and sending the queued heimdall msgs
+func (tb *TxBroadcaster) Start() {
+	tb.rootchainTxManager.Start()
+	tb.heimdallTxManager.Start()
+	tb.heimdallBatcher.Start()
+}
 
+// Stop stops tracking the pending rootchain and heimdall txs, the queued heimdall msgs are sent before it returns
+func (tb *TxBroadcaster) Stop() {
+	tb.rootchainTxManager.Stop()
+	tb.heimdallBatcher.Stop()
+	tb.heimdallTxManager.Stop()
+}
+
+//
+// BroadcastToHeimdall broadcast to heimdall, independent msgs like state syncs and topups
+// are queued and sent along with other msgs of the same type
+func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg) error {
+	if tb.heimdallBatcher != nil && tb.heimdallBatcher.config.MaxMsgs > 1 && IsBatchable(msg) {
+		return tb.heimdallBatcher.Add(msg)
+	}
+
+	return tb.broadcastToHeimdall([]sdk.Msg{msg})
+}
+
+// broadcastToHeimdall broadcasts the msgs in a single heimdall tx
+func (tb *TxBroadcaster) broadcastToHeimdall(msgs []sdk.Msg) error {
+	if _, err := tb.heimdallTxManager.Send(msgs); err != nil {
+		tb.logger.Error("Error while broadcasting the heimdall transaction", "error", err)
 		return err
 	}
 
-	tb.logger.Info("Tx sent on heimdall", "txHash", txResponse.TxHash, "accSeq", tb.lastSeqNo, "accNum", tb.accNum)
-	tb.logger.Debug("Tx successful on heimdall", "txResponse", txResponse)
-	// increment account sequence
-	tb.lastSeqNo += 1
 	return nil
 }
 
@@ -130,7 +264,10 @@ func (tb *TxBroadcaster) BroadcastToMatic(msg bor.CallMsg) error {
 	tb.logger.Info("Sending transaction to bor", "txHash", signedTx.Hash())
 
 	// broadcast transaction
-	if err := maticClient.SendTransaction(context.Background(), signedTx); err != nil {
+	start := time.Now()
+	err = maticClient.SendTransaction(context.Background(), signedTx)
+	metrics.BroadcastDuration.WithLabelValues(metrics.MaticChain, metrics.BroadcastStatus(err)).Observe(time.Since(start).Seconds())
+	if err != nil {
 		tb.logger.Error("Error while broadcasting the transaction to maticchain", "error", err)
 		return err
 	}
@@ -138,5 +275,18 @@ func (tb *TxBroadcaster) BroadcastToMatic(msg bor.CallMsg) error {
 	return nil
 }
 
-// BroadcastToRootchain broadcast to rootchain
-func (tb *TxBroadcaster) BroadcastToRootchain() {}
+// BroadcastToRootchain broadcast to rootchain, the tx is tracked and replaced
+// with higher fees until it's confirmed
+func (tb *TxBroadcaster) BroadcastToRootchain(method string, msg bor.CallMsg) (common.Hash, error) {
+	if tb.rootchainTxManager == nil {
+		return common.Hash{}, errNoRootChainTxManager
+	}
+
+	txHash, err := tb.rootchainTxManager.Send(method, *msg.To, msg.Data)
+	if err != nil {
+		tb.logger.Error("Error while broadcasting the transaction to rootchain", "method", method, "error", err)
+		return common.Hash{}, err
+	}
+
+	return txHash, nil
+}
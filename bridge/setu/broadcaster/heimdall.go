@@ -0,0 +1,486 @@
+package broadcaster
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+)
+
+const (
+	// heimdallTxPrefix is the bridge db prefix of pending heimdall txs
+	heimdallTxPrefix = "heimdall-tx-"
+
+	// HeimdallTxPollInterval is how often pending heimdall txs are checked
+	HeimdallTxPollInterval = 5 * time.Second
+
+	// HeimdallTxMaxResubmits is how many times a pending heimdall tx is sent again before it's dropped
+	HeimdallTxMaxResubmits = 10
+)
+
+// HeimdallTxManagerConfig configures the heimdall tx manager
+type HeimdallTxManagerConfig struct {
+	ResubmitInterval time.Duration // time after which a pending tx, which isn't in the mempool, is sent again
+	PollInterval     time.Duration // how often pending txs are checked
+	MaxResubmits     uint64        // times a pending tx is sent again before it's dropped, 0 for no limit
+}
+
+// HeimdallTxManager sends the txs of the validator to heimdall. It keeps the
+// sequence locally so txs don't wait on each other, confirms their inclusion
+// through tx search and sends them again when they're dropped from the mempool.
+// Txs following a gap in the sequences are signed again with the next free
+// sequence, as are txs whose sequence was used by another tx once their msgs
+// are known not to have taken effect, since tx search misses txs when the node
+// doesn't index them. Pending txs are kept in the bridge db to be tracked across
+// restarts.
+type HeimdallTxManager struct {
+	logger log.Logger
+	client HeimdallTxClient
+	config HeimdallTxManagerConfig
+	db     *leveldb.DB
+
+	mu             sync.Mutex
+	sequence       uint64 // next sequence
+	sequenceLoaded bool
+	pending        map[uint64]*HeimdallTx
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewHeimdallTxManager creates the tx manager and loads the pending txs
+func NewHeimdallTxManager(logger log.Logger, client HeimdallTxClient, db *leveldb.DB, config HeimdallTxManagerConfig) (*HeimdallTxManager, error) {
+	m := &HeimdallTxManager{
+		logger:  logger,
+		client:  client,
+		config:  config,
+		db:      db,
+		pending: make(map[uint64]*HeimdallTx),
+		quit:    make(chan struct{}),
+	}
+
+	txs, err := m.loadTxs()
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range txs {
+		m.pending[tx.Sequence] = tx
+	}
+	if len(txs) > 0 {
+		m.logger.Info("Loaded pending heimdall txs", "count", len(txs))
+	}
+
+	return m, nil
+}
+
+// Start starts tracking the pending txs
+func (m *HeimdallTxManager) Start() {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+
+		ticker := time.NewTicker(m.config.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				m.checkPendingTxs()
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops tracking the pending txs, they are tracked again after a restart
+func (m *HeimdallTxManager) Stop() {
+	close(m.quit)
+	m.wg.Wait()
+}
+
+// PendingTxs returns the txs which are not committed yet, ordered by sequence
+func (m *HeimdallTxManager) PendingTxs() []*HeimdallTx {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.sortedTxs()
+}
+
+// Send signs and broadcasts the tx of the msgs with the next sequence, it's
+// then tracked until it's committed
+func (m *HeimdallTxManager) Send(msgs []sdk.Msg) (tmbytes.HexBytes, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tx, err := m.send(msgs)
+	if errors.Is(err, sdkerrors.ErrWrongSequence) {
+		// sequence used outside of the manager, retry with the chain sequence
+		m.logger.Info("Heimdall account sequence mismatch, reloading it", "sequence", m.sequence, "error", err)
+		metrics.SequenceMismatches.Inc()
+		m.sequenceLoaded = false
+
+		tx, err = m.send(msgs)
+	}
+	if err != nil {
+		if errors.Is(err, sdkerrors.ErrWrongSequence) {
+			m.sequenceLoaded = false
+		}
+		return nil, err
+	}
+
+	m.sequence = tx.Sequence + 1
+	m.pending[tx.Sequence] = tx
+
+	m.logger.Info("Tx sent on heimdall", "txHash", tx.Hash(), "sequence", tx.Sequence, "msgs", len(msgs))
+	return tx.Hash(), nil
+}
+
+// send signs and broadcasts the tx with the next sequence, it's not kept if
+// the node rejects it
+func (m *HeimdallTxManager) send(msgs []sdk.Msg) (*HeimdallTx, error) {
+	sequence, err := m.nextSequence()
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &HeimdallTx{Sequence: sequence}
+	if err := m.sign(tx, msgs); err != nil {
+		return nil, err
+	}
+
+	res, err := m.broadcast(tx)
+	if err == nil {
+		err = checkTxError(res)
+	}
+	if err != nil {
+		if deleteErr := m.deleteTx(sequence); deleteErr != nil {
+			m.logger.Error("Error while deleting heimdall tx", "sequence", sequence, "error", deleteErr)
+		}
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// nextSequence returns the sequence of the next tx, loading it from the chain the first time
+func (m *HeimdallTxManager) nextSequence() (uint64, error) {
+	if m.sequenceLoaded {
+		return m.sequence, nil
+	}
+
+	sequence, err := m.client.Sequence()
+	if err != nil {
+		return 0, err
+	}
+
+	// txs still pending may be in the mempool
+	for pendingSequence := range m.pending {
+		if pendingSequence >= sequence {
+			sequence = pendingSequence + 1
+		}
+	}
+
+	m.sequence = sequence
+	m.sequenceLoaded = true
+	return sequence, nil
+}
+
+// sign signs the msgs with the sequence of the tx
+func (m *HeimdallTxManager) sign(tx *HeimdallTx, msgs []sdk.Msg) error {
+	txBytes, err := m.client.SignTx(msgs, tx.Sequence)
+	if err != nil {
+		return err
+	}
+
+	tx.TxBytes = txBytes
+	tx.Hashes = append(tx.Hashes, tmTypes.Tx(txBytes).Hash())
+	return nil
+}
+
+// broadcast stores and sends the tx. The tx is stored first so it's tracked
+// even if the bridge stops right after sending it.
+func (m *HeimdallTxManager) broadcast(tx *HeimdallTx) (*sdk.TxResponse, error) {
+	tx.SentAt = time.Now()
+	if err := m.putTx(tx); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	res, err := m.client.BroadcastTx(tx.TxBytes)
+	status := err
+	if err == nil {
+		status = checkTxError(res)
+	}
+	metrics.BroadcastDuration.WithLabelValues(metrics.Heimdall, metrics.BroadcastStatus(status)).Observe(time.Since(start).Seconds())
+
+	return res, err
+}
+
+// checkPendingTxs confirms committed txs, sends again the txs dropped from
+// the mempool and moves the txs after a sequence gap to the free sequences
+func (m *HeimdallTxManager) checkPendingTxs() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.pending) == 0 {
+		return
+	}
+
+	uncommitted := make([]*HeimdallTx, 0, len(m.pending))
+	for _, tx := range m.sortedTxs() {
+		result, err := m.findCommitted(tx)
+		if err != nil {
+			// the tx index may be disabled, the tx is checked against the account sequence
+			m.logger.Error("Error while searching heimdall tx", "sequence", tx.Sequence, "error", err)
+		} else if result != nil {
+			m.confirm(tx, result)
+			continue
+		}
+		uncommitted = append(uncommitted, tx)
+	}
+
+	// sequence of the account in the latest block, fetched after the search
+	// so txs committed in between aren't taken as lost
+	chainSequence, err := m.client.Sequence()
+	if err != nil {
+		m.logger.Error("Error while fetching heimdall account sequence", "error", err)
+		return
+	}
+
+	// uncommitted txs take the sequences from the chain sequence on, the ones
+	// whose sequence was used by another tx go last
+	var live, lost []*HeimdallTx
+	for _, tx := range uncommitted {
+		if tx.Sequence < chainSequence {
+			lost = append(lost, tx)
+		} else {
+			live = append(live, tx)
+		}
+	}
+
+	sequence := chainSequence
+	for _, tx := range append(live, lost...) {
+		if tx.Sequence < chainSequence {
+			if time.Since(tx.SentAt) < m.config.ResubmitInterval {
+				// the tx may be committed but not indexed yet
+				continue
+			}
+
+			committed, err := m.committedUnindexed(tx)
+			if err != nil {
+				m.logger.Error("Error while checking if heimdall tx took effect", "sequence", tx.Sequence, "error", err)
+				continue
+			}
+			if committed {
+				continue
+			}
+		}
+
+		kept, err := m.resubmit(tx, sequence)
+		if err != nil {
+			m.logger.Error("Error while sending heimdall tx again", "sequence", tx.Sequence, "error", err)
+			if errors.Is(err, sdkerrors.ErrWrongSequence) {
+				metrics.SequenceMismatches.Inc()
+				m.sequenceLoaded = false
+			}
+			return
+		}
+
+		if kept {
+			sequence++
+		}
+	}
+
+	m.sequence = sequence
+	m.sequenceLoaded = true
+}
+
+// committedUnindexed checks if the tx whose sequence was used by another tx was
+// committed without tx search finding it, stopping to track it then. Txs whose
+// msgs took effect are taken as committed, as are txs whose effect can't be told
+// from their msgs, sending them again could apply them twice.
+func (m *HeimdallTxManager) committedUnindexed(tx *HeimdallTx) (bool, error) {
+	msgs, err := m.client.DecodeMsgs(tx.TxBytes)
+	if err != nil {
+		return false, err
+	}
+
+	applied, known, err := m.client.MsgsApplied(msgs)
+	if err != nil {
+		return false, err
+	}
+
+	if applied || !known {
+		m.logger.Info("Heimdall tx sequence was used and the tx wasn't found, taking it as committed", "txHash", tx.Hash(), "sequence", tx.Sequence, "applied", applied)
+		m.remove(tx.Sequence)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// resubmit sends the tx with the sequence again. Txs with another sequence are
+// signed again, txs with the sequence are sent again once the resubmit
+// interval has passed. Txs rejected by the node or sent again too many times
+// are dropped, returning false.
+func (m *HeimdallTxManager) resubmit(tx *HeimdallTx, sequence uint64) (bool, error) {
+	if tx.Sequence == sequence && time.Since(tx.SentAt) < m.config.ResubmitInterval {
+		return true, nil
+	}
+
+	if m.config.MaxResubmits > 0 && tx.Resubmits >= m.config.MaxResubmits {
+		m.logger.Error("Heimdall tx was sent again too many times, dropping it", "txHash", tx.Hash(), "sequence", tx.Sequence, "resubmits", tx.Resubmits)
+		m.remove(tx.Sequence)
+		return false, nil
+	}
+
+	reason := "resequenced"
+	if tx.Sequence == sequence {
+		reason = "dropped"
+	} else {
+		msgs, err := m.client.DecodeMsgs(tx.TxBytes)
+		if err != nil {
+			return false, err
+		}
+
+		// sign a copy, the stored tx is only replaced once the new version is signed
+		replacement := *tx
+		replacement.Sequence = sequence
+		replacement.Hashes = append([]tmbytes.HexBytes{}, tx.Hashes...)
+		if err := m.sign(&replacement, msgs); err != nil {
+			return false, err
+		}
+
+		m.logger.Info("Heimdall tx sequence is not free, signing it again", "txHash", tx.Hash(), "sequence", tx.Sequence, "newSequence", sequence)
+		m.remove(tx.Sequence)
+		m.pending[sequence] = &replacement
+		tx = &replacement
+	}
+
+	res, err := m.broadcast(tx)
+	if err != nil {
+		return false, err
+	}
+
+	if isInMempool(res) {
+		return true, nil
+	}
+
+	metrics.HeimdallTxResubmissions.WithLabelValues(reason).Inc()
+
+	tx.Resubmits++
+	if err := m.putTx(tx); err != nil {
+		m.logger.Error("Error while storing heimdall tx", "sequence", tx.Sequence, "error", err)
+	}
+
+	if err := checkTxError(res); err != nil {
+		if errors.Is(err, sdkerrors.ErrWrongSequence) {
+			return false, err
+		}
+
+		m.logger.Error("Heimdall tx rejected, dropping it", "txHash", tx.Hash(), "sequence", tx.Sequence, "error", err)
+		m.remove(tx.Sequence)
+		return false, nil
+	}
+
+	m.logger.Info("Sent heimdall tx again", "txHash", tx.Hash(), "sequence", tx.Sequence, "reason", reason)
+	return true, nil
+}
+
+// findCommitted returns the result of the committed version of the tx, if any
+func (m *HeimdallTxManager) findCommitted(tx *HeimdallTx) (*ctypes.ResultTx, error) {
+	for i := len(tx.Hashes) - 1; i >= 0; i-- {
+		result, err := m.client.SearchTx(tx.Hashes[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if result != nil {
+			return result, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// confirm stops tracking the committed tx
+func (m *HeimdallTxManager) confirm(tx *HeimdallTx, result *ctypes.ResultTx) {
+	if result.TxResult.Code != 0 {
+		m.logger.Error("Heimdall tx failed", "txHash", result.Hash, "sequence", tx.Sequence, "height", result.Height, "log", result.TxResult.Log)
+	} else {
+		m.logger.Debug("Heimdall tx committed", "txHash", result.Hash, "sequence", tx.Sequence, "height", result.Height)
+	}
+
+	m.remove(tx.Sequence)
+}
+
+// remove stops tracking the tx with the sequence
+func (m *HeimdallTxManager) remove(sequence uint64) {
+	delete(m.pending, sequence)
+	if err := m.deleteTx(sequence); err != nil {
+		m.logger.Error("Error while deleting heimdall tx", "sequence", sequence, "error", err)
+	}
+}
+
+func (m *HeimdallTxManager) sortedTxs() []*HeimdallTx {
+	txs := make([]*HeimdallTx, 0, len(m.pending))
+	for _, tx := range m.pending {
+		txs = append(txs, tx)
+	}
+	sort.Slice(txs, func(i, j int) bool { return txs[i].Sequence < txs[j].Sequence })
+
+	return txs
+}
+
+//
+// Storage
+//
+
+func (m *HeimdallTxManager) putTx(tx *HeimdallTx) error {
+	value, err := json.Marshal(tx)
+	if err != nil {
+		return err
+	}
+	return m.db.Put(heimdallTxKey(tx.Sequence), value, nil)
+}
+
+func (m *HeimdallTxManager) deleteTx(sequence uint64) error {
+	return m.db.Delete(heimdallTxKey(sequence), nil)
+}
+
+func (m *HeimdallTxManager) loadTxs() ([]*HeimdallTx, error) {
+	iter := m.db.NewIterator(leveldbUtil.BytesPrefix([]byte(heimdallTxPrefix)), nil)
+	defer iter.Release()
+
+	var txs []*HeimdallTx
+	for iter.Next() {
+		var tx HeimdallTx
+		if err := json.Unmarshal(iter.Value(), &tx); err != nil {
+			return nil, err
+		}
+		txs = append(txs, &tx)
+	}
+
+	return txs, iter.Error()
+}
+
+func heimdallTxKey(sequence uint64) []byte {
+	key := make([]byte, len(heimdallTxPrefix)+8)
+	copy(key, heimdallTxPrefix)
+	binary.BigEndian.PutUint64(key[len(heimdallTxPrefix):], sequence)
+	return key
+}
@@ -0,0 +1,274 @@
+package broadcaster
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+	clerkTypes "github.com/maticnetwork/heimdall/x/clerk/types"
+	topupTypes "github.com/maticnetwork/heimdall/x/topup/types"
+)
+
+// batchableMsgs are the msgs of independent events, these are queued and sent
+// to heimdall in multi-msg txs of the same msg type
+var batchableMsgs = map[string]bool{
+	proto.MessageName(&clerkTypes.MsgEventRecordRequest{}): true,
+	proto.MessageName(&topupTypes.MsgTopup{}):              true,
+}
+
+var errHeimdallBatcherStopped = errors.New("heimdall batcher stopped")
+
+const (
+	// HeimdallBatchResultTimeout is the time the msgs of a sent batch have to take effect in
+	HeimdallBatchResultTimeout = time.Minute
+
+	// HeimdallBatchResultPollInterval is how often the msgs of a sent batch are checked
+	HeimdallBatchResultPollInterval = 5 * time.Second
+)
+
+// HeimdallBatchConfig limits the msgs sent in a multi-msg heimdall tx
+type HeimdallBatchConfig struct {
+	Interval time.Duration // time msgs are queued for before they're sent
+	MaxMsgs  uint64
+	MaxBytes uint64
+	MaxGas   uint64
+	MsgGas   uint64 // gas of each msg of the tx
+
+	ResultTimeout      time.Duration // time the msgs of a sent batch have to take effect in
+	ResultPollInterval time.Duration // how often the msgs of a sent batch are checked
+}
+
+// heimdallBatch is the queued msgs of a msg type
+type heimdallBatch struct {
+	msgs []sdk.Msg
+	size uint64
+}
+
+// HeimdallBatcher queues the batchable msgs and sends them in multi-msg txs.
+// Msgs are queued without waiting for their batch, so the task workers adding
+// them are free to process the next events. A side-tx is voted as a whole, so
+// a msg rejected by the validators rejects the rest of its batch; the msgs of a
+// batch which doesn't take effect are sent again on their own. Msgs which fail
+// on their own are caught by the old tx checks and retries of their events.
+type HeimdallBatcher struct {
+	logger log.Logger
+	config HeimdallBatchConfig
+
+	// send broadcasts the msgs in a single tx
+	send func(msgs []sdk.Msg) error
+
+	// applied checks if the msg took effect on heimdall
+	applied func(msg sdk.Msg) (bool, error)
+
+	queue chan sdk.Msg
+	quit  chan struct{}
+	wg    sync.WaitGroup
+
+	// stopped is set before quit is closed, so that no msg is queued after the last batch
+	stopped   bool
+	stoppedMu sync.RWMutex
+}
+
+// NewHeimdallBatcher creates batcher sending the msgs with send and checking they
+// took effect with applied
+func NewHeimdallBatcher(logger log.Logger, config HeimdallBatchConfig, send func(msgs []sdk.Msg) error, applied func(msg sdk.Msg) (bool, error)) *HeimdallBatcher {
+	return &HeimdallBatcher{
+		logger:  logger,
+		config:  config,
+		send:    send,
+		applied: applied,
+		queue:   make(chan sdk.Msg, config.MaxMsgs),
+		quit:    make(chan struct{}),
+	}
+}
+
+// IsBatchable checks if the msg can be sent along with other msgs of its type
+func IsBatchable(msg sdk.Msg) bool {
+	return batchableMsgs[proto.MessageName(msg)]
+}
+
+// Start starts sending the queued msgs
+func (b *HeimdallBatcher) Start() {
+	b.wg.Add(1)
+	go b.loop()
+}
+
+// Stop sends the queued msgs and stops the batcher, the msgs of the sent batches
+// are not checked anymore
+func (b *HeimdallBatcher) Stop() {
+	b.stoppedMu.Lock()
+	b.stopped = true
+	close(b.quit)
+	b.stoppedMu.Unlock()
+
+	b.wg.Wait()
+}
+
+// Add queues the msg to be sent with the next batch of its type, within the
+// batch interval. It returns once the msg is queued.
+func (b *HeimdallBatcher) Add(msg sdk.Msg) error {
+	b.stoppedMu.RLock()
+	defer b.stoppedMu.RUnlock()
+
+	if b.stopped {
+		return errHeimdallBatcherStopped
+	}
+
+	b.queue <- msg
+	return nil
+}
+
+func (b *HeimdallBatcher) loop() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(b.config.Interval)
+	defer ticker.Stop()
+
+	batches := make(map[string]*heimdallBatch)
+	for {
+		select {
+		case msg := <-b.queue:
+			b.add(batches, msg)
+
+		case <-ticker.C:
+			b.flushAll(batches)
+
+		case <-b.quit:
+			// send the queued msgs before exiting
+			for {
+				select {
+				case msg := <-b.queue:
+					b.add(batches, msg)
+				default:
+					b.flushAll(batches)
+					return
+				}
+			}
+		}
+	}
+}
+
+// add adds the msg to the batch of its type, sending the batch once it's full
+func (b *HeimdallBatcher) add(batches map[string]*heimdallBatch, msg sdk.Msg) {
+	msgType := proto.MessageName(msg)
+	batch, ok := batches[msgType]
+	if !ok {
+		batch = &heimdallBatch{}
+		batches[msgType] = batch
+	}
+
+	size := uint64(proto.Size(msg))
+	if len(batch.msgs) > 0 && batch.size+size > b.config.MaxBytes {
+		b.flush(msgType, batch)
+	}
+
+	batch.msgs = append(batch.msgs, msg)
+	batch.size += size
+
+	if uint64(len(batch.msgs)) >= b.maxMsgs() {
+		b.flush(msgType, batch)
+	}
+}
+
+// maxMsgs returns the max msgs of a tx within the msgs and gas limits
+func (b *HeimdallBatcher) maxMsgs() uint64 {
+	maxMsgs := b.config.MaxMsgs
+	if b.config.MsgGas > 0 && b.config.MaxGas/b.config.MsgGas < maxMsgs {
+		maxMsgs = b.config.MaxGas / b.config.MsgGas
+	}
+
+	if maxMsgs == 0 {
+		return 1
+	}
+	return maxMsgs
+}
+
+func (b *HeimdallBatcher) flushAll(batches map[string]*heimdallBatch) {
+	msgTypes := make([]string, 0, len(batches))
+	for msgType := range batches {
+		msgTypes = append(msgTypes, msgType)
+	}
+	sort.Strings(msgTypes)
+
+	for _, msgType := range msgTypes {
+		if batch := batches[msgType]; len(batch.msgs) > 0 {
+			b.flush(msgType, batch)
+		}
+	}
+}
+
+// flush sends the msgs of the batch in a tx
+func (b *HeimdallBatcher) flush(msgType string, batch *heimdallBatch) {
+	msgs := batch.msgs
+	batch.msgs = nil
+	batch.size = 0
+
+	metrics.HeimdallBatchMsgs.WithLabelValues(msgType).Observe(float64(len(msgs)))
+
+	err := b.send(msgs)
+	switch {
+	case err == nil && len(msgs) > 1 && b.applied != nil:
+		b.wg.Add(1)
+		go b.awaitApplied(msgType, msgs)
+
+	case err != nil && len(msgs) > 1:
+		// a single invalid msg fails the whole tx, send the msgs one by one
+		b.logger.Error("Error while sending batch to heimdall, sending msgs one by one", "msg", msgType, "msgs", len(msgs), "error", err)
+		b.sendEach(msgType, msgs)
+
+	case err != nil:
+		b.logger.Error("Error while sending queued msg to heimdall", "msg", msgType, "error", err)
+	}
+}
+
+// sendEach sends the msgs in a tx each
+func (b *HeimdallBatcher) sendEach(msgType string, msgs []sdk.Msg) {
+	for _, msg := range msgs {
+		if err := b.send([]sdk.Msg{msg}); err != nil {
+			b.logger.Error("Error while sending queued msg to heimdall", "msg", msgType, "error", err)
+		}
+	}
+}
+
+// awaitApplied checks that the msgs of a sent batch took effect, the msgs not
+// applied within the result timeout are sent again on their own
+func (b *HeimdallBatcher) awaitApplied(msgType string, msgs []sdk.Msg) {
+	defer b.wg.Done()
+
+	timeout := time.NewTimer(b.config.ResultTimeout)
+	defer timeout.Stop()
+	ticker := time.NewTicker(b.config.ResultPollInterval)
+	defer ticker.Stop()
+
+	for len(msgs) > 0 {
+		select {
+		case <-ticker.C:
+			pending := msgs[:0]
+			for _, msg := range msgs {
+				applied, err := b.applied(msg)
+				if err != nil {
+					b.logger.Error("Error while checking if queued msg was applied", "msg", msgType, "error", err)
+				}
+
+				if !applied {
+					pending = append(pending, msg)
+				}
+			}
+			msgs = pending
+
+		case <-timeout.C:
+			b.logger.Error("Batch not applied on heimdall, sending its msgs on their own", "msg", msgType, "msgs", len(msgs))
+			b.sendEach(msgType, msgs)
+			return
+
+		case <-b.quit:
+			return
+		}
+	}
+}
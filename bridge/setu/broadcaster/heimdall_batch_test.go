@@ -0,0 +1,275 @@
+package broadcaster
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	clerkTypes "github.com/maticnetwork/heimdall/x/clerk/types"
+	stakingTypes "github.com/maticnetwork/heimdall/x/staking/types"
+	topupTypes "github.com/maticnetwork/heimdall/x/topup/types"
+)
+
+// fakeHeimdall keeps the msgs of the sent txs and the applied msgs
+type fakeHeimdall struct {
+	mu      sync.Mutex
+	txs     [][]sdk.Msg
+	applied map[string]bool
+
+	// fail fails the broadcast of the txs with the msg
+	fail func(msg sdk.Msg) bool
+
+	// reject votes against the side-txs with the msg, these aren't applied
+	reject func(msg sdk.Msg) bool
+}
+
+func (h *fakeHeimdall) send(msgs []sdk.Msg) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, msg := range msgs {
+		if h.fail != nil && h.fail(msg) {
+			return errors.New("invalid msg")
+		}
+	}
+
+	h.txs = append(h.txs, msgs)
+
+	for _, msg := range msgs {
+		if h.reject != nil && h.reject(msg) {
+			return nil
+		}
+	}
+
+	if h.applied == nil {
+		h.applied = make(map[string]bool)
+	}
+	for _, msg := range msgs {
+		h.applied[msgKey(msg)] = true
+	}
+	return nil
+}
+
+func (h *fakeHeimdall) isApplied(msg sdk.Msg) (bool, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.applied[msgKey(msg)], nil
+}
+
+func (h *fakeHeimdall) sentTxs() [][]sdk.Msg {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.txs
+}
+
+func testHeimdallBatchConfig() HeimdallBatchConfig {
+	return HeimdallBatchConfig{
+		Interval:           time.Hour,
+		MaxMsgs:            3,
+		MaxBytes:           1024 * 1024,
+		MaxGas:             1000000,
+		MsgGas:             100000,
+		ResultTimeout:      time.Second,
+		ResultPollInterval: time.Millisecond,
+	}
+}
+
+func newTestHeimdallBatcher(config HeimdallBatchConfig, heimdall *fakeHeimdall) *HeimdallBatcher {
+	return NewHeimdallBatcher(log.NewNopLogger(), config, heimdall.send, heimdall.isApplied)
+}
+
+// msgKey returns the key of the msg in the applied msgs
+func msgKey(msg sdk.Msg) string {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return proto.MessageName(msg) + ":" + string(bz)
+}
+
+// addAll queues the msgs in order
+func addAll(t *testing.T, b *HeimdallBatcher, msgs ...sdk.Msg) {
+	for _, msg := range msgs {
+		require.NoError(t, b.Add(msg))
+	}
+}
+
+// awaitTxs waits until the number of sent txs is n
+func awaitTxs(t *testing.T, heimdall *fakeHeimdall, n int) [][]sdk.Msg {
+	require.Eventually(t, func() bool {
+		return len(heimdall.sentTxs()) == n
+	}, time.Second, time.Millisecond)
+	return heimdall.sentTxs()
+}
+
+func eventRecordMsg(id uint64) sdk.Msg {
+	return &clerkTypes.MsgEventRecordRequest{Id: id, TxHash: "0x01"}
+}
+
+func TestHeimdallBatcher(t *testing.T) {
+	heimdall := &fakeHeimdall{}
+	b := newTestHeimdallBatcher(testHeimdallBatchConfig(), heimdall)
+	b.Start()
+
+	// a full batch is sent
+	addAll(t, b, eventRecordMsg(1), eventRecordMsg(2), eventRecordMsg(3))
+	awaitTxs(t, heimdall, 1)
+
+	// the rest of the msgs are sent on stop
+	addAll(t, b, eventRecordMsg(4), &topupTypes.MsgTopup{TxHash: "0x02"})
+	b.Stop()
+
+	txs := heimdall.sentTxs()
+	require.Len(t, txs, 3)
+	require.Equal(t, []sdk.Msg{eventRecordMsg(1), eventRecordMsg(2), eventRecordMsg(3)}, txs[0])
+
+	// msgs of a type are sent in their own tx
+	for _, msgs := range txs[1:] {
+		require.Len(t, msgs, 1)
+	}
+
+	require.Error(t, b.Add(eventRecordMsg(5)))
+}
+
+func TestHeimdallBatcherConcurrentAdds(t *testing.T) {
+	config := testHeimdallBatchConfig()
+	config.MaxMsgs = 100
+	config.MaxGas = 100 * config.MsgGas
+
+	heimdall := &fakeHeimdall{}
+	b := newTestHeimdallBatcher(config, heimdall)
+	b.Start()
+
+	// more msgs than task workers, added without waiting for their batch
+	var wg sync.WaitGroup
+	for i := uint64(1); i <= 20; i++ {
+		wg.Add(1)
+		go func(id uint64) {
+			defer wg.Done()
+			require.NoError(t, b.Add(eventRecordMsg(id)))
+		}(i)
+	}
+	wg.Wait()
+	require.Empty(t, heimdall.sentTxs())
+
+	b.Stop()
+	txs := heimdall.sentTxs()
+	require.Len(t, txs, 1)
+	require.Len(t, txs[0], 20)
+}
+
+func TestHeimdallBatcherLimits(t *testing.T) {
+	t.Run("Gas", func(t *testing.T) {
+		config := testHeimdallBatchConfig()
+		config.MaxGas = 200000
+
+		heimdall := &fakeHeimdall{}
+		b := newTestHeimdallBatcher(config, heimdall)
+		b.Start()
+		addAll(t, b, eventRecordMsg(1), eventRecordMsg(2), eventRecordMsg(3))
+		awaitTxs(t, heimdall, 1)
+		b.Stop()
+
+		txs := heimdall.sentTxs()
+		require.Len(t, txs, 2)
+		require.Len(t, txs[0], 2)
+	})
+
+	t.Run("Bytes", func(t *testing.T) {
+		config := testHeimdallBatchConfig()
+		config.MaxBytes = 1
+
+		heimdall := &fakeHeimdall{}
+		b := newTestHeimdallBatcher(config, heimdall)
+		b.Start()
+		addAll(t, b, eventRecordMsg(1), eventRecordMsg(2))
+		b.Stop()
+
+		txs := heimdall.sentTxs()
+		require.Len(t, txs, 2)
+		require.Len(t, txs[0], 1)
+	})
+
+	t.Run("Interval", func(t *testing.T) {
+		config := testHeimdallBatchConfig()
+		config.Interval = 10 * time.Millisecond
+
+		heimdall := &fakeHeimdall{}
+		b := newTestHeimdallBatcher(config, heimdall)
+		b.Start()
+		defer b.Stop()
+
+		require.NoError(t, b.Add(eventRecordMsg(1)))
+		awaitTxs(t, heimdall, 1)
+	})
+}
+
+func TestHeimdallBatcherFailedBatch(t *testing.T) {
+	heimdall := &fakeHeimdall{
+		fail: func(msg sdk.Msg) bool {
+			return msg.(*clerkTypes.MsgEventRecordRequest).Id == 2
+		},
+	}
+	b := newTestHeimdallBatcher(testHeimdallBatchConfig(), heimdall)
+	b.Start()
+	addAll(t, b, eventRecordMsg(1), eventRecordMsg(2), eventRecordMsg(3))
+	b.Stop()
+
+	// the valid msgs are sent one by one
+	require.Equal(t, [][]sdk.Msg{{eventRecordMsg(1)}, {eventRecordMsg(3)}}, heimdall.sentTxs())
+}
+
+func TestHeimdallBatcherRejectedBatch(t *testing.T) {
+	heimdall := &fakeHeimdall{
+		reject: func(msg sdk.Msg) bool {
+			return msg.(*clerkTypes.MsgEventRecordRequest).Id == 2
+		},
+	}
+	config := testHeimdallBatchConfig()
+	config.ResultTimeout = 50 * time.Millisecond
+	b := newTestHeimdallBatcher(config, heimdall)
+	b.Start()
+	defer b.Stop()
+
+	// the msgs of a batch which isn't applied are sent on their own
+	addAll(t, b, eventRecordMsg(1), eventRecordMsg(2), eventRecordMsg(3))
+	require.Equal(t, [][]sdk.Msg{
+		{eventRecordMsg(1), eventRecordMsg(2), eventRecordMsg(3)},
+		{eventRecordMsg(1)}, {eventRecordMsg(2)}, {eventRecordMsg(3)},
+	}, awaitTxs(t, heimdall, 4))
+
+	for _, id := range []uint64{1, 3} {
+		applied, _ := heimdall.isApplied(eventRecordMsg(id))
+		require.True(t, applied)
+	}
+
+	// following msgs are batched again
+	addAll(t, b, eventRecordMsg(4), eventRecordMsg(5), eventRecordMsg(6))
+	txs := awaitTxs(t, heimdall, 5)
+	require.Len(t, txs[4], 3)
+}
+
+func TestHeimdallBatcherStopWhileAwaitingApplied(t *testing.T) {
+	heimdall := &fakeHeimdall{reject: func(msg sdk.Msg) bool { return true }}
+	b := newTestHeimdallBatcher(testHeimdallBatchConfig(), heimdall)
+	b.Start()
+
+	addAll(t, b, eventRecordMsg(1), eventRecordMsg(2), eventRecordMsg(3))
+	awaitTxs(t, heimdall, 1)
+
+	// the msgs are left to the retries of their events
+	b.Stop()
+	require.Len(t, heimdall.sentTxs(), 1)
+}
+
+func TestIsBatchable(t *testing.T) {
+	require.True(t, IsBatchable(&clerkTypes.MsgEventRecordRequest{}))
+	require.True(t, IsBatchable(&topupTypes.MsgTopup{}))
+	require.False(t, IsBatchable(&stakingTypes.MsgValidatorJoin{}))
+}
@@ -0,0 +1,409 @@
+package broadcaster
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	clerkTypes "github.com/maticnetwork/heimdall/x/clerk/types"
+)
+
+// fakeHeimdallNode keeps the txs in a mempool and commits them on demand
+type fakeHeimdallNode struct {
+	mu sync.Mutex
+
+	sequence         uint64 // sequence of the account in the latest block
+	sequenceRequests int
+
+	signed    map[string]signedFakeTx
+	mempool   map[uint64][]byte
+	committed map[string]int64
+
+	// reject fails CheckTx of the txs with the msg
+	reject func(msg sdk.Msg) bool
+
+	// unknownMsgs makes the effect of the msgs unknown
+	unknownMsgs bool
+	// searchErr fails the tx search, as with the tx index disabled
+	searchErr error
+}
+
+type signedFakeTx struct {
+	sequence uint64
+	msgs     []sdk.Msg
+}
+
+func newFakeHeimdallNode(sequence uint64) *fakeHeimdallNode {
+	return &fakeHeimdallNode{
+		sequence:  sequence,
+		signed:    make(map[string]signedFakeTx),
+		mempool:   make(map[uint64][]byte),
+		committed: make(map[string]int64),
+	}
+}
+
+func (n *fakeHeimdallNode) Sequence() (uint64, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.sequenceRequests++
+	return n.sequence, nil
+}
+
+func (n *fakeHeimdallNode) SignTx(msgs []sdk.Msg, sequence uint64) ([]byte, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	txBytes := []byte(fmt.Sprintf("%d:%v", sequence, msgs))
+	n.signed[string(txBytes)] = signedFakeTx{sequence: sequence, msgs: msgs}
+	return txBytes, nil
+}
+
+func (n *fakeHeimdallNode) DecodeMsgs(txBytes []byte) ([]sdk.Msg, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	tx, ok := n.signed[string(txBytes)]
+	if !ok {
+		return nil, errors.New("unknown tx")
+	}
+	return tx.msgs, nil
+}
+
+func (n *fakeHeimdallNode) BroadcastTx(txBytes []byte) (*sdk.TxResponse, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	tx := n.signed[string(txBytes)]
+	if string(n.mempool[tx.sequence]) == string(txBytes) {
+		return &sdk.TxResponse{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrTxInMempoolCache.ABCICode()}, nil
+	}
+
+	// CheckTx state includes the txs in the mempool
+	if tx.sequence != n.sequence+uint64(len(n.mempool)) {
+		return &sdk.TxResponse{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrWrongSequence.ABCICode(), RawLog: "account sequence mismatch"}, nil
+	}
+
+	for _, msg := range tx.msgs {
+		if n.reject != nil && n.reject(msg) {
+			return &sdk.TxResponse{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrInvalidRequest.ABCICode(), RawLog: "invalid msg"}, nil
+		}
+	}
+
+	n.mempool[tx.sequence] = txBytes
+	return &sdk.TxResponse{TxHash: fmt.Sprintf("%X", tmTypes.Tx(txBytes).Hash())}, nil
+}
+
+func (n *fakeHeimdallNode) SearchTx(hash []byte) (*ctypes.ResultTx, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.searchErr != nil {
+		return nil, n.searchErr
+	}
+	height, ok := n.committed[string(hash)]
+	if !ok {
+		return nil, nil
+	}
+	return &ctypes.ResultTx{Hash: hash, Height: height, TxResult: abci.ResponseDeliverTx{Code: abci.CodeTypeOK}}, nil
+}
+
+func (n *fakeHeimdallNode) MsgsApplied(msgs []sdk.Msg) (bool, bool, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.unknownMsgs {
+		return false, false, nil
+	}
+
+	for txBytes, tx := range n.signed {
+		if _, ok := n.committed[string(tmTypes.Tx(txBytes).Hash())]; !ok {
+			continue
+		}
+		for _, committedMsg := range tx.msgs {
+			for _, msg := range msgs {
+				if committedMsg.String() == msg.String() {
+					return true, true, nil
+				}
+			}
+		}
+	}
+	return false, true, nil
+}
+
+// commit includes the mempool txs in a block
+func (n *fakeHeimdallNode) commit() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	sequences := make([]uint64, 0, len(n.mempool))
+	for sequence := range n.mempool {
+		sequences = append(sequences, sequence)
+	}
+	sort.Slice(sequences, func(i, j int) bool { return sequences[i] < sequences[j] })
+
+	for _, sequence := range sequences {
+		n.committed[string(tmTypes.Tx(n.mempool[sequence]).Hash())] = 10
+		n.sequence++
+	}
+	n.mempool = make(map[uint64][]byte)
+}
+
+// dropMempool drops the txs of the mempool, e.g. after a node restart
+func (n *fakeHeimdallNode) dropMempool() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.mempool = make(map[uint64][]byte)
+}
+
+func testHeimdallTxManagerConfig() HeimdallTxManagerConfig {
+	return HeimdallTxManagerConfig{
+		ResubmitInterval: time.Hour,
+		PollInterval:     time.Second,
+		MaxResubmits:     HeimdallTxMaxResubmits,
+	}
+}
+
+func newTestHeimdallTxManager(t *testing.T, node *fakeHeimdallNode, config HeimdallTxManagerConfig) *HeimdallTxManager {
+	m, err := NewHeimdallTxManager(log.NewNopLogger(), node, newTestDB(t), config)
+	require.NoError(t, err)
+	return m
+}
+
+func recordMsgs(ids ...uint64) []sdk.Msg {
+	msgs := make([]sdk.Msg, 0, len(ids))
+	for _, id := range ids {
+		msgs = append(msgs, &clerkTypes.MsgEventRecordRequest{Id: id})
+	}
+	return msgs
+}
+
+func TestHeimdallTxManagerSequences(t *testing.T) {
+	node := newFakeHeimdallNode(7)
+	m := newTestHeimdallTxManager(t, node, testHeimdallTxManagerConfig())
+
+	for i := uint64(1); i <= 3; i++ {
+		_, err := m.Send(recordMsgs(i))
+		require.NoError(t, err)
+	}
+
+	txs := m.PendingTxs()
+	require.Len(t, txs, 3)
+	for i, tx := range txs {
+		require.Equal(t, uint64(7+i), tx.Sequence)
+	}
+
+	// sequence is tracked locally
+	require.Equal(t, 1, node.sequenceRequests)
+	require.Len(t, node.mempool, 3)
+
+	// committed txs stop being tracked
+	node.commit()
+	m.checkPendingTxs()
+	require.Empty(t, m.PendingTxs())
+
+	txs, err := m.loadTxs()
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	_, err = m.Send(recordMsgs(4))
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), m.PendingTxs()[0].Sequence)
+}
+
+func TestHeimdallTxManagerResendsDroppedTxs(t *testing.T) {
+	node := newFakeHeimdallNode(0)
+	m := newTestHeimdallTxManager(t, node, testHeimdallTxManagerConfig())
+
+	firstHash, err := m.Send(recordMsgs(1))
+	require.NoError(t, err)
+	_, err = m.Send(recordMsgs(2))
+	require.NoError(t, err)
+
+	// txs in the mempool aren't sent again
+	m.config.ResubmitInterval = 0
+	m.checkPendingTxs()
+	require.Len(t, node.mempool, 2)
+
+	// dropped txs are sent again as they are
+	node.dropMempool()
+	m.checkPendingTxs()
+	require.Len(t, node.mempool, 2)
+
+	txs := m.PendingTxs()
+	require.Len(t, txs[0].Hashes, 1)
+	require.Equal(t, firstHash, txs[0].Hash())
+
+	node.commit()
+	m.checkPendingTxs()
+	require.Empty(t, m.PendingTxs())
+}
+
+func TestHeimdallTxManagerFillsSequenceGap(t *testing.T) {
+	node := newFakeHeimdallNode(0)
+	m := newTestHeimdallTxManager(t, node, testHeimdallTxManagerConfig())
+
+	for i := uint64(1); i <= 3; i++ {
+		_, err := m.Send(recordMsgs(i))
+		require.NoError(t, err)
+	}
+
+	// first tx became invalid while the mempool was dropped
+	node.dropMempool()
+	node.reject = func(msg sdk.Msg) bool {
+		return msg.(*clerkTypes.MsgEventRecordRequest).Id == 1
+	}
+
+	m.config.ResubmitInterval = 0
+	m.checkPendingTxs()
+
+	// the next txs take its sequence
+	txs := m.PendingTxs()
+	require.Len(t, txs, 2)
+	for i, tx := range txs {
+		require.Equal(t, uint64(i), tx.Sequence)
+		require.Len(t, tx.Hashes, 2)
+	}
+	require.Len(t, node.mempool, 2)
+
+	_, err := m.Send(recordMsgs(4))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), m.PendingTxs()[2].Sequence)
+
+	// restarted manager tracks the moved txs
+	restarted, err := NewHeimdallTxManager(log.NewNopLogger(), node, m.db, testHeimdallTxManagerConfig())
+	require.NoError(t, err)
+	restartedTxs := restarted.PendingTxs()
+	require.Len(t, restartedTxs, 3)
+	for i, tx := range m.PendingTxs() {
+		require.Equal(t, tx.Sequence, restartedTxs[i].Sequence)
+		require.Equal(t, tx.Hashes, restartedTxs[i].Hashes)
+	}
+}
+
+func TestHeimdallTxManagerUsedSequence(t *testing.T) {
+	node := newFakeHeimdallNode(0)
+	m := newTestHeimdallTxManager(t, node, testHeimdallTxManagerConfig())
+
+	firstHash, err := m.Send(recordMsgs(1))
+	require.NoError(t, err)
+
+	// another tx with the sequence got committed
+	node.dropMempool()
+	node.sequence = 1
+
+	// the tx may be committed but not indexed yet
+	m.checkPendingTxs()
+	require.Equal(t, firstHash, m.PendingTxs()[0].Hash())
+
+	m.config.ResubmitInterval = 0
+	m.checkPendingTxs()
+	tx := m.PendingTxs()[0]
+	require.Equal(t, uint64(1), tx.Sequence)
+	require.Equal(t, firstHash, tx.Hashes[0])
+	require.Len(t, node.mempool, 1)
+
+	// sequence used outside of the manager, send retries with the chain sequence
+	node.commit()
+	node.sequence = 5
+	_, err = m.Send(recordMsgs(2))
+	require.NoError(t, err)
+
+	m.checkPendingTxs()
+	txs := m.PendingTxs()
+	require.Len(t, txs, 1)
+	require.Equal(t, uint64(5), txs[0].Sequence)
+}
+
+func TestHeimdallTxManagerRejectedTx(t *testing.T) {
+	node := newFakeHeimdallNode(3)
+	node.reject = func(msg sdk.Msg) bool { return true }
+	m := newTestHeimdallTxManager(t, node, testHeimdallTxManagerConfig())
+
+	_, err := m.Send(recordMsgs(1))
+	require.True(t, errors.Is(err, sdkerrors.ErrInvalidRequest))
+	require.Empty(t, m.PendingTxs())
+
+	txs, err := m.loadTxs()
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	// sequence isn't used by the rejected tx
+	node.reject = nil
+	_, err = m.Send(recordMsgs(1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), m.PendingTxs()[0].Sequence)
+}
+
+func TestHeimdallTxManagerSearchError(t *testing.T) {
+	node := newFakeHeimdallNode(0)
+	m := newTestHeimdallTxManager(t, node, testHeimdallTxManagerConfig())
+
+	_, err := m.Send(recordMsgs(1))
+	require.NoError(t, err)
+
+	// dropped txs are sent again without the tx index
+	node.searchErr = errors.New("transaction indexing is disabled")
+	node.dropMempool()
+	m.config.ResubmitInterval = 0
+	m.checkPendingTxs()
+	require.Len(t, node.mempool, 1)
+
+	// committed txs are told by their msgs
+	node.commit()
+	m.checkPendingTxs()
+	require.Empty(t, m.PendingTxs())
+	require.Empty(t, node.mempool)
+}
+
+func TestHeimdallTxManagerUsedSequenceUnknownMsgs(t *testing.T) {
+	node := newFakeHeimdallNode(0)
+	m := newTestHeimdallTxManager(t, node, testHeimdallTxManagerConfig())
+
+	_, err := m.Send(recordMsgs(1))
+	require.NoError(t, err)
+
+	// the tx may have been committed without being indexed
+	node.dropMempool()
+	node.sequence = 1
+	node.unknownMsgs = true
+
+	m.config.ResubmitInterval = 0
+	m.checkPendingTxs()
+	require.Empty(t, m.PendingTxs())
+	require.Empty(t, node.mempool)
+}
+
+func TestHeimdallTxManagerMaxResubmits(t *testing.T) {
+	node := newFakeHeimdallNode(0)
+	config := testHeimdallTxManagerConfig()
+	config.MaxResubmits = 2
+	m := newTestHeimdallTxManager(t, node, config)
+
+	_, err := m.Send(recordMsgs(1))
+	require.NoError(t, err)
+
+	m.config.ResubmitInterval = 0
+	for i := uint64(1); i <= 2; i++ {
+		node.dropMempool()
+		m.checkPendingTxs()
+		require.Len(t, node.mempool, 1)
+		require.Equal(t, i, m.PendingTxs()[0].Resubmits)
+	}
+
+	// the count survives restarts
+	restarted, err := NewHeimdallTxManager(log.NewNopLogger(), node, m.db, config)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), restarted.PendingTxs()[0].Resubmits)
+
+	node.dropMempool()
+	m.checkPendingTxs()
+	require.Empty(t, m.PendingTxs())
+	require.Empty(t, node.mempool)
+}
@@ -0,0 +1,152 @@
+package broadcaster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/pflag"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
+)
+
+// HeimdallTxClient is the heimdall node api used by the heimdall tx manager
+type HeimdallTxClient interface {
+	// Sequence returns the sequence of the validator account in the latest block
+	Sequence() (uint64, error)
+	// SignTx builds the tx of the msgs and signs it with the sequence
+	SignTx(msgs []sdk.Msg, sequence uint64) ([]byte, error)
+	// DecodeMsgs returns the msgs of the encoded tx
+	DecodeMsgs(txBytes []byte) ([]sdk.Msg, error)
+	// BroadcastTx sends the tx, returning its CheckTx result
+	BroadcastTx(txBytes []byte) (*sdk.TxResponse, error)
+	// SearchTx returns the committed tx with the hash, nil if it's not committed
+	SearchTx(hash []byte) (*ctypes.ResultTx, error)
+	// MsgsApplied checks if the msgs took effect on heimdall, known is false when
+	// it can't be told from the msgs
+	MsgsApplied(msgs []sdk.Msg) (applied bool, known bool, err error)
+}
+
+// cliHeimdallTxClient signs the txs with the validator key and sends them
+// through the tendermint rpc of the cli context
+type cliHeimdallTxClient struct {
+	cliCtx  client.Context
+	flagSet *pflag.FlagSet
+	address hmCommonTypes.HeimdallAddress
+	accNum  uint64
+}
+
+// NewHeimdallTxClient creates heimdall tx client of the validator account
+func NewHeimdallTxClient(cliCtx client.Context, flagSet *pflag.FlagSet) (HeimdallTxClient, error) {
+	address := hmCommonTypes.BytesToHeimdallAddress(helper.GetAddress())
+	account, err := util.GetAccount(cliCtx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cliHeimdallTxClient{
+		cliCtx:  cliCtx,
+		flagSet: flagSet,
+		address: address,
+		accNum:  account.GetAccountNumber(),
+	}, nil
+}
+
+func (c *cliHeimdallTxClient) Sequence() (uint64, error) {
+	account, err := util.GetAccount(c.cliCtx, c.address)
+	if err != nil {
+		return 0, err
+	}
+	return account.GetSequence(), nil
+}
+
+func (c *cliHeimdallTxClient) SignTx(msgs []sdk.Msg, sequence uint64) ([]byte, error) {
+	txf := tx.NewFactoryCLI(c.cliCtx, c.flagSet).
+		WithAccountNumber(c.accNum).
+		WithSequence(sequence).
+		WithChainID(helper.GetGenesisDoc().ChainID).
+		WithTxConfig(c.cliCtx.TxConfig).
+		WithAccountRetriever(c.cliCtx.AccountRetriever)
+
+	// each msg of a multi-msg tx needs the gas of a single msg tx
+	txf = txf.WithGas(txf.Gas() * uint64(len(msgs)))
+
+	return helper.GetSignedTxBytesNew(c.cliCtx, txf, msgs)
+}
+
+func (c *cliHeimdallTxClient) DecodeMsgs(txBytes []byte) ([]sdk.Msg, error) {
+	decoded, err := c.cliCtx.TxConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	return decoded.GetMsgs(), nil
+}
+
+func (c *cliHeimdallTxClient) BroadcastTx(txBytes []byte) (*sdk.TxResponse, error) {
+	// sync mode returns the CheckTx result, async txs rejected by CheckTx go unnoticed
+	return helper.BroadcastTxBytes(c.cliCtx, txBytes, flags.BroadcastSync)
+}
+
+func (c *cliHeimdallTxClient) SearchTx(hash []byte) (*ctypes.ResultTx, error) {
+	node, err := c.cliCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := node.TxSearch(context.Background(), fmt.Sprintf("tx.hash='%X'", hash), false, nil, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Txs) == 0 {
+		return nil, nil
+	}
+
+	return result.Txs[0], nil
+}
+
+func (c *cliHeimdallTxClient) MsgsApplied(msgs []sdk.Msg) (bool, bool, error) {
+	return heimdallMsgsApplied(msgs)
+}
+
+// HeimdallTx is a tx sent to heimdall by the validator, tracked until it's committed.
+// Txs are signed again with a new sequence when their sequence is used by another
+// tx, so any of its hashes may get committed.
+type HeimdallTx struct {
+	Sequence uint64             `json:"sequence"`
+	TxBytes  []byte             `json:"txBytes"`
+	Hashes   []tmbytes.HexBytes `json:"hashes"` // hashes of the signed versions, latest last
+	SentAt   time.Time          `json:"sentAt"` // time the latest version was sent
+
+	Resubmits uint64 `json:"resubmits"` // times the tx was sent again
+}
+
+// Hash returns the hash of the latest version of the tx
+func (tx *HeimdallTx) Hash() tmbytes.HexBytes {
+	if len(tx.Hashes) == 0 {
+		return nil
+	}
+	return tx.Hashes[len(tx.Hashes)-1]
+}
+
+// isInMempool checks if CheckTx reported the tx as already in the mempool
+func isInMempool(res *sdk.TxResponse) bool {
+	return res.Codespace == sdkerrors.RootCodespace && res.Code == sdkerrors.ErrTxInMempoolCache.ABCICode()
+}
+
+// checkTxError returns the error of the tx rejected by CheckTx
+func checkTxError(res *sdk.TxResponse) error {
+	if res.Code == abci.CodeTypeOK || isInMempool(res) {
+		return nil
+	}
+	return sdkerrors.ABCIError(res.Codespace, res.Code, res.RawLog)
+}
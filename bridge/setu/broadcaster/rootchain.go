@@ -0,0 +1,487 @@
+package broadcaster
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	// rootChainTxPrefix is the bridge db prefix of pending rootchain txs
+	rootChainTxPrefix = "rootchain-tx-"
+
+	// RootChainTxPollInterval is how often pending rootchain txs are checked
+	RootChainTxPollInterval = 15 * time.Second
+
+	rootChainTxTimeout = 30 * time.Second
+)
+
+var errNoRootChainTxManager = errors.New("rootchain tx manager is not started")
+
+// RootChainTxManagerConfig configures the rootchain tx manager
+type RootChainTxManagerConfig struct {
+	Confirmations    uint64        // blocks on top of the tx block before it's confirmed
+	ResubmitInterval time.Duration // time after which a pending tx is considered stuck
+	GasPriceBump     uint64        // percent the fees are raised by when replacing a stuck tx
+	MaxGasPrice      *big.Int      // cap of the gas price or fee cap, nil for no cap
+	DefaultGasLimit  uint64        // gas limit used when estimation fails
+	DynamicFee       bool          // send EIP-1559 txs
+	PollInterval     time.Duration // how often pending txs are checked
+}
+
+// RootChainTxManager sends the txs of the validator to the rootchain. It keeps
+// the nonce locally so txs don't wait on each other, replaces txs stuck in the
+// mempool with higher fees and tracks them until they are confirmed. Pending
+// txs are kept in the bridge db to be tracked across restarts.
+type RootChainTxManager struct {
+	logger  log.Logger
+	client  RootChainClient
+	signer  helper.Signer
+	config  RootChainTxManagerConfig
+	db      *leveldb.DB
+	chainID *big.Int
+	from    common.Address
+
+	mu          sync.Mutex
+	nonce       uint64 // next nonce
+	nonceLoaded bool
+	pending     map[uint64]*RootChainTx
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewRootChainTxManager creates the tx manager and loads the pending txs
+func NewRootChainTxManager(logger log.Logger, client RootChainClient, signer helper.Signer, db *leveldb.DB, config RootChainTxManagerConfig) (*RootChainTxManager, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rootChainTxTimeout)
+	defer cancel()
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &RootChainTxManager{
+		logger:  logger,
+		client:  client,
+		signer:  signer,
+		config:  config,
+		db:      db,
+		chainID: chainID,
+		from:    common.BytesToAddress(signer.PubKey().Address()),
+		pending: make(map[uint64]*RootChainTx),
+		quit:    make(chan struct{}),
+	}
+
+	txs, err := m.loadTxs()
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range txs {
+		m.pending[tx.Nonce] = tx
+	}
+	if len(txs) > 0 {
+		m.logger.Info("Loaded pending rootchain txs", "count", len(txs))
+	}
+
+	return m, nil
+}
+
+// Start starts tracking the pending txs
+func (m *RootChainTxManager) Start() {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+
+		ticker := time.NewTicker(m.config.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				m.checkPendingTxs()
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops tracking the pending txs, they are tracked again after a restart
+func (m *RootChainTxManager) Stop() {
+	close(m.quit)
+	m.wg.Wait()
+}
+
+// PendingTxs returns the txs which are not confirmed yet, ordered by nonce
+func (m *RootChainTxManager) PendingTxs() []*RootChainTx {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	txs := make([]*RootChainTx, 0, len(m.pending))
+	for _, tx := range m.pending {
+		txs = append(txs, tx)
+	}
+	sort.Slice(txs, func(i, j int) bool { return txs[i].Nonce < txs[j].Nonce })
+
+	return txs
+}
+
+// Send signs and broadcasts the tx with the next nonce, it's then tracked until
+// it's confirmed
+func (m *RootChainTxManager) Send(method string, to common.Address, data []byte) (common.Hash, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), rootChainTxTimeout)
+	defer cancel()
+
+	nonce, err := m.nextNonce(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	gasLimit, err := m.client.EstimateGas(ctx, ethereum.CallMsg{From: m.from, To: &to, Data: data})
+	if err != nil {
+		if m.config.DefaultGasLimit == 0 {
+			return common.Hash{}, err
+		}
+		m.logger.Info("Unable to estimate gas, using default gas limit", "method", method, "gasLimit", m.config.DefaultGasLimit, "error", err)
+		gasLimit = m.config.DefaultGasLimit
+	}
+
+	tx := &RootChainTx{
+		Method:   method,
+		Nonce:    nonce,
+		To:       to,
+		Data:     data,
+		GasLimit: gasLimit,
+	}
+	if err := m.setFees(ctx, tx, false); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := m.broadcast(ctx, tx); err != nil {
+		if isNonceTooLow(err) {
+			// nonce used outside of the manager, reload it for the next tx
+			m.nonceLoaded = false
+		}
+		if deleteErr := m.deleteTx(nonce); deleteErr != nil {
+			m.logger.Error("Error while deleting rootchain tx", "nonce", nonce, "error", deleteErr)
+		}
+		return common.Hash{}, err
+	}
+
+	m.nonce = nonce + 1
+	m.pending[nonce] = tx
+
+	m.logger.Info("Sent rootchain tx", "method", method, "txHash", tx.Hash(), "nonce", nonce, "gasLimit", gasLimit, "gasPrice", tx.GasPrice, "gasFeeCap", tx.GasFeeCap, "gasTipCap", tx.GasTipCap)
+	return tx.Hash(), nil
+}
+
+// nextNonce returns the nonce of the next tx, loading it from the chain the first time
+func (m *RootChainTxManager) nextNonce(ctx context.Context) (uint64, error) {
+	if m.nonceLoaded {
+		return m.nonce, nil
+	}
+
+	nonce, err := m.client.PendingNonceAt(ctx, m.from)
+	if err != nil {
+		return 0, err
+	}
+
+	// txs still pending may have been dropped by the node
+	for pendingNonce := range m.pending {
+		if pendingNonce >= nonce {
+			nonce = pendingNonce + 1
+		}
+	}
+
+	m.nonce = nonce
+	m.nonceLoaded = true
+	return nonce, nil
+}
+
+// broadcast signs the tx with its current fees, stores and sends it. The tx is
+// stored first so it's tracked even if the bridge stops right after sending it.
+func (m *RootChainTxManager) broadcast(ctx context.Context, tx *RootChainTx) error {
+	rawTx, hash, err := signRootChainTx(tx, m.chainID, m.signer)
+	if err != nil {
+		return err
+	}
+
+	tx.Hashes = append(tx.Hashes, hash)
+	tx.SentAt = time.Now()
+	if err := m.putTx(tx); err != nil {
+		return err
+	}
+
+	start := time.Now()
+	err = m.client.SendRawTransaction(ctx, rawTx)
+	metrics.BroadcastDuration.WithLabelValues(metrics.RootChain, metrics.BroadcastStatus(err)).Observe(time.Since(start).Seconds())
+	if err != nil && !isAlreadyKnown(err) {
+		return err
+	}
+
+	return nil
+}
+
+// setFees sets the fees of the tx from the node suggestions, bumping the previous
+// fees of replaced txs so the node accepts the replacement
+func (m *RootChainTxManager) setFees(ctx context.Context, tx *RootChainTx, bump bool) error {
+	if m.config.DynamicFee {
+		tipCap, err := m.client.SuggestGasTipCap(ctx)
+		if err != nil {
+			return err
+		}
+
+		baseFee, err := m.client.BaseFee(ctx)
+		if err != nil {
+			return err
+		}
+
+		// fee cap stays above the base fee for a few full blocks
+		feeCap := new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), tipCap)
+
+		if bump && tx.IsDynamicFee() {
+			tipCap = maxBig(tipCap, m.bumped(tx.GasTipCap))
+			feeCap = maxBig(feeCap, m.bumped(tx.GasFeeCap))
+		}
+
+		feeCap = m.capped(feeCap)
+		if tipCap.Cmp(feeCap) > 0 {
+			tipCap = feeCap
+		}
+
+		tx.GasPrice, tx.GasTipCap, tx.GasFeeCap = nil, tipCap, feeCap
+		return nil
+	}
+
+	gasPrice, err := m.client.SuggestGasPrice(ctx)
+	if err != nil {
+		return err
+	}
+
+	if bump && tx.GasPrice != nil {
+		gasPrice = maxBig(gasPrice, m.bumped(tx.GasPrice))
+	}
+
+	tx.GasPrice, tx.GasTipCap, tx.GasFeeCap = m.capped(gasPrice), nil, nil
+	return nil
+}
+
+// bumped returns the fee raised by the bump percentage, and at least by one wei
+func (m *RootChainTxManager) bumped(fee *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(fee, new(big.Int).SetUint64(100+m.config.GasPriceBump))
+	bumped.Div(bumped, big.NewInt(100))
+	return maxBig(bumped, new(big.Int).Add(fee, big.NewInt(1)))
+}
+
+// capped returns the fee limited by the max gas price
+func (m *RootChainTxManager) capped(fee *big.Int) *big.Int {
+	if m.config.MaxGasPrice != nil && m.config.MaxGasPrice.Sign() > 0 && fee.Cmp(m.config.MaxGasPrice) > 0 {
+		return new(big.Int).Set(m.config.MaxGasPrice)
+	}
+	return fee
+}
+
+// checkPendingTxs confirms mined txs, drops txs whose nonce was used by another
+// tx and replaces stuck txs
+func (m *RootChainTxManager) checkPendingTxs() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.pending) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), rootChainTxTimeout)
+	defer cancel()
+
+	head, err := m.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		m.logger.Error("Error while fetching rootchain head", "error", err)
+		return
+	}
+
+	// nonce of the account in the latest block
+	minedNonce, err := m.client.NonceAt(ctx, m.from, nil)
+	if err != nil {
+		m.logger.Error("Error while fetching rootchain nonce", "error", err)
+		return
+	}
+
+	nonces := make([]uint64, 0, len(m.pending))
+	for nonce := range m.pending {
+		nonces = append(nonces, nonce)
+	}
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+	for _, nonce := range nonces {
+		tx := m.pending[nonce]
+
+		receipt, err := m.findReceipt(ctx, tx)
+		if err != nil {
+			m.logger.Error("Error while fetching rootchain tx receipt", "nonce", nonce, "error", err)
+			continue
+		}
+
+		if receipt != nil {
+			// the receipt can be from a block newer than the head fetched before
+			if head.Number.Cmp(receipt.BlockNumber) < 0 {
+				continue
+			}
+
+			confirmations := new(big.Int).Sub(head.Number, receipt.BlockNumber).Uint64() + 1
+			if confirmations >= m.config.Confirmations {
+				m.confirm(tx, receipt)
+			}
+			continue
+		}
+
+		if nonce < minedNonce {
+			m.logger.Error("Rootchain tx nonce was used by another tx, dropping it", "method", tx.Method, "nonce", nonce, "txHash", tx.Hash())
+			m.remove(nonce)
+			continue
+		}
+
+		if time.Since(tx.SentAt) >= m.config.ResubmitInterval {
+			m.resubmit(ctx, tx)
+		}
+	}
+}
+
+// findReceipt returns the receipt of the mined version of the tx, if any
+func (m *RootChainTxManager) findReceipt(ctx context.Context, tx *RootChainTx) (*types.Receipt, error) {
+	for i := len(tx.Hashes) - 1; i >= 0; i-- {
+		receipt, err := m.client.TransactionReceipt(ctx, tx.Hashes[i])
+		if err == ethereum.NotFound {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		if receipt != nil && receipt.BlockNumber != nil {
+			return receipt, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// confirm stops tracking the confirmed tx
+func (m *RootChainTxManager) confirm(tx *RootChainTx, receipt *types.Receipt) {
+	if receipt.Status == types.ReceiptStatusFailed {
+		m.logger.Error("Rootchain tx reverted", "method", tx.Method, "txHash", receipt.TxHash, "nonce", tx.Nonce, "blockNumber", receipt.BlockNumber)
+	} else {
+		m.logger.Info("Rootchain tx confirmed", "method", tx.Method, "txHash", receipt.TxHash, "nonce", tx.Nonce, "blockNumber", receipt.BlockNumber, "gasUsed", receipt.GasUsed)
+	}
+
+	metrics.RootChainGasUsed.WithLabelValues(tx.Method).Add(float64(receipt.GasUsed))
+	m.remove(tx.Nonce)
+}
+
+// resubmit replaces the stuck tx with a version paying higher fees
+func (m *RootChainTxManager) resubmit(ctx context.Context, tx *RootChainTx) {
+	// sign a copy, the stored tx is only replaced once the new version is sent
+	replacement := *tx
+	replacement.Hashes = append([]common.Hash{}, tx.Hashes...)
+	if err := m.setFees(ctx, &replacement, true); err != nil {
+		m.logger.Error("Error while fetching rootchain fees", "error", err)
+		return
+	}
+
+	m.logger.Info("Rootchain tx is stuck, replacing it", "method", tx.Method, "nonce", tx.Nonce, "txHash", tx.Hash(), "pendingSince", tx.SentAt,
+		"gasPrice", replacement.GasPrice, "gasFeeCap", replacement.GasFeeCap, "gasTipCap", replacement.GasTipCap)
+
+	if err := m.broadcast(ctx, &replacement); err != nil {
+		m.logger.Error("Error while replacing rootchain tx", "nonce", tx.Nonce, "error", err)
+	}
+
+	// keep the new hash even if sending failed, the node may have accepted it
+	m.pending[tx.Nonce] = &replacement
+}
+
+// remove stops tracking the tx with the nonce
+func (m *RootChainTxManager) remove(nonce uint64) {
+	delete(m.pending, nonce)
+	if err := m.deleteTx(nonce); err != nil {
+		m.logger.Error("Error while deleting rootchain tx", "nonce", nonce, "error", err)
+	}
+}
+
+//
+// Storage
+//
+
+func (m *RootChainTxManager) putTx(tx *RootChainTx) error {
+	value, err := json.Marshal(tx)
+	if err != nil {
+		return err
+	}
+	return m.db.Put(rootChainTxKey(tx.Nonce), value, nil)
+}
+
+func (m *RootChainTxManager) deleteTx(nonce uint64) error {
+	return m.db.Delete(rootChainTxKey(nonce), nil)
+}
+
+func (m *RootChainTxManager) loadTxs() ([]*RootChainTx, error) {
+	iter := m.db.NewIterator(leveldbUtil.BytesPrefix([]byte(rootChainTxPrefix)), nil)
+	defer iter.Release()
+
+	var txs []*RootChainTx
+	for iter.Next() {
+		var tx RootChainTx
+		if err := json.Unmarshal(iter.Value(), &tx); err != nil {
+			return nil, err
+		}
+		txs = append(txs, &tx)
+	}
+
+	return txs, iter.Error()
+}
+
+func rootChainTxKey(nonce uint64) []byte {
+	key := make([]byte, len(rootChainTxPrefix)+8)
+	copy(key, rootChainTxPrefix)
+	binary.BigEndian.PutUint64(key[len(rootChainTxPrefix):], nonce)
+	return key
+}
+
+//
+// Helpers
+//
+
+func maxBig(a *big.Int, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
+
+// isAlreadyKnown checks if the node already has the tx
+func isAlreadyKnown(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "already known")
+}
+
+// isNonceTooLow checks if the nonce of the tx was already used
+func isNonceTooLow(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "nonce too low")
+}
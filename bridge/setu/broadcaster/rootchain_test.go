@@ -0,0 +1,309 @@
+package broadcaster
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// fakeRootChain keeps the sent txs and mines them on demand
+type fakeRootChain struct {
+	mu sync.Mutex
+
+	gasPrice *big.Int
+	tipCap   *big.Int
+	baseFee  *big.Int
+
+	head          uint64
+	minedNonce    uint64
+	pendingNonce  uint64
+	nonceRequests int
+
+	sent     [][]byte
+	receipts map[common.Hash]*types.Receipt
+}
+
+func newFakeRootChain() *fakeRootChain {
+	return &fakeRootChain{
+		gasPrice: big.NewInt(100),
+		tipCap:   big.NewInt(2),
+		baseFee:  big.NewInt(10),
+		head:     100,
+		receipts: make(map[common.Hash]*types.Receipt),
+	}
+}
+
+func (c *fakeRootChain) ChainID(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(5), nil
+}
+
+func (c *fakeRootChain) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nonceRequests++
+	return c.pendingNonce, nil
+}
+
+func (c *fakeRootChain) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.minedNonce, nil
+}
+
+func (c *fakeRootChain) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return c.gasPrice, nil
+}
+
+func (c *fakeRootChain) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	return 50000, nil
+}
+
+func (c *fakeRootChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &types.Header{Number: new(big.Int).SetUint64(c.head)}, nil
+}
+
+func (c *fakeRootChain) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	receipt, ok := c.receipts[txHash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return receipt, nil
+}
+
+func (c *fakeRootChain) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return c.tipCap, nil
+}
+
+func (c *fakeRootChain) BaseFee(ctx context.Context) (*big.Int, error) {
+	return c.baseFee, nil
+}
+
+func (c *fakeRootChain) SendRawTransaction(ctx context.Context, rawTx []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sent = append(c.sent, rawTx)
+	return nil
+}
+
+// mine includes the tx in the head block
+func (c *fakeRootChain) mine(txHash common.Hash) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.receipts[txHash] = &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		TxHash:      txHash,
+		BlockNumber: new(big.Int).SetUint64(c.head),
+		GasUsed:     21000,
+	}
+	c.minedNonce++
+}
+
+func (c *fakeRootChain) addBlocks(count uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.head += count
+}
+
+func newTestDB(t *testing.T) *leveldb.DB {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func testTxManagerConfig() RootChainTxManagerConfig {
+	return RootChainTxManagerConfig{
+		Confirmations:    6,
+		ResubmitInterval: time.Hour,
+		GasPriceBump:     15,
+		DefaultGasLimit:  100000,
+		PollInterval:     time.Second,
+	}
+}
+
+func newTestTxManager(t *testing.T, client RootChainClient, db *leveldb.DB, signer helper.Signer, config RootChainTxManagerConfig) *RootChainTxManager {
+	m, err := NewRootChainTxManager(log.NewNopLogger(), client, signer, db, config)
+	require.NoError(t, err)
+	return m
+}
+
+func TestRootChainTxManagerNonces(t *testing.T) {
+	client := newFakeRootChain()
+	client.pendingNonce = 7
+	m := newTestTxManager(t, client, newTestDB(t), helper.NewFileSigner(secp256k1.GenPrivKey()), testTxManagerConfig())
+
+	for i := 0; i < 3; i++ {
+		_, err := m.Send("submitHeaderBlock", common.HexToAddress("0x1"), []byte{0x1})
+		require.NoError(t, err)
+	}
+
+	txs := m.PendingTxs()
+	require.Len(t, txs, 3)
+	for i, tx := range txs {
+		require.Equal(t, uint64(7+i), tx.Nonce)
+		require.Equal(t, big.NewInt(100), tx.GasPrice)
+	}
+
+	// nonce is tracked locally
+	require.Equal(t, 1, client.nonceRequests)
+	require.Len(t, client.sent, 3)
+}
+
+func TestRootChainTxManagerReplacesStuckTx(t *testing.T) {
+	client := newFakeRootChain()
+	config := testTxManagerConfig()
+	config.ResubmitInterval = 0
+	config.MaxGasPrice = big.NewInt(120)
+	db := newTestDB(t)
+	m := newTestTxManager(t, client, db, helper.NewFileSigner(secp256k1.GenPrivKey()), config)
+
+	firstHash, err := m.Send("submitHeaderBlock", common.HexToAddress("0x1"), []byte{0x1})
+	require.NoError(t, err)
+
+	// replaced with bumped gas price
+	m.checkPendingTxs()
+	tx := m.PendingTxs()[0]
+	require.Len(t, tx.Hashes, 2)
+	require.Equal(t, big.NewInt(115), tx.GasPrice)
+	require.Len(t, client.sent, 2)
+
+	// bump is capped by the max gas price
+	m.checkPendingTxs()
+	require.Equal(t, big.NewInt(120), m.PendingTxs()[0].GasPrice)
+
+	// the first version got mined, it's tracked until it's confirmed
+	client.mine(firstHash)
+	m.checkPendingTxs()
+	require.Len(t, m.PendingTxs(), 1)
+
+	client.addBlocks(5)
+	m.checkPendingTxs()
+	require.Empty(t, m.PendingTxs())
+
+	txs, err := m.loadTxs()
+	require.NoError(t, err)
+	require.Empty(t, txs)
+}
+
+func TestRootChainTxManagerRestart(t *testing.T) {
+	client := newFakeRootChain()
+	db := newTestDB(t)
+	signer := helper.NewFileSigner(secp256k1.GenPrivKey())
+
+	m := newTestTxManager(t, client, db, signer, testTxManagerConfig())
+	txHash, err := m.Send("submitHeaderBlock", common.HexToAddress("0x1"), []byte{0x1})
+	require.NoError(t, err)
+
+	// pending tx is loaded again, its nonce isn't reused though the node dropped it
+	restarted := newTestTxManager(t, client, db, signer, testTxManagerConfig())
+	txs := restarted.PendingTxs()
+	require.Len(t, txs, 1)
+	require.Equal(t, txHash, txs[0].Hash())
+
+	_, err = restarted.Send("submitHeaderBlock", common.HexToAddress("0x1"), []byte{0x2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), restarted.PendingTxs()[1].Nonce)
+}
+
+func TestRootChainTxManagerDropsTxWithUsedNonce(t *testing.T) {
+	client := newFakeRootChain()
+	m := newTestTxManager(t, client, newTestDB(t), helper.NewFileSigner(secp256k1.GenPrivKey()), testTxManagerConfig())
+
+	_, err := m.Send("submitHeaderBlock", common.HexToAddress("0x1"), []byte{0x1})
+	require.NoError(t, err)
+
+	// another tx with the nonce got mined
+	client.mine(common.HexToHash("0x2"))
+	m.checkPendingTxs()
+	require.Empty(t, m.PendingTxs())
+}
+
+func TestRootChainTxManagerReceiptAheadOfHead(t *testing.T) {
+	client := newFakeRootChain()
+	m := newTestTxManager(t, client, newTestDB(t), helper.NewFileSigner(secp256k1.GenPrivKey()), testTxManagerConfig())
+
+	txHash, err := m.Send("submitHeaderBlock", common.HexToAddress("0x1"), []byte{0x1})
+	require.NoError(t, err)
+
+	// the receipt comes from a node ahead of the one serving the head
+	client.mine(txHash)
+	client.receipts[txHash].BlockNumber = new(big.Int).SetUint64(client.head + 10)
+	m.checkPendingTxs()
+	require.Len(t, m.PendingTxs(), 1)
+
+	client.addBlocks(15)
+	m.checkPendingTxs()
+	require.Empty(t, m.PendingTxs())
+}
+
+func TestRootChainTxManagerDynamicFee(t *testing.T) {
+	client := newFakeRootChain()
+	config := testTxManagerConfig()
+	config.DynamicFee = true
+	privKey := secp256k1.PrivKey(common.FromHex("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"))
+	m := newTestTxManager(t, client, newTestDB(t), helper.NewFileSigner(privKey), config)
+	require.Equal(t, common.HexToAddress("0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"), m.from)
+
+	txHash, err := m.Send("submitHeaderBlock", common.HexToAddress("0x1"), []byte{0x1})
+	require.NoError(t, err)
+
+	tx := m.PendingTxs()[0]
+	require.Nil(t, tx.GasPrice)
+	require.Equal(t, big.NewInt(2), tx.GasTipCap)
+	require.Equal(t, big.NewInt(22), tx.GasFeeCap)
+
+	// raw tx and hash of the same tx signed with go-ethereum's london signer
+	require.Equal(t, "0x02f8620580021682c3509400000000000000000000000000000000000000018001c080a07771aedf384800c1ca8cda3de4d14f15ce1691de2f7bdf65c4f7e3efa096ee00a04b365066d574a8be86ea8b5c3648d80b9d08c20fd314733fc58aac996cdba28d", hexutil.Encode(client.sent[0]))
+	require.Equal(t, common.HexToHash("0x33a93febb52881470fd30e21b07e3a15adf8ec173d8639f11e21addcebc4fedb"), txHash)
+
+	// replacement bumps both fees
+	m.config.ResubmitInterval = 0
+	m.checkPendingTxs()
+	tx = m.PendingTxs()[0]
+	require.Equal(t, big.NewInt(25), tx.GasFeeCap)
+	require.Equal(t, big.NewInt(3), tx.GasTipCap)
+}
+
+// failingRootChain rejects all txs
+type failingRootChain struct {
+	*fakeRootChain
+}
+
+func (c failingRootChain) SendRawTransaction(ctx context.Context, rawTx []byte) error {
+	return errors.New("nonce too low")
+}
+
+func TestRootChainTxManagerSendError(t *testing.T) {
+	client := failingRootChain{newFakeRootChain()}
+	m := newTestTxManager(t, client, newTestDB(t), helper.NewFileSigner(secp256k1.GenPrivKey()), testTxManagerConfig())
+
+	_, err := m.Send("submitHeaderBlock", common.HexToAddress("0x1"), []byte{0x1})
+	require.Error(t, err)
+	require.Empty(t, m.PendingTxs())
+
+	txs, err := m.loadTxs()
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	// nonce is loaded again for the next tx
+	require.False(t, m.nonceLoaded)
+}
@@ -0,0 +1,218 @@
+package broadcaster
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/bor/ethclient"
+	"github.com/maticnetwork/bor/rlp"
+	"github.com/maticnetwork/bor/rpc"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// dynamicFeeTxType is the EIP-2718 type of EIP-1559 txs
+const dynamicFeeTxType = 0x02
+
+// RootChainClient is the rootchain node api used by the rootchain tx manager
+type RootChainClient interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+
+	// SuggestGasTipCap returns the priority fee of EIP-1559 txs
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	// BaseFee returns the base fee of the latest block
+	BaseFee(ctx context.Context) (*big.Int, error)
+	// SendRawTransaction broadcasts the encoded signed tx
+	SendRawTransaction(ctx context.Context, rawTx []byte) error
+}
+
+// ethRootChainClient adds the calls the bor ethclient predates
+type ethRootChainClient struct {
+	*ethclient.Client
+	rpcClient *rpc.Client
+}
+
+// NewRootChainClient creates rootchain client from the eth and rpc clients of the same node
+func NewRootChainClient(client *ethclient.Client, rpcClient *rpc.Client) RootChainClient {
+	return &ethRootChainClient{
+		Client:    client,
+		rpcClient: rpcClient,
+	}
+}
+
+func (c *ethRootChainClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	var tipCap hexutil.Big
+	if err := c.rpcClient.CallContext(ctx, &tipCap, "eth_maxPriorityFeePerGas"); err != nil {
+		return nil, err
+	}
+	return (*big.Int)(&tipCap), nil
+}
+
+func (c *ethRootChainClient) BaseFee(ctx context.Context) (*big.Int, error) {
+	var head struct {
+		BaseFee *hexutil.Big `json:"baseFeePerGas"`
+	}
+	if err := c.rpcClient.CallContext(ctx, &head, "eth_getBlockByNumber", "latest", false); err != nil {
+		return nil, err
+	}
+	if head.BaseFee == nil {
+		return nil, errors.New("rootchain does not support EIP-1559 txs")
+	}
+	return (*big.Int)(head.BaseFee), nil
+}
+
+func (c *ethRootChainClient) SendRawTransaction(ctx context.Context, rawTx []byte) error {
+	return c.rpcClient.CallContext(ctx, nil, "eth_sendRawTransaction", hexutil.Encode(rawTx))
+}
+
+// RootChainTx is a tx sent to the rootchain by the validator, tracked until it's confirmed.
+// Resubmissions keep the nonce and bump the fees, so any of its hashes may get mined.
+type RootChainTx struct {
+	Method   string         `json:"method"`
+	Nonce    uint64         `json:"nonce"`
+	To       common.Address `json:"to"`
+	Data     hexutil.Bytes  `json:"data"`
+	GasLimit uint64         `json:"gasLimit"`
+
+	GasPrice  *big.Int `json:"gasPrice,omitempty"`  // legacy txs
+	GasTipCap *big.Int `json:"gasTipCap,omitempty"` // EIP-1559 txs
+	GasFeeCap *big.Int `json:"gasFeeCap,omitempty"` // EIP-1559 txs
+
+	Hashes []common.Hash `json:"hashes"` // hashes of the sent versions, latest last
+	SentAt time.Time     `json:"sentAt"` // time the latest version was sent
+}
+
+// Hash returns the hash of the latest version of the tx
+func (tx *RootChainTx) Hash() common.Hash {
+	if len(tx.Hashes) == 0 {
+		return common.Hash{}
+	}
+	return tx.Hashes[len(tx.Hashes)-1]
+}
+
+// IsDynamicFee checks if the tx is an EIP-1559 tx
+func (tx *RootChainTx) IsDynamicFee() bool {
+	return tx.GasFeeCap != nil
+}
+
+// signRootChainTx signs the tx, returning the encoded signed tx and its hash
+func signRootChainTx(tx *RootChainTx, chainID *big.Int, signer helper.Signer) ([]byte, common.Hash, error) {
+	if tx.IsDynamicFee() {
+		return signDynamicFeeTx(tx, chainID, signer)
+	}
+
+	ethSigner := types.NewEIP155Signer(chainID)
+	legacyTx := types.NewTransaction(tx.Nonce, tx.To, big.NewInt(0), tx.GasLimit, tx.GasPrice, tx.Data)
+
+	signature, err := signer.SignHash(ethSigner.Hash(legacyTx).Bytes())
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+
+	signedTx, err := legacyTx.WithSignature(ethSigner, signature)
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+
+	rawTx, err := rlp.EncodeToBytes(signedTx)
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+
+	return rawTx, signedTx.Hash(), nil
+}
+
+// accessTuple is an EIP-2930 access list entry, rootchain txs have empty access lists
+type accessTuple struct {
+	Address     common.Address
+	StorageKeys []common.Hash
+}
+
+// dynamicFeeTxPayload is the signed payload of EIP-1559 txs
+type dynamicFeeTxPayload struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	GasLimit   uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList []accessTuple
+}
+
+// signedDynamicFeeTx is the EIP-1559 tx with its signature
+type signedDynamicFeeTx struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	GasLimit   uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList []accessTuple
+	V          *big.Int
+	R          *big.Int
+	S          *big.Int
+}
+
+// signDynamicFeeTx signs the EIP-1559 tx, encoded by hand as the bor client
+// predates typed txs
+func signDynamicFeeTx(tx *RootChainTx, chainID *big.Int, signer helper.Signer) ([]byte, common.Hash, error) {
+	payload := dynamicFeeTxPayload{
+		ChainID:    chainID,
+		Nonce:      tx.Nonce,
+		GasTipCap:  tx.GasTipCap,
+		GasFeeCap:  tx.GasFeeCap,
+		GasLimit:   tx.GasLimit,
+		To:         tx.To,
+		Value:      big.NewInt(0),
+		Data:       tx.Data,
+		AccessList: []accessTuple{},
+	}
+
+	payloadBytes, err := rlp.EncodeToBytes(&payload)
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+
+	signature, err := signer.SignHash(crypto.Keccak256(append([]byte{dynamicFeeTxType}, payloadBytes...)))
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+
+	signedBytes, err := rlp.EncodeToBytes(&signedDynamicFeeTx{
+		ChainID:    payload.ChainID,
+		Nonce:      payload.Nonce,
+		GasTipCap:  payload.GasTipCap,
+		GasFeeCap:  payload.GasFeeCap,
+		GasLimit:   payload.GasLimit,
+		To:         payload.To,
+		Value:      payload.Value,
+		Data:       payload.Data,
+		AccessList: payload.AccessList,
+		V:          new(big.Int).SetUint64(uint64(signature[64])),
+		R:          new(big.Int).SetBytes(signature[:32]),
+		S:          new(big.Int).SetBytes(signature[32:64]),
+	})
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+
+	rawTx := append([]byte{dynamicFeeTxType}, signedBytes...)
+	return rawTx, crypto.Keccak256Hash(rawTx), nil
+}
@@ -12,6 +12,7 @@ import (
 	ethereum "github.com/maticnetwork/bor"
 	"github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/bor/ethclient"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
@@ -141,6 +142,8 @@ func (bl *BaseListener) StartHeaderProcess(ctx context.Context) {
 		select {
 		case newHeader := <-bl.HeaderChannel:
 			bl.impl.ProcessHeader(newHeader)
+			metrics.HeadersProcessed.WithLabelValues(bl.name).Inc()
+			metrics.MarkActive(bl.name)
 		case <-ctx.Done():
 			bl.Logger.Info("Header process stopped")
 			return
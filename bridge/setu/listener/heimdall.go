@@ -3,23 +3,46 @@ package listener
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/RichardKnop/machinery/v1/tasks"
-	"github.com/maticnetwork/bor/core/types"
-	"github.com/maticnetwork/heimdall/helper"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/syndtr/goleveldb/leveldb"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
 
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
 	checkpointTypes "github.com/maticnetwork/heimdall/x/checkpoint/types"
-	//slashingTypes "github.com/maticnetwork/heimdall/x/slashing/types"
+	clerkTypes "github.com/maticnetwork/heimdall/x/clerk/types"
+	slashingTypes "github.com/maticnetwork/heimdall/x/slashing/types"
 )
 
 const (
 	heimdallLastBlockKey = "heimdall-last-block" // storage key
+
+	heimdallSubscriber         = "heimdall-listener"
+	heimdallSubscriptionBuffer = 100
+
+	// no new block for this long means the websocket dropped
+	heimdallNewBlockTimeout = 10 * util.BlockInterval
+	// how long to poll before subscribing again
+	heimdallResubscribeInterval = 1 * time.Minute
+
+	heimdallTxSearchLimit = 50
 )
 
+// heimdallTxEventTypes are the search queries of tx level events
+var heimdallTxEventTypes = []string{
+	fmt.Sprintf("message.action='%s'", clerkTypes.MsgEventRecordRequest{}.Type()),
+}
+
 // HeimdallListener - Listens to and process events from heimdall
 type HeimdallListener struct {
 	BaseListener
@@ -44,8 +67,7 @@ func (hl *HeimdallListener) Start() error {
 		pollInterval = helper.GetConfig().CheckpointerPollInterval
 	}
 
-	hl.Logger.Info("Start polling for events", "pollInterval", pollInterval)
-	hl.StartPolling(headerCtx, pollInterval)
+	go hl.listen(headerCtx, pollInterval)
 	return nil
 }
 
@@ -54,6 +76,130 @@ func (hl *HeimdallListener) ProcessHeader(*types.Header) {
 
 }
 
+// listen subscribes to new blocks and falls back to polling while the websocket is down
+func (hl *HeimdallListener) listen(ctx context.Context, pollInterval time.Duration) {
+	for {
+		hl.Logger.Info("Subscribing to new blocks")
+		err := hl.StartBlockSubscription(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		hl.Logger.Error("New block subscription dropped, falling back to polling", "error", err, "pollInterval", pollInterval, "resubscribeIn", heimdallResubscribeInterval)
+
+		// poll until the next subscription attempt
+		pollCtx, cancelPolling := context.WithTimeout(ctx, heimdallResubscribeInterval)
+		hl.StartPolling(pollCtx, pollInterval)
+		cancelPolling()
+
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// StartBlockSubscription processes begin block events of new blocks pushed over the
+// websocket. It returns once the subscription drops or the context is done.
+func (hl *HeimdallListener) StartBlockSubscription(ctx context.Context) error {
+	query := tmTypes.QueryForEvent(tmTypes.EventNewBlock).String()
+
+	eventCh, err := hl.httpClient.Subscribe(ctx, heimdallSubscriber, query, heimdallSubscriptionBuffer)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		unsubscribeCtx, cancel := context.WithTimeout(context.Background(), util.TransactionTimeout)
+		defer cancel()
+
+		if err := hl.httpClient.Unsubscribe(unsubscribeCtx, heimdallSubscriber, query); err != nil {
+			hl.Logger.Debug("Error while unsubscribing from new blocks", "error", err)
+		}
+	}()
+
+	// catch up on blocks missed while not subscribed
+	fromBlock, toBlock, err := hl.fetchFromAndToBlock()
+	if err != nil {
+		return err
+	}
+	if fromBlock <= toBlock {
+		if err := hl.processBlocks(fromBlock, toBlock); err != nil {
+			hl.Logger.Error("Error while processing missed blocks", "error", err)
+		}
+	}
+
+	// the websocket client reconnects on its own, a stalled subscription means it gave up
+	stallTimer := time.NewTimer(heimdallNewBlockTimeout)
+	defer stallTimer.Stop()
+
+	for {
+		select {
+		case event, ok := <-eventCh:
+			if !ok {
+				return errors.New("subscription closed")
+			}
+
+			data, ok := event.Data.(tmTypes.EventDataNewBlock)
+			if !ok || data.Block == nil {
+				continue
+			}
+
+			if !stallTimer.Stop() {
+				<-stallTimer.C
+			}
+			stallTimer.Reset(heimdallNewBlockTimeout)
+
+			hl.processNewBlock(data.Block.Height, data.ResultBeginBlock.Events, len(data.Block.Txs))
+
+		case <-stallTimer.C:
+			return fmt.Errorf("no new block received in %v", heimdallNewBlockTimeout)
+
+		case <-ctx.Done():
+			hl.Logger.Info("Subscription stopped")
+			return nil
+		}
+	}
+}
+
+// processNewBlock processes a block received over the subscription, backfilling
+// blocks skipped since the last processed one. Txs are only searched for blocks
+// with txs.
+func (hl *HeimdallListener) processNewBlock(height int64, beginBlockEvents []abci.Event, numTxs int) {
+	lastBlock, found, err := hl.getLastBlock()
+	if err != nil {
+		hl.Logger.Error("Error while fetching last processed block", "error", err)
+		return
+	}
+
+	blockHeight := uint64(height)
+	if found && blockHeight <= lastBlock {
+		hl.Logger.Debug("Skipping already processed block", "height", height, "lastBlock", lastBlock)
+		return
+	}
+
+	// the block is processed once all blocks before it are
+	if found && blockHeight > lastBlock+1 {
+		hl.Logger.Info("Missed blocks, backfilling", "fromBlock", lastBlock+1, "toBlock", blockHeight-1)
+		if err := hl.processBlocks(lastBlock+1, blockHeight-1); err != nil {
+			hl.Logger.Error("Error while backfilling missed blocks", "error", err)
+			return
+		}
+	}
+
+	if numTxs > 0 {
+		if err := hl.processTxEvents(blockHeight, blockHeight); err != nil {
+			hl.Logger.Error("Error while processing tx events", "height", height, "error", err)
+			return
+		}
+	}
+	for _, event := range beginBlockEvents {
+		hl.ProcessBlockEvent(sdk.StringifyEvent(event), height)
+	}
+
+	hl.setLastBlock(blockHeight)
+	metrics.HeadersProcessed.WithLabelValues(hl.name).Inc()
+}
+
 // StartPolling - starts polling for heimdall events
 func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.Duration) {
 	// How often to fire the passed in function in second
@@ -63,12 +209,6 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 	// the ending of the interval
 	ticker := time.NewTicker(interval)
 
-	// var eventTypes []string
-	// eventTypes = append(eventTypes, "message.action='checkpoint'")
-	// eventTypes = append(eventTypes, "message.action='event-record'")
-	// eventTypes = append(eventTypes, "message.action='tick'")
-	// ADD EVENT TYPE for SLASH-LIMIT
-
 	// start listening
 	for {
 		select {
@@ -76,59 +216,9 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 			fromBlock, toBlock, err := hl.fetchFromAndToBlock()
 			if err != nil {
 				hl.Logger.Error("Error fetching fromBlock and toBlock...skipping events query", "error", err)
-			} else if fromBlock < toBlock {
-
-				hl.Logger.Info("Fetching new events between", "fromBlock", fromBlock, "toBlock", toBlock)
-
-				// Querying and processing Begin events
-				for i := fromBlock; i <= toBlock; i++ {
-					events, err := helper.GetBeginBlockEvents(hl.httpClient, int64(i))
-					if err != nil {
-						hl.Logger.Error("Error fetching begin block events", "error", err)
-					}
-					for _, event := range events {
-						hl.ProcessBlockEvent(sdk.StringifyEvent(event), int64(i))
-					}
-				}
-
-				// Querying and processing tx Events. Below for loop is kept for future purpose to process events from tx
-				/* 		for _, eventType := range eventTypes {
-					var query []string
-					query = append(query, eventType)
-					query = append(query, fmt.Sprintf("tx.height>=%v", fromBlock))
-					query = append(query, fmt.Sprintf("tx.height<=%v", toBlock))
-
-					limit := 50
-					for page := 1; page > 0; {
-						searchResult, err := helper.QueryTxsByEvents(hl.cliCtx, query, page, limit)
-						hl.Logger.Debug("Fetching new events using search query", "query", query, "page", page, "limit", limit)
-
-						if err != nil {
-							hl.Logger.Error("Error while searching events", "eventType", eventType, "error", err)
-							break
-						}
-
-						for _, tx := range searchResult.Txs {
-							for _, log := range tx.Logs {
-								event := helper.FilterEvents(log.Events, func(et sdk.StringEvent) bool {
-									return et.Type == checkpointTypes.EventTypeCheckpoint || et.Type == clerkTypes.EventTypeRecord
-								})
-								if event != nil {
-									hl.ProcessEvent(*event, tx)
-								}
-							}
-						}
-
-						if len(searchResult.Txs) == limit {
-							page = page + 1
-						} else {
-							page = 0
-						}
-					}
-				} */
-				// set last block to storage
-				if err := hl.storageClient.Put([]byte(heimdallLastBlockKey), []byte(strconv.FormatUint(toBlock, 10)), nil); err != nil {
-					hl.Logger.Error("hl.storageClient.Put", "Error", err)
+			} else if fromBlock <= toBlock {
+				if err := hl.processBlocks(fromBlock, toBlock); err != nil {
+					hl.Logger.Error("Error while processing blocks", "error", err)
 				}
 			}
 
@@ -140,6 +230,87 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 	}
 }
 
+// processBlocks processes begin block and tx events of the blocks in the range
+// and records the last processed block. It stops at the first block whose
+// events can't be fetched, so that the block is processed again later.
+func (hl *HeimdallListener) processBlocks(fromBlock uint64, toBlock uint64) error {
+	hl.Logger.Info("Fetching new events between", "fromBlock", fromBlock, "toBlock", toBlock)
+
+	// Querying begin events, up to the first block failing
+	var beginBlockEvents [][]abci.Event
+	var fetchErr error
+	for i := fromBlock; i <= toBlock; i++ {
+		events, err := helper.GetBeginBlockEvents(hl.httpClient, int64(i))
+		if err != nil {
+			fetchErr = fmt.Errorf("fetching begin block events of block %d: %w", i, err)
+			break
+		}
+		beginBlockEvents = append(beginBlockEvents, events)
+	}
+
+	if len(beginBlockEvents) == 0 {
+		return fetchErr
+	}
+	lastBlock := fromBlock + uint64(len(beginBlockEvents)) - 1
+
+	// Querying and processing tx events
+	if err := hl.processTxEvents(fromBlock, lastBlock); err != nil {
+		return err
+	}
+
+	// Processing begin events
+	for i, events := range beginBlockEvents {
+		for _, event := range events {
+			hl.ProcessBlockEvent(sdk.StringifyEvent(event), int64(fromBlock)+int64(i))
+		}
+	}
+
+	// set last block to storage
+	hl.setLastBlock(lastBlock)
+	metrics.HeadersProcessed.WithLabelValues(hl.name).Add(float64(lastBlock - fromBlock + 1))
+
+	return fetchErr
+}
+
+// processTxEvents searches the txs of the block range for tx level events, the
+// events are processed once all searches succeeded
+func (hl *HeimdallListener) processTxEvents(fromBlock uint64, toBlock uint64) error {
+	var txs []*sdk.TxResponse
+	for _, eventType := range heimdallTxEventTypes {
+		query := []string{
+			eventType,
+			fmt.Sprintf("tx.height>=%v", fromBlock),
+			fmt.Sprintf("tx.height<=%v", toBlock),
+		}
+
+		for page := 1; page > 0; {
+			hl.Logger.Debug("Fetching new events using search query", "query", query, "page", page, "limit", heimdallTxSearchLimit)
+			searchResult, err := authclient.QueryTxsByEvents(hl.cliCtx, query, page, heimdallTxSearchLimit, "")
+			if err != nil {
+				return fmt.Errorf("searching %s events: %w", eventType, err)
+			}
+
+			txs = append(txs, searchResult.Txs...)
+
+			if len(searchResult.Txs) == heimdallTxSearchLimit {
+				page = page + 1
+			} else {
+				page = 0
+			}
+		}
+	}
+
+	for _, tx := range txs {
+		for _, log := range tx.Logs {
+			for _, event := range log.Events {
+				hl.ProcessTxEvent(event, tx.TxHash, tx.Height)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (hl *HeimdallListener) fetchFromAndToBlock() (uint64, uint64, error) {
 	// toBlock - get latest blockheight from heimdall node
 	fromBlock := uint64(0)
@@ -153,26 +324,49 @@ func (hl *HeimdallListener) fetchFromAndToBlock() (uint64, uint64, error) {
 	toBlock = uint64(nodeStatus.SyncInfo.LatestBlockHeight)
 
 	// fromBlock - get last block from storage
-	hasLastBlock, _ := hl.storageClient.Has([]byte(heimdallLastBlockKey), nil)
-	if hasLastBlock {
-		lastBlockBytes, err := hl.storageClient.Get([]byte(heimdallLastBlockKey), nil)
-		if err != nil {
-			hl.Logger.Info("Error while fetching last block bytes from storage", "error", err)
-			return fromBlock, toBlock, err
-		}
+	lastBlock, found, err := hl.getLastBlock()
+	if err != nil {
+		toBlock = 0
+		return fromBlock, toBlock, err
+	}
 
-		if result, err := strconv.ParseUint(string(lastBlockBytes), 10, 64); err == nil {
-			hl.Logger.Debug("Got last block from bridge storage", "lastBlock", result)
-			fromBlock = uint64(result) + 1
-		} else {
-			hl.Logger.Info("Error parsing last block bytes from storage", "error", err)
-			toBlock = 0
-			return fromBlock, toBlock, err
-		}
+	// heimdall blocks start at 1
+	fromBlock = 1
+	if found {
+		hl.Logger.Debug("Got last block from bridge storage", "lastBlock", lastBlock)
+		fromBlock = lastBlock + 1
 	}
 	return fromBlock, toBlock, err
 }
 
+// getLastBlock returns the last processed block from storage
+func (hl *HeimdallListener) getLastBlock() (uint64, bool, error) {
+	lastBlockBytes, err := hl.storageClient.Get([]byte(heimdallLastBlockKey), nil)
+	if err == leveldb.ErrNotFound {
+		return 0, false, nil
+	} else if err != nil {
+		hl.Logger.Info("Error while fetching last block bytes from storage", "error", err)
+		return 0, false, err
+	}
+
+	lastBlock, err := strconv.ParseUint(string(lastBlockBytes), 10, 64)
+	if err != nil {
+		hl.Logger.Info("Error parsing last block bytes from storage", "error", err)
+		return 0, false, err
+	}
+
+	return lastBlock, true, nil
+}
+
+// setLastBlock stores the last processed block
+func (hl *HeimdallListener) setLastBlock(lastBlock uint64) {
+	if err := hl.storageClient.Put([]byte(heimdallLastBlockKey), []byte(strconv.FormatUint(lastBlock, 10)), nil); err != nil {
+		hl.Logger.Error("hl.storageClient.Put", "Error", err)
+	}
+	metrics.LastProcessedBlock.WithLabelValues(metrics.Heimdall).Set(float64(lastBlock))
+	metrics.MarkActive(hl.name)
+}
+
 // ProcessBlockEvent - process Blockevents (BeginBlock, EndBlock events) from heimdall.
 func (hl *HeimdallListener) ProcessBlockEvent(event sdk.StringEvent, blockHeight int64) {
 	hl.Logger.Info("Received block event from Heimdall", "eventType", event.Type)
@@ -185,15 +379,32 @@ func (hl *HeimdallListener) ProcessBlockEvent(event sdk.StringEvent, blockHeight
 	switch event.Type {
 	case checkpointTypes.EventTypeCheckpoint:
 		hl.sendBlockTask("sendCheckpointToRootchain", eventBytes, blockHeight)
-	//case slashingTypes.EventTypeSlashLimit:
-	//	hl.sendBlockTask("sendTickToHeimdall", eventBytes, blockHeight)
-	//case slashingTypes.EventTypeTickConfirm:
-	//	hl.sendBlockTask("sendTickToRootchain", eventBytes, blockHeight)
+	case slashingTypes.EventTypeSlashLimit:
+		hl.sendBlockTask("sendTickToHeimdall", eventBytes, blockHeight)
+	case slashingTypes.EventTypeTickConfirm:
+		hl.sendBlockTask("sendTickToRootchain", eventBytes, blockHeight)
 	default:
 		hl.Logger.Debug("BlockEvent Type mismatch", "eventType", event.Type)
 	}
 }
 
+// ProcessTxEvent - process tx events from heimdall.
+func (hl *HeimdallListener) ProcessTxEvent(event sdk.StringEvent, txHash string, blockHeight int64) {
+	hl.Logger.Info("Received tx event from Heimdall", "eventType", event.Type, "txHash", txHash)
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		hl.Logger.Error("Error while parsing tx event", "error", err, "eventType", event.Type)
+		return
+	}
+
+	switch event.Type {
+	case clerkTypes.EventTypeRecord:
+		hl.sendTxTask("sendMissingRecordsToHeimdall", eventBytes, txHash, blockHeight)
+	default:
+		hl.Logger.Debug("TxEvent Type mismatch", "eventType", event.Type)
+	}
+}
+
 func (hl *HeimdallListener) sendBlockTask(taskName string, eventBytes []byte, blockHeight int64) {
 	// create machinery task
 	signature := &tasks.Signature{
@@ -209,11 +420,37 @@ func (hl *HeimdallListener) sendBlockTask(taskName string, eventBytes []byte, bl
 			},
 		},
 	}
-	signature.RetryCount = 3
 	hl.Logger.Info("Sending block level task", "taskName", taskName, "currentTime", time.Now(), "blockHeight", blockHeight)
 	// send task
-	_, err := hl.queueConnector.Server.SendTask(signature)
+	err := hl.queueConnector.SendTask(signature)
 	if err != nil {
 		hl.Logger.Error("Error sending block level task", "taskName", taskName, "blockHeight", blockHeight, "error", err)
 	}
 }
+
+func (hl *HeimdallListener) sendTxTask(taskName string, eventBytes []byte, txHash string, blockHeight int64) {
+	// create machinery task
+	signature := &tasks.Signature{
+		Name: taskName,
+		Args: []tasks.Arg{
+			{
+				Type:  "string",
+				Value: string(eventBytes),
+			},
+			{
+				Type:  "string",
+				Value: txHash,
+			},
+			{
+				Type:  "int64",
+				Value: blockHeight,
+			},
+		},
+	}
+	hl.Logger.Info("Sending tx level task", "taskName", taskName, "txHash", txHash, "blockHeight", blockHeight)
+	// send task
+	err := hl.queueConnector.SendTask(signature)
+	if err != nil {
+		hl.Logger.Error("Error sending tx level task", "taskName", taskName, "txHash", txHash, "blockHeight", blockHeight, "error", err)
+	}
+}
@@ -0,0 +1,246 @@
+package listener
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	httpClient "github.com/tendermint/tendermint/rpc/client/http"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	checkpointTypes "github.com/maticnetwork/heimdall/x/checkpoint/types"
+	clerkTypes "github.com/maticnetwork/heimdall/x/clerk/types"
+)
+
+// heimdallTaskCall is a task sent by the heimdall listener
+type heimdallTaskCall struct {
+	name        string
+	event       sdk.StringEvent
+	txHash      string
+	blockHeight int64
+}
+
+// newTestHeimdallListener returns a listener sending its tasks to the returned channel
+func newTestHeimdallListener(t *testing.T) (*HeimdallListener, chan heimdallTaskCall) {
+	viper.Set("log_level", "error")
+	db := newTestDB(t)
+	queueConnector, err := queue.NewQueueConnector(queue.LocalBackend, "", db)
+	require.NoError(t, err)
+	t.Cleanup(queueConnector.Stop)
+
+	calls := make(chan heimdallTaskCall, 10)
+	decode := func(eventBytes string) sdk.StringEvent {
+		var event sdk.StringEvent
+		require.NoError(t, json.Unmarshal([]byte(eventBytes), &event))
+		return event
+	}
+	for _, name := range []string{"sendCheckpointToRootchain", "sendTickToHeimdall", "sendTickToRootchain"} {
+		name := name
+		require.NoError(t, queueConnector.RegisterTask(name, func(eventBytes string, blockHeight int64) error {
+			calls <- heimdallTaskCall{name: name, event: decode(eventBytes), blockHeight: blockHeight}
+			return nil
+		}))
+	}
+	require.NoError(t, queueConnector.RegisterTask("sendMissingRecordsToHeimdall", func(eventBytes string, txHash string, blockHeight int64) error {
+		calls <- heimdallTaskCall{name: "sendMissingRecordsToHeimdall", event: decode(eventBytes), txHash: txHash, blockHeight: blockHeight}
+		return nil
+	}))
+	queueConnector.StartWorker()
+
+	hl := NewHeimdallListener()
+	hl.BaseListener = BaseListener{
+		Logger:         log.NewNopLogger(),
+		name:           "heimdall",
+		queueConnector: queueConnector,
+		storageClient:  db,
+	}
+	return hl, calls
+}
+
+func receiveTask(t *testing.T, calls chan heimdallTaskCall) heimdallTaskCall {
+	select {
+	case call := <-calls:
+		return call
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "task wasn't sent")
+		return heimdallTaskCall{}
+	}
+}
+
+func TestHeimdallListenerProcessTxEvent(t *testing.T) {
+	hl, calls := newTestHeimdallListener(t)
+
+	event := sdk.StringEvent{
+		Type: clerkTypes.EventTypeRecord,
+		Attributes: []sdk.Attribute{
+			{Key: clerkTypes.AttributeKeyRecordID, Value: "7"},
+			{Key: clerkTypes.AttributeKeyRecordBuffered, Value: "true"},
+		},
+	}
+	hl.ProcessTxEvent(event, "0xabcd", 12)
+
+	call := receiveTask(t, calls)
+	require.Equal(t, heimdallTaskCall{name: "sendMissingRecordsToHeimdall", event: event, txHash: "0xabcd", blockHeight: 12}, call)
+
+	// other tx events aren't routed
+	hl.ProcessTxEvent(sdk.StringEvent{Type: "transfer"}, "0xabcd", 12)
+	select {
+	case call := <-calls:
+		require.FailNow(t, "unexpected task", call.name)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestHeimdallListenerProcessNewBlock(t *testing.T) {
+	hl, calls := newTestHeimdallListener(t)
+
+	// blocks without txs aren't searched, the listener has no node to search
+	checkpoint := abci.Event{
+		Type:       checkpointTypes.EventTypeCheckpoint,
+		Attributes: []abci.EventAttribute{{Key: []byte(checkpointTypes.AttributeKeyStartBlock), Value: []byte("0")}},
+	}
+	hl.processNewBlock(5, []abci.Event{checkpoint}, 0)
+
+	call := receiveTask(t, calls)
+	require.Equal(t, "sendCheckpointToRootchain", call.name)
+	require.Equal(t, sdk.StringifyEvent(checkpoint), call.event)
+	require.Equal(t, int64(5), call.blockHeight)
+
+	lastBlock, found, err := hl.getLastBlock()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(5), lastBlock)
+
+	// processed blocks are skipped
+	hl.processNewBlock(5, []abci.Event{checkpoint}, 0)
+	select {
+	case call := <-calls:
+		require.FailNow(t, "unexpected task", call.name)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// heimdallNode serves the begin block events of its blocks, blocks marked as
+// failing can't be fetched
+type heimdallNode struct {
+	mu          sync.Mutex
+	beginEvents map[int64][]abci.Event
+	failing     map[int64]bool
+}
+
+func (n *heimdallNode) setFailing(height int64, failing bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.failing[height] = failing
+}
+
+func (n *heimdallNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req rpctypes.RPCRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var res rpctypes.RPCResponse
+	switch req.Method {
+	case "block_results":
+		var params struct {
+			Height int64 `json:"height,string"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil || n.failing[params.Height] {
+			res = rpctypes.RPCInternalError(req.ID, errors.New("block results unavailable"))
+			break
+		}
+		res = rpctypes.NewRPCSuccessResponse(req.ID, &ctypes.ResultBlockResults{Height: params.Height, BeginBlockEvents: n.beginEvents[params.Height]})
+	case "status":
+		var latest int64
+		for height := range n.beginEvents {
+			if height > latest {
+				latest = height
+			}
+		}
+		res = rpctypes.NewRPCSuccessResponse(req.ID, &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: latest}})
+	case "tx_search":
+		res = rpctypes.NewRPCSuccessResponse(req.ID, &ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{}})
+	default:
+		res = rpctypes.RPCMethodNotFoundError(req.ID)
+	}
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+func TestHeimdallListenerProcessBlocks(t *testing.T) {
+	hl, calls := newTestHeimdallListener(t)
+
+	checkpoint := func(startBlock string) abci.Event {
+		return abci.Event{
+			Type:       checkpointTypes.EventTypeCheckpoint,
+			Attributes: []abci.EventAttribute{{Key: []byte(checkpointTypes.AttributeKeyStartBlock), Value: []byte(startBlock)}},
+		}
+	}
+	node := &heimdallNode{
+		beginEvents: map[int64][]abci.Event{
+			2: {checkpoint("0")},
+			3: {checkpoint("256")},
+			4: {checkpoint("512")},
+		},
+		failing: map[int64]bool{3: true},
+	}
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+
+	rpc, err := httpClient.New(server.URL, "/websocket")
+	require.NoError(t, err)
+	hl.httpClient = rpc
+	hl.cliCtx = client.Context{}.WithClient(rpc)
+
+	// processing stops at the failing block
+	require.Error(t, hl.processBlocks(1, 4))
+
+	call := receiveTask(t, calls)
+	require.Equal(t, sdk.StringifyEvent(checkpoint("0")), call.event)
+	require.Equal(t, int64(2), call.blockHeight)
+
+	lastBlock, found, err := hl.getLastBlock()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(2), lastBlock)
+
+	// the failing block is processed once available
+	node.setFailing(3, false)
+	require.NoError(t, hl.processBlocks(lastBlock+1, 4))
+
+	heights := []int64{receiveTask(t, calls).blockHeight, receiveTask(t, calls).blockHeight}
+	require.ElementsMatch(t, []int64{3, 4}, heights)
+
+	lastBlock, _, err = hl.getLastBlock()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), lastBlock)
+
+	// a single new block is processed
+	node.mu.Lock()
+	node.beginEvents[5] = []abci.Event{checkpoint("768")}
+	node.mu.Unlock()
+	fromBlock, toBlock, err := hl.fetchFromAndToBlock()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), fromBlock)
+	require.Equal(t, uint64(5), toBlock)
+	require.NoError(t, hl.processBlocks(fromBlock, toBlock))
+
+	call = receiveTask(t, calls)
+	require.Equal(t, int64(5), call.blockHeight)
+}
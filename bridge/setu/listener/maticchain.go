@@ -2,10 +2,12 @@ package listener
 
 import (
 	"context"
+	"math/big"
 	"time"
 
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -15,6 +17,9 @@ import (
 type MaticChainListener struct {
 	BaseListener
 	cacheLastSpan *hmTypes.Span
+
+	// processed blocks, to handle reorgs
+	blockTracker *BlockTracker
 }
 
 // NewMaticChainListener - constructor func
@@ -26,6 +31,8 @@ func NewMaticChainListener() *MaticChainListener {
 func (ml *MaticChainListener) Start() error {
 	ml.Logger.Info("Starting")
 
+	ml.blockTracker = NewBlockTracker(ml.storageClient, maticchainBlocksPrefix, reorgWindow)
+
 	// create cancellable context
 	ctx, cancelSubscription := context.WithCancel(context.Background())
 	ml.cancelSubscription = cancelSubscription
@@ -64,17 +71,54 @@ func (ml *MaticChainListener) Start() error {
 func (ml *MaticChainListener) ProcessHeader(newHeader *types.Header) {
 	ml.Logger.Debug("New block detected", "blockNumber", newHeader.Number)
 
+	// polling returns the same head until a new block is produced
+	if hash, found, err := ml.blockTracker.Get(newHeader.Number.Uint64()); err == nil && found && hash == newHeader.Hash() {
+		ml.Logger.Debug("Block already processed", "blockNumber", newHeader.Number)
+		return
+	}
+
+	if reorgBlock, reorged, err := ml.blockTracker.CheckReorg(context.Background(), ml.chainClient, newHeader); err != nil {
+		ml.Logger.Error("Error while checking maticchain reorg", "error", err)
+	} else if reorged {
+		ml.Logger.Info("Maticchain reorg detected", "fromBlock", reorgBlock, "blockNumber", newHeader.Number)
+		if err := ml.blockTracker.Rewind(reorgBlock); err != nil {
+			ml.Logger.Error("Error while rewinding processed blocks", "error", err)
+		} else {
+			// checkpoint and span tasks of replaced blocks are triggered again from the fork point
+			for number := reorgBlock; number < newHeader.Number.Uint64(); number++ {
+				header, err := ml.chainClient.HeaderByNumber(context.Background(), new(big.Int).SetUint64(number))
+				if err != nil {
+					ml.Logger.Error("Error while fetching maticchain header", "blockNumber", number, "error", err)
+					break
+				}
+				ml.processBlock(header)
+			}
+		}
+	}
+
+	ml.processBlock(newHeader)
+}
+
+// processBlock records the block and sends its checkpoint and span tasks
+func (ml *MaticChainListener) processBlock(header *types.Header) {
+	if err := ml.blockTracker.Add(header); err != nil {
+		ml.Logger.Error("Error while storing processed block", "error", err)
+	}
+	metrics.LastProcessedBlock.WithLabelValues(metrics.MaticChain).Set(float64(header.Number.Uint64()))
+
 	// check and send span task
-	go ml.checkAndSendSpanTask(newHeader)
+	go ml.checkAndSendSpanTask(header)
+
+	// check and send replace span task
+	go ml.checkAndSendReplaceSpanTask(header)
 
 	// Marshall header block and publish to queue
-	headerBytes, err := newHeader.MarshalJSON()
+	headerBytes, err := header.MarshalJSON()
 	if err != nil {
 		ml.Logger.Error("Error marshalling header block", "error", err)
 		return
 	}
 	ml.sendTaskWithDelay("sendCheckpointToHeimdall", headerBytes, 0)
-
 }
 
 func (ml *MaticChainListener) checkAndSendSpanTask(newHeader *types.Header) {
@@ -110,6 +154,31 @@ func (ml *MaticChainListener) checkAndSendSpanTask(newHeader *types.Header) {
 	}
 }
 
+// checkAndSendReplaceSpanTask sends the replace span task at the start of each sprint,
+// the current heimdall proposer checks the sprints the producers missed
+func (ml *MaticChainListener) checkAndSendReplaceSpanTask(newHeader *types.Header) {
+	params, err := util.GetHeimdallClient().BorParams()
+	if err != nil {
+		ml.Logger.Error("Error while fetching bor params", "error", err)
+		return
+	}
+
+	if params.ReplacementMissedSprints == 0 || params.Sprint == 0 || newHeader.Number.Uint64()%params.Sprint != 0 {
+		return
+	}
+
+	if isProposer, err := util.IsCurrentProposer(ml.cliCtx); err != nil || !isProposer {
+		return
+	}
+
+	headerBytes, err := newHeader.MarshalJSON()
+	if err != nil {
+		ml.Logger.Error("Error marshalling header block", "error", err)
+		return
+	}
+	ml.sendTaskWithDelay("sendReplaceSpanToHeimdall", headerBytes, 0)
+}
+
 func (ml *MaticChainListener) sendTaskWithDelay(taskName string, headerBytes []byte, delay time.Duration) {
 	// create machinery task
 	signature := &tasks.Signature{
@@ -121,13 +190,12 @@ func (ml *MaticChainListener) sendTaskWithDelay(taskName string, headerBytes []b
 			},
 		},
 	}
-	signature.RetryCount = 3
 
 	// add delay for task so that multiple validators won't send same transaction at same time
 	eta := time.Now().Add(delay)
 	signature.ETA = &eta
 	ml.Logger.Debug("Sending task", "taskname", taskName, "currentTime", time.Now(), "delayTime", eta)
-	_, err := ml.queueConnector.Server.SendTask(signature)
+	err := ml.queueConnector.SendTask(signature)
 	if err != nil {
 		ml.Logger.Error("Error sending task", "taskName", taskName, "error", err)
 	}
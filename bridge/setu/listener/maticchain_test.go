@@ -0,0 +1,124 @@
+package listener
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/ethclient"
+	"github.com/maticnetwork/bor/rpc"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// maticChainService serves the headers of the current chain
+type maticChainService struct {
+	mu    sync.Mutex
+	chain testChain
+}
+
+func (s *maticChainService) setChain(chain testChain) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.chain = chain
+}
+
+func (s *maticChainService) GetBlockByNumber(_ context.Context, number hexutil.Uint64, _ bool) (*types.Header, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.chain[uint64(number)], nil
+}
+
+// newTestMaticChainListener returns a listener sending the checkpoint task headers to the returned channel
+func newTestMaticChainListener(t *testing.T, service *maticChainService) (*MaticChainListener, chan *types.Header) {
+	viper.Set("log_level", "error")
+
+	// span checks fail, there is no heimdall node
+	helper.SetTestConfig(helper.Configuration{HeimdallGRPCServerURL: "127.0.0.1:1"})
+	require.NoError(t, util.InitHeimdallClient(codectypes.NewInterfaceRegistry()))
+
+	db := newTestDB(t)
+	queueConnector, err := queue.NewQueueConnector(queue.LocalBackend, "", db)
+	require.NoError(t, err)
+	t.Cleanup(queueConnector.Stop)
+
+	headers := make(chan *types.Header, 20)
+	require.NoError(t, queueConnector.RegisterTask("sendCheckpointToHeimdall", func(headerJSON string) error {
+		header := new(types.Header)
+		require.NoError(t, header.UnmarshalJSON([]byte(headerJSON)))
+		headers <- header
+		return nil
+	}))
+	queueConnector.StartWorker()
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", service))
+	t.Cleanup(server.Stop)
+
+	ml := NewMaticChainListener()
+	ml.BaseListener = BaseListener{
+		Logger:         log.NewNopLogger(),
+		name:           "maticchain",
+		queueConnector: queueConnector,
+		storageClient:  db,
+		chainClient:    ethclient.NewClient(rpc.DialInProc(server)),
+	}
+	ml.blockTracker = NewBlockTracker(db, maticchainBlocksPrefix, reorgWindow)
+	return ml, headers
+}
+
+func receiveHeaders(t *testing.T, headers chan *types.Header, count int) map[uint64]*types.Header {
+	received := map[uint64]*types.Header{}
+	for i := 0; i < count; i++ {
+		select {
+		case header := <-headers:
+			received[header.Number.Uint64()] = header
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "checkpoint task wasn't sent")
+		}
+	}
+	return received
+}
+
+func TestMaticChainListenerReorg(t *testing.T) {
+	chain := newTestChain(10, 1)
+	service := &maticChainService{chain: chain}
+	ml, headers := newTestMaticChainListener(t, service)
+
+	for number := uint64(1); number <= 7; number++ {
+		ml.ProcessHeader(chain[number])
+	}
+	receiveHeaders(t, headers, 7)
+
+	// blocks from 5 on are replaced, the new head is 9
+	fork := chain.fork(5, 2)
+	service.setChain(fork)
+	ml.ProcessHeader(fork[9])
+
+	received := receiveHeaders(t, headers, 5)
+	for number := uint64(5); number <= 9; number++ {
+		require.Contains(t, received, number)
+		require.Equal(t, fork[number].Hash(), received[number].Hash())
+
+		hash, found, err := ml.blockTracker.Get(number)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, fork[number].Hash(), hash)
+	}
+
+	// blocks before the fork point aren't sent again
+	select {
+	case header := <-headers:
+		require.FailNow(t, "unexpected checkpoint task", "blockNumber", header.Number)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,287 @@
+package listener
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+	"strconv"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// reorgWindow is the number of blocks tracked to detect reorgs
+	reorgWindow = 256
+
+	rootchainBlocksPrefix  = "rootchain-block-"  // storage prefix of processed rootchain blocks
+	rootchainLogsPrefix    = "rootchain-log-"    // storage prefix of processed rootchain logs
+	maticchainBlocksPrefix = "maticchain-block-" // storage prefix of processed maticchain blocks
+)
+
+// HeaderFetcher fetches canonical headers of a chain
+type HeaderFetcher interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// trackedBlock is a processed block
+type trackedBlock struct {
+	Number uint64
+	Hash   common.Hash
+}
+
+// BlockTracker keeps the (number, hash) pairs of the last processed blocks in
+// the bridge db to detect reorgs of the chain
+type BlockTracker struct {
+	db     *leveldb.DB
+	prefix string
+	window uint64
+}
+
+// NewBlockTracker creates block tracker storing blocks under the given prefix
+func NewBlockTracker(db *leveldb.DB, prefix string, window uint64) *BlockTracker {
+	return &BlockTracker{
+		db:     db,
+		prefix: prefix,
+		window: window,
+	}
+}
+
+// Get returns the hash of the processed block with given number
+func (t *BlockTracker) Get(number uint64) (common.Hash, bool, error) {
+	value, err := t.db.Get(t.key(number), nil)
+	if err == leveldb.ErrNotFound {
+		return common.Hash{}, false, nil
+	} else if err != nil {
+		return common.Hash{}, false, err
+	}
+
+	return common.BytesToHash(value), true, nil
+}
+
+// Add stores the processed block and prunes blocks out of the window
+func (t *BlockTracker) Add(header *types.Header) error {
+	number := header.Number.Uint64()
+
+	batch := new(leveldb.Batch)
+	batch.Put(t.key(number), header.Hash().Bytes())
+
+	if number > t.window {
+		iter := t.db.NewIterator(&leveldbUtil.Range{Start: t.key(0), Limit: t.key(number - t.window)}, nil)
+		for iter.Next() {
+			batch.Delete(append([]byte{}, iter.Key()...))
+		}
+		iter.Release()
+
+		if err := iter.Error(); err != nil {
+			return err
+		}
+	}
+
+	return t.db.Write(batch, nil)
+}
+
+// Rewind drops the processed blocks from the given number on
+func (t *BlockTracker) Rewind(number uint64) error {
+	batch := new(leveldb.Batch)
+
+	iter := t.db.NewIterator(&leveldbUtil.Range{Start: t.key(number), Limit: leveldbUtil.BytesPrefix([]byte(t.prefix)).Limit}, nil)
+	for iter.Next() {
+		batch.Delete(append([]byte{}, iter.Key()...))
+	}
+	iter.Release()
+
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	return t.db.Write(batch, nil)
+}
+
+// CheckReorg checks the canonical header against the processed blocks. If the
+// chain reorged it returns the first block number which has to be processed again.
+func (t *BlockTracker) CheckReorg(ctx context.Context, fetcher HeaderFetcher, header *types.Header) (uint64, bool, error) {
+	number := header.Number.Uint64()
+
+	// same block processed before
+	hash, found, err := t.Get(number)
+	if err != nil {
+		return 0, false, err
+	}
+	if found && hash == header.Hash() {
+		return 0, false, nil
+	}
+
+	// processed blocks replaced by the header, or above it
+	replaced := found
+	if !replaced {
+		iter := t.db.NewIterator(&leveldbUtil.Range{Start: t.key(number), Limit: leveldbUtil.BytesPrefix([]byte(t.prefix)).Limit}, nil)
+		replaced = iter.Next()
+		iter.Release()
+	}
+
+	// walk back to the latest processed block still on the canonical chain
+	blocks, err := t.blocksBelow(number)
+	if err != nil {
+		return 0, false, err
+	}
+
+	for _, block := range blocks {
+		canonicalHash := header.ParentHash
+		if block.Number != number-1 {
+			canonical, err := fetcher.HeaderByNumber(ctx, new(big.Int).SetUint64(block.Number))
+			if err != nil {
+				return 0, false, err
+			}
+			canonicalHash = canonical.Hash()
+		}
+
+		if canonicalHash == block.Hash {
+			if block.Number == blocks[0].Number && !replaced {
+				return 0, false, nil
+			}
+			return block.Number + 1, true, nil
+		}
+	}
+
+	if len(blocks) == 0 {
+		if replaced {
+			return number, true, nil
+		}
+		return 0, false, nil
+	}
+
+	// no common block in the window, process the whole window again
+	return blocks[len(blocks)-1].Number, true, nil
+}
+
+// blocksBelow returns the processed blocks below the given number, latest first
+func (t *BlockTracker) blocksBelow(number uint64) ([]trackedBlock, error) {
+	iter := t.db.NewIterator(&leveldbUtil.Range{Start: t.key(0), Limit: t.key(number)}, nil)
+	defer iter.Release()
+
+	var blocks []trackedBlock
+	for ok := iter.Last(); ok; ok = iter.Prev() {
+		blocks = append(blocks, trackedBlock{
+			Number: binary.BigEndian.Uint64(iter.Key()[len(t.prefix):]),
+			Hash:   common.BytesToHash(iter.Value()),
+		})
+	}
+
+	return blocks, iter.Error()
+}
+
+func (t *BlockTracker) key(number uint64) []byte {
+	key := make([]byte, len(t.prefix)+8)
+	copy(key, t.prefix)
+	binary.BigEndian.PutUint64(key[len(t.prefix):], number)
+	return key
+}
+
+// LogTracker keeps the logs for which tasks were queued in the bridge db, so
+// logs of re-scanned blocks are not queued again. Logs are kept by tx hash and
+// log index, and indexed by block number to prune them up to a block.
+type LogTracker struct {
+	db     *leveldb.DB
+	prefix string
+}
+
+// logTrackerBlockIndex is the storage infix of the block number index of logs
+const logTrackerBlockIndex = "block-"
+
+// logTrackerIndexedKey marks the logs tracked before the block number index as indexed
+const logTrackerIndexedKey = "indexed"
+
+// NewLogTracker creates log tracker storing logs under the given prefix
+func NewLogTracker(db *leveldb.DB, prefix string) *LogTracker {
+	return &LogTracker{
+		db:     db,
+		prefix: prefix,
+	}
+}
+
+// Has checks if the log was processed
+func (t *LogTracker) Has(log *types.Log) (bool, error) {
+	return t.db.Has(t.key(log.TxHash, log.Index), nil)
+}
+
+// Add marks the log as processed
+func (t *LogTracker) Add(log *types.Log) error {
+	key := t.key(log.TxHash, log.Index)
+
+	batch := new(leveldb.Batch)
+	batch.Put(key, []byte(strconv.FormatUint(log.BlockNumber, 10)))
+	batch.Put(t.blockKey(log.BlockNumber, key), key)
+
+	return t.db.Write(batch, nil)
+}
+
+// Prune drops the logs of blocks below the given number
+func (t *LogTracker) Prune(number uint64) error {
+	if err := t.indexLogs(); err != nil {
+		return err
+	}
+
+	batch := new(leveldb.Batch)
+
+	iter := t.db.NewIterator(&leveldbUtil.Range{Start: t.blockKey(0, nil), Limit: t.blockKey(number, nil)}, nil)
+	for iter.Next() {
+		batch.Delete(append([]byte{}, iter.Key()...))
+
+		// the log may be tracked again in a later block
+		blockNumber := binary.BigEndian.Uint64(iter.Key()[len(t.prefix)+len(logTrackerBlockIndex):])
+		value, err := t.db.Get(iter.Value(), nil)
+		if err == nil && string(value) == strconv.FormatUint(blockNumber, 10) {
+			batch.Delete(append([]byte{}, iter.Value()...))
+		}
+	}
+	iter.Release()
+
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	return t.db.Write(batch, nil)
+}
+
+// indexLogs adds the logs tracked before the block number index to it, once
+func (t *LogTracker) indexLogs() error {
+	indexedKey := []byte(t.prefix + logTrackerIndexedKey)
+	if indexed, err := t.db.Has(indexedKey, nil); err != nil || indexed {
+		return err
+	}
+
+	batch := new(leveldb.Batch)
+
+	iter := t.db.NewIterator(leveldbUtil.BytesPrefix([]byte(t.prefix+"0x")), nil)
+	for iter.Next() {
+		key := append([]byte{}, iter.Key()...)
+		if blockNumber, err := strconv.ParseUint(string(iter.Value()), 10, 64); err != nil {
+			batch.Delete(key)
+		} else {
+			batch.Put(t.blockKey(blockNumber, key), key)
+		}
+	}
+	iter.Release()
+
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	batch.Put(indexedKey, []byte{})
+	return t.db.Write(batch, nil)
+}
+
+func (t *LogTracker) key(txHash common.Hash, logIndex uint) []byte {
+	return []byte(t.prefix + txHash.Hex() + "-" + strconv.FormatUint(uint64(logIndex), 10))
+}
+
+// blockKey returns the block number index key of the log with given key
+func (t *LogTracker) blockKey(number uint64, logKey []byte) []byte {
+	key := make([]byte, len(t.prefix)+len(logTrackerBlockIndex)+8, len(t.prefix)+len(logTrackerBlockIndex)+8+len(logKey))
+	copy(key, t.prefix+logTrackerBlockIndex)
+	binary.BigEndian.PutUint64(key[len(t.prefix)+len(logTrackerBlockIndex):], number)
+	return append(key, logKey...)
+}
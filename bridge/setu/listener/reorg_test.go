@@ -0,0 +1,209 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+// testChain is a chain of headers, fork ids make the hashes of forks differ
+type testChain map[uint64]*types.Header
+
+func newTestChain(length uint64, fork int64) testChain {
+	chain := testChain{}
+	parentHash := common.Hash{}
+	for number := uint64(0); number < length; number++ {
+		header := &types.Header{Number: new(big.Int).SetUint64(number), ParentHash: parentHash, Difficulty: big.NewInt(1)}
+		chain[number] = header
+		parentHash = header.Hash()
+	}
+	return chain.fork(1, fork)
+}
+
+// fork returns a copy of the chain replacing blocks from the given number on
+func (c testChain) fork(from uint64, fork int64) testChain {
+	chain := testChain{}
+	for number := uint64(0); number < uint64(len(c)); number++ {
+		header := *c[number]
+		if number >= from {
+			header.ParentHash = chain[number-1].Hash()
+			header.Difficulty = big.NewInt(fork)
+		}
+		chain[number] = &header
+	}
+	return chain
+}
+
+func (c testChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	header, ok := c[number.Uint64()]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return header, nil
+}
+
+func newTestDB(t *testing.T) *leveldb.DB {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestBlockTrackerNoReorg(t *testing.T) {
+	tracker := NewBlockTracker(newTestDB(t), rootchainBlocksPrefix, reorgWindow)
+	chain := newTestChain(20, 1)
+
+	// nothing tracked yet
+	_, reorged, err := tracker.CheckReorg(context.Background(), chain, chain[5])
+	require.NoError(t, err)
+	require.False(t, reorged)
+	require.NoError(t, tracker.Add(chain[5]))
+
+	// next block
+	_, reorged, err = tracker.CheckReorg(context.Background(), chain, chain[6])
+	require.NoError(t, err)
+	require.False(t, reorged)
+	require.NoError(t, tracker.Add(chain[6]))
+
+	// same block again
+	_, reorged, err = tracker.CheckReorg(context.Background(), chain, chain[6])
+	require.NoError(t, err)
+	require.False(t, reorged)
+
+	// gap
+	_, reorged, err = tracker.CheckReorg(context.Background(), chain, chain[10])
+	require.NoError(t, err)
+	require.False(t, reorged)
+}
+
+func TestBlockTrackerReorg(t *testing.T) {
+	tracker := NewBlockTracker(newTestDB(t), rootchainBlocksPrefix, reorgWindow)
+	chain := newTestChain(20, 1)
+	for number := uint64(1); number <= 10; number++ {
+		require.NoError(t, tracker.Add(chain[number]))
+	}
+
+	// blocks from 8 on were replaced
+	reorgedChain := chain.fork(8, 2)
+
+	// next block on the new chain
+	reorgBlock, reorged, err := tracker.CheckReorg(context.Background(), reorgedChain, reorgedChain[11])
+	require.NoError(t, err)
+	require.True(t, reorged)
+	require.Equal(t, uint64(8), reorgBlock)
+
+	// replaced block
+	reorgBlock, reorged, err = tracker.CheckReorg(context.Background(), reorgedChain, reorgedChain[9])
+	require.NoError(t, err)
+	require.True(t, reorged)
+	require.Equal(t, uint64(8), reorgBlock)
+
+	// after rewinding the new chain is tracked
+	require.NoError(t, tracker.Rewind(reorgBlock))
+	_, found, err := tracker.Get(8)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, tracker.Add(reorgedChain[11]))
+	_, reorged, err = tracker.CheckReorg(context.Background(), reorgedChain, reorgedChain[12])
+	require.NoError(t, err)
+	require.False(t, reorged)
+}
+
+func TestBlockTrackerReorgBelowWindow(t *testing.T) {
+	tracker := NewBlockTracker(newTestDB(t), rootchainBlocksPrefix, 4)
+	chain := newTestChain(20, 1)
+	for number := uint64(1); number <= 10; number++ {
+		require.NoError(t, tracker.Add(chain[number]))
+	}
+
+	// blocks out of the window are pruned
+	_, found, err := tracker.Get(5)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// whole window is processed again
+	reorgedChain := chain.fork(2, 2)
+	reorgBlock, reorged, err := tracker.CheckReorg(context.Background(), reorgedChain, reorgedChain[11])
+	require.NoError(t, err)
+	require.True(t, reorged)
+	require.Equal(t, uint64(6), reorgBlock)
+}
+
+func TestLogTracker(t *testing.T) {
+	tracker := NewLogTracker(newTestDB(t), rootchainLogsPrefix)
+
+	first := &types.Log{TxHash: common.HexToHash("0x01"), Index: 0, BlockNumber: 10}
+	second := &types.Log{TxHash: common.HexToHash("0x01"), Index: 1, BlockNumber: 20}
+
+	require.NoError(t, tracker.Add(first))
+	require.NoError(t, tracker.Add(second))
+
+	// same log in another block after a reorg
+	processed, err := tracker.Has(&types.Log{TxHash: first.TxHash, Index: first.Index, BlockNumber: 11})
+	require.NoError(t, err)
+	require.True(t, processed)
+
+	require.NoError(t, tracker.Prune(15))
+
+	processed, err = tracker.Has(first)
+	require.NoError(t, err)
+	require.False(t, processed)
+
+	processed, err = tracker.Has(second)
+	require.NoError(t, err)
+	require.True(t, processed)
+}
+
+func TestLogTrackerPruneRetrackedLog(t *testing.T) {
+	tracker := NewLogTracker(newTestDB(t), rootchainLogsPrefix)
+
+	log := &types.Log{TxHash: common.HexToHash("0x01"), Index: 0, BlockNumber: 10}
+	require.NoError(t, tracker.Add(log))
+
+	// tracked again in a later block
+	require.NoError(t, tracker.Add(&types.Log{TxHash: log.TxHash, Index: log.Index, BlockNumber: 20}))
+
+	require.NoError(t, tracker.Prune(15))
+	processed, err := tracker.Has(log)
+	require.NoError(t, err)
+	require.True(t, processed)
+
+	require.NoError(t, tracker.Prune(25))
+	processed, err = tracker.Has(log)
+	require.NoError(t, err)
+	require.False(t, processed)
+}
+
+func TestLogTrackerPruneUnindexedLogs(t *testing.T) {
+	db := newTestDB(t)
+	tracker := NewLogTracker(db, rootchainLogsPrefix)
+
+	// logs tracked before the block number index
+	first := &types.Log{TxHash: common.HexToHash("0x01"), Index: 0, BlockNumber: 10}
+	second := &types.Log{TxHash: common.HexToHash("0x02"), Index: 0, BlockNumber: 20}
+	require.NoError(t, db.Put(tracker.key(first.TxHash, first.Index), []byte("10"), nil))
+	require.NoError(t, db.Put(tracker.key(second.TxHash, second.Index), []byte("20"), nil))
+
+	require.NoError(t, tracker.Prune(15))
+
+	processed, err := tracker.Has(first)
+	require.NoError(t, err)
+	require.False(t, processed)
+
+	processed, err = tracker.Has(second)
+	require.NoError(t, err)
+	require.True(t, processed)
+
+	require.NoError(t, tracker.Prune(25))
+	processed, err = tracker.Has(second)
+	require.NoError(t, err)
+	require.False(t, processed)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"math/big"
 	"strconv"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/maticnetwork/bor/accounts/abi"
 	ethCommon "github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
 	"github.com/maticnetwork/heimdall/helper"
@@ -32,6 +34,10 @@ type RootChainListener struct {
 	abis []*abi.ABI
 
 	stakingInfoAbi *abi.ABI
+
+	// processed blocks and logs, to handle reorgs
+	blockTracker *BlockTracker
+	logTracker   *LogTracker
 }
 
 const (
@@ -60,6 +66,9 @@ func NewRootChainListener() *RootChainListener {
 func (rl *RootChainListener) Start() error {
 	rl.Logger.Info("Starting")
 
+	rl.blockTracker = NewBlockTracker(rl.storageClient, rootchainBlocksPrefix, reorgWindow)
+	rl.logTracker = NewLogTracker(rl.storageClient, rootchainLogsPrefix)
+
 	// create cancellable context
 	ctx, cancelSubscription := context.WithCancel(context.Background())
 	rl.cancelSubscription = cancelSubscription
@@ -137,16 +146,57 @@ func (rl *RootChainListener) ProcessHeader(newHeader *types.Header) {
 		fromBlock = toBlock
 	}
 
+	// re-scan blocks replaced by a reorg
+	toHeader, err := rl.chainClient.HeaderByNumber(context.Background(), toBlock)
+	if err != nil {
+		rl.Logger.Error("Error while fetching rootchain header", "blockNumber", toBlock, "error", err)
+		return
+	}
+
+	reorgBlock, reorged, err := rl.blockTracker.CheckReorg(context.Background(), rl.chainClient, toHeader)
+	if err != nil {
+		rl.Logger.Error("Error while checking rootchain reorg", "error", err)
+		return
+	}
+
+	if reorged {
+		rl.Logger.Info("Rootchain reorg detected, re-scanning blocks", "fromBlock", reorgBlock, "toBlock", toBlock)
+		if err := rl.blockTracker.Rewind(reorgBlock); err != nil {
+			rl.Logger.Error("Error while rewinding processed blocks", "error", err)
+			return
+		}
+
+		// re-scan from the fork point, also if querying the events fails
+		if reorgBlock < fromBlock.Uint64() {
+			fromBlock = new(big.Int).SetUint64(reorgBlock)
+			if err := rl.storageClient.Put([]byte(lastRootBlockKey), []byte(strconv.FormatUint(reorgBlock-1, 10)), nil); err != nil {
+				rl.Logger.Error("rl.storageClient.Put", "Error", err)
+				return
+			}
+		}
+	}
+
+	// query events
+	if err := rl.queryAndBroadcastEvents(rootchainContext, fromBlock, toBlock); err != nil {
+		rl.Logger.Error("Error while querying rootchain events", "fromBlock", fromBlock, "toBlock", toBlock, "error", err)
+		return
+	}
+
+	if err := rl.blockTracker.Add(toHeader); err != nil {
+		rl.Logger.Error("Error while storing processed block", "error", err)
+	}
+
 	// set last block to storage
 	if err := rl.storageClient.Put([]byte(lastRootBlockKey), []byte(toBlock.String()), nil); err != nil {
 		rl.Logger.Error("rl.storageClient.Put", "Error", err)
 	}
-
-	// query events
-	rl.queryAndBroadcastEvents(rootchainContext, fromBlock, toBlock)
+	metrics.LastProcessedBlock.WithLabelValues(metrics.RootChain).Set(float64(toBlock.Uint64()))
 }
 
-func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChainListenerContext, fromBlock *big.Int, toBlock *big.Int) {
+// queryAndBroadcastEvents queues the tasks of the rootchain logs in the range.
+// It stops at the first log failing to be queued, logs queued before it are
+// skipped once the range is queried again.
+func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChainListenerContext, fromBlock *big.Int, toBlock *big.Int) error {
 	rl.Logger.Info("Query rootchain event logs", "fromBlock", fromBlock, "toBlock", toBlock)
 
 	// current public key
@@ -165,14 +215,23 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 	// get logs from rootchain by filter
 	logs, err := rl.contractConnector.MainChainClient.FilterLogs(context.Background(), query)
 	if err != nil {
-		rl.Logger.Error("Error while filtering logs", "error", err)
-		return
+		return fmt.Errorf("filtering logs: %w", err)
 	} else if len(logs) > 0 {
 		rl.Logger.Debug("New logs found", "numberOfLogs", len(logs))
 	}
 
 	// process filtered log
 	for _, vLog := range logs {
+		// logs of re-scanned blocks are queued only once
+		if processed, err := rl.logTracker.Has(&vLog); err != nil {
+			rl.Logger.Error("Error while checking processed log", "txHash", vLog.TxHash, "logIndex", vLog.Index, "error", err)
+			continue
+		} else if processed {
+			rl.Logger.Debug("Skipping processed log", "txHash", vLog.TxHash, "logIndex", vLog.Index)
+			continue
+		}
+
+		var sendErr error
 		topic := vLog.Topics[0].Bytes()
 		for _, abiObject := range rl.abis {
 			selectedEvent := helper.EventByID(abiObject, topic)
@@ -182,7 +241,7 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 				switch selectedEvent.Name {
 				case "NewHeaderBlock":
 					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendCheckpointAckToHeimdall", selectedEvent.Name, logBytes, delay)
+						sendErr = rl.sendTaskWithDelay("sendCheckpointAckToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 				case "Staked":
 					event := new(stakinginfo.StakinginfoStaked)
@@ -192,11 +251,11 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 					if bytes.Equal(event.SignerPubkey, pubkeyBytes) {
 						// topup has to be processed first before validator join. so adding delay.
 						delay := util.TaskDelayBetweenEachVal
-						rl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, delay)
+						sendErr = rl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, delay)
 					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
 						// topup has to be processed first before validator join. so adding delay.
 						delay = delay + util.TaskDelayBetweenEachVal
-						rl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, delay)
+						sendErr = rl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
 				case "StakeUpdate":
@@ -205,9 +264,9 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
 					}
 					if util.IsEventSender(rl.cliCtx, event.ValidatorId.Uint64()) {
-						rl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, 0)
+						sendErr = rl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, 0)
 					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, delay)
+						sendErr = rl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
 				case "SignerChange":
@@ -216,9 +275,9 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
 					}
 					if bytes.Equal(event.SignerPubkey, pubkeyBytes) {
-						rl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, 0)
+						sendErr = rl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, 0)
 					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, delay)
+						sendErr = rl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
 				case "UnstakeInit":
@@ -227,14 +286,14 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
 					}
 					if util.IsEventSender(rl.cliCtx, event.ValidatorId.Uint64()) {
-						rl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, 0)
+						sendErr = rl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, 0)
 					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, delay)
+						sendErr = rl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
 				case "StateSynced":
 					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendStateSyncedToHeimdall", selectedEvent.Name, logBytes, delay)
+						sendErr = rl.sendTaskWithDelay("sendStateSyncedToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
 				case "TopUpFee":
@@ -243,14 +302,14 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
 					}
 					if bytes.Equal(event.User.Bytes(), helper.GetAddress()) {
-						rl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, 0)
+						sendErr = rl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, 0)
 					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, delay)
+						sendErr = rl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
 				case "Slashed":
 					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendTickAckToHeimdall", selectedEvent.Name, logBytes, delay)
+						sendErr = rl.sendTaskWithDelay("sendTickAckToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 
 				case "UnJailed":
@@ -259,17 +318,34 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
 					}
 					if util.IsEventSender(rl.cliCtx, event.ValidatorId.Uint64()) {
-						rl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, 0)
+						sendErr = rl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, 0)
 					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, delay)
+						sendErr = rl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, delay)
 					}
 				}
 			}
 		}
+
+		if sendErr != nil {
+			return fmt.Errorf("queueing log %s/%d: %w", vLog.TxHash.Hex(), vLog.Index, sendErr)
+		}
+
+		if err := rl.logTracker.Add(&vLog); err != nil {
+			rl.Logger.Error("Error while storing processed log", "txHash", vLog.TxHash, "logIndex", vLog.Index, "error", err)
+		}
 	}
+
+	// forget logs of blocks out of the reorg window
+	if toBlock.Uint64() > reorgWindow {
+		if err := rl.logTracker.Prune(toBlock.Uint64() - reorgWindow); err != nil {
+			rl.Logger.Error("Error while pruning processed logs", "error", err)
+		}
+	}
+
+	return nil
 }
 
-func (rl *RootChainListener) sendTaskWithDelay(taskName string, eventName string, logBytes []byte, delay time.Duration) {
+func (rl *RootChainListener) sendTaskWithDelay(taskName string, eventName string, logBytes []byte, delay time.Duration) error {
 	signature := &tasks.Signature{
 		Name: taskName,
 		Args: []tasks.Arg{
@@ -283,16 +359,16 @@ func (rl *RootChainListener) sendTaskWithDelay(taskName string, eventName string
 			},
 		},
 	}
-	signature.RetryCount = 3
 
 	// add delay for task so that multiple validators won't send same transaction at same time
 	eta := time.Now().Add(delay)
 	signature.ETA = &eta
 	rl.Logger.Info("Sending task", "taskName", taskName, "currentTime", time.Now(), "delayTime", eta)
-	_, err := rl.queueConnector.Server.SendTask(signature)
+	err := rl.queueConnector.SendTask(signature)
 	if err != nil {
 		rl.Logger.Error("Error sending task", "taskName", taskName, "error", err)
 	}
+	return err
 }
 
 //
@@ -3,6 +3,7 @@ package listener
 import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
@@ -16,6 +17,10 @@ const (
 	RootChainListenerStr  = "rootchain"
 	HeimdallListenerStr   = "heimdall"
 	MaticChainListenerStr = "maticchain"
+
+	// listenerLivenessPolls is the number of poll intervals a listener may go
+	// without processing a header before it is reported dead
+	listenerLivenessPolls = 3
 )
 
 // var logger = util.Logger().With("service", ListenerServiceStr)
@@ -61,7 +66,9 @@ func (listenerService *ListenerService) OnStart() error {
 	for _, listener := range listenerService.listeners {
 		if err := listener.Start(); err != nil {
 			listenerService.Logger.Error("OnStart | Start", "Error", err)
+			continue
 		}
+		metrics.MarkActive(listener.String())
 	}
 
 	listenerService.Logger.Info("all listeners Started")
@@ -80,3 +87,24 @@ func (listenerService *ListenerService) OnStop() {
 	listenerService.Logger.Info("all listeners stopped")
 
 }
+
+// Health reports the service liveness and whether each listener processed
+// headers recently
+func (listenerService *ListenerService) Health() metrics.ServiceHealth {
+	// listeners fall back to polling, allow a few of the longest poll intervals
+	timeout := helper.GetConfig().SyncerPollInterval
+	if helper.GetConfig().CheckpointerPollInterval > timeout {
+		timeout = helper.GetConfig().CheckpointerPollInterval
+	}
+	timeout *= listenerLivenessPolls
+
+	health := metrics.ServiceHealth{
+		Running:    listenerService.IsRunning(),
+		Components: make(map[string]metrics.ComponentHealth, len(listenerService.listeners)),
+	}
+	for _, listener := range listenerService.listeners {
+		health.Components[listener.String()] = metrics.ActivityHealth(listener.String(), timeout)
+	}
+
+	return health
+}
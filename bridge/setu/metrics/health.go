@@ -0,0 +1,114 @@
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ComponentHealth is the liveness of a listener or processor
+type ComponentHealth struct {
+	Alive      bool       `json:"alive"`
+	LastActive *time.Time `json:"last_active,omitempty"`
+	Error      string     `json:"error,omitempty"`
+}
+
+// ServiceHealth is the liveness of a bridge service and its components
+type ServiceHealth struct {
+	Running    bool                       `json:"running"`
+	Components map[string]ComponentHealth `json:"components"`
+}
+
+// Healthy checks that the service and all its components are alive
+func (h ServiceHealth) Healthy() bool {
+	if !h.Running {
+		return false
+	}
+
+	for _, component := range h.Components {
+		if !component.Alive {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HealthReporter reports the liveness of a bridge service
+type HealthReporter interface {
+	String() string
+
+	Health() ServiceHealth
+}
+
+// healthResponse is the body of the health endpoint
+type healthResponse struct {
+	Healthy  bool                     `json:"healthy"`
+	Services map[string]ServiceHealth `json:"services"`
+}
+
+// HealthHandler serves the liveness of the services, it responds with 503 when
+// any service is unhealthy
+func HealthHandler(reporters ...HealthReporter) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := healthResponse{
+			Healthy:  true,
+			Services: make(map[string]ServiceHealth, len(reporters)),
+		}
+
+		for _, reporter := range reporters {
+			health := reporter.Health()
+			response.Services[reporter.String()] = health
+			response.Healthy = response.Healthy && health.Healthy()
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if !response.Healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+
+		_ = json.NewEncoder(w).Encode(response)
+	})
+}
+
+// activity keeps the last time each component made progress
+var activity = struct {
+	sync.RWMutex
+	lastActive map[string]time.Time
+}{lastActive: make(map[string]time.Time)}
+
+// MarkActive records that the component made progress
+func MarkActive(component string) {
+	activity.Lock()
+	defer activity.Unlock()
+
+	activity.lastActive[component] = time.Now()
+}
+
+// LastActive returns the last time the component made progress
+func LastActive(component string) (time.Time, bool) {
+	activity.RLock()
+	defer activity.RUnlock()
+
+	lastActive, ok := activity.lastActive[component]
+	return lastActive, ok
+}
+
+// ActivityHealth reports the component alive if it made progress within the timeout
+func ActivityHealth(component string, timeout time.Duration) ComponentHealth {
+	lastActive, ok := LastActive(component)
+	if !ok {
+		return ComponentHealth{Error: "not started"}
+	}
+
+	health := ComponentHealth{
+		Alive:      time.Since(lastActive) <= timeout,
+		LastActive: &lastActive,
+	}
+	if !health.Alive {
+		health.Error = "no progress since " + lastActive.UTC().Format(time.RFC3339)
+	}
+
+	return health
+}
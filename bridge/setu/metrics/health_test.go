@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testReporter struct {
+	name   string
+	health ServiceHealth
+}
+
+func (r testReporter) String() string { return r.name }
+
+func (r testReporter) Health() ServiceHealth { return r.health }
+
+func getHealth(t *testing.T, reporters ...HealthReporter) (int, healthResponse) {
+	recorder := httptest.NewRecorder()
+	HealthHandler(reporters...).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	var response healthResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	return recorder.Code, response
+}
+
+func TestHealthHandler(t *testing.T) {
+	listener := testReporter{name: "listener", health: ServiceHealth{
+		Running:    true,
+		Components: map[string]ComponentHealth{"rootchain": {Alive: true}},
+	}}
+	processor := testReporter{name: "processor", health: ServiceHealth{
+		Running:    true,
+		Components: map[string]ComponentHealth{"clerk": {Alive: true}},
+	}}
+
+	code, response := getHealth(t, listener, processor)
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, response.Healthy)
+	require.True(t, response.Services["listener"].Components["rootchain"].Alive)
+
+	// dead component
+	processor.health.Components["clerk"] = ComponentHealth{Error: "failed"}
+	code, response = getHealth(t, listener, processor)
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.False(t, response.Healthy)
+	require.Equal(t, "failed", response.Services["processor"].Components["clerk"].Error)
+
+	// stopped service
+	code, response = getHealth(t, testReporter{name: "listener"})
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.False(t, response.Healthy)
+}
+
+func TestActivityHealth(t *testing.T) {
+	require.False(t, ActivityHealth("test-listener", time.Minute).Alive)
+
+	MarkActive("test-listener")
+	health := ActivityHealth("test-listener", time.Minute)
+	require.True(t, health.Alive)
+	require.NotNil(t, health.LastActive)
+
+	time.Sleep(10 * time.Millisecond)
+	require.False(t, ActivityHealth("test-listener", time.Millisecond).Alive)
+}
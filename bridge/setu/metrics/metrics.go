@@ -0,0 +1,137 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "heimdall_bridge"
+
+// Chain labels
+const (
+	RootChain  = "rootchain"
+	MaticChain = "maticchain"
+	Heimdall   = "heimdall"
+)
+
+// Registry holds the bridge collectors
+var Registry = prometheus.NewRegistry()
+
+var (
+	// HeadersProcessed counts the headers (blocks) processed by each listener
+	HeadersProcessed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "listener",
+		Name:      "headers_processed_total",
+		Help:      "Number of headers processed by the listener.",
+	}, []string{"listener"})
+
+	// LastProcessedBlock is the last block processed on each chain
+	LastProcessedBlock = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "listener",
+		Name:      "last_processed_block",
+		Help:      "Last block processed by the listener of the chain.",
+	}, []string{"chain"})
+
+	// TasksEnqueued counts the tasks sent to the queue
+	TasksEnqueued = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "processor",
+		Name:      "tasks_enqueued_total",
+		Help:      "Number of tasks sent to the queue.",
+	}, []string{"task"})
+
+	// TasksSucceeded counts the tasks run successfully
+	TasksSucceeded = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "processor",
+		Name:      "tasks_succeeded_total",
+		Help:      "Number of tasks run successfully.",
+	}, []string{"task"})
+
+	// TasksFailed counts the failed task attempts
+	TasksFailed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "processor",
+		Name:      "tasks_failed_total",
+		Help:      "Number of failed task attempts.",
+	}, []string{"task"})
+
+	// TasksDeadLettered counts the tasks moved to the dead-letter store
+	TasksDeadLettered = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "processor",
+		Name:      "tasks_dead_lettered_total",
+		Help:      "Number of tasks which failed all their attempts.",
+	}, []string{"task"})
+
+	// BroadcastDuration observes the latency of tx broadcasts to each chain
+	BroadcastDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "broadcaster",
+		Name:      "broadcast_duration_seconds",
+		Help:      "Latency of tx broadcasts.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+	}, []string{"chain", "status"})
+
+	// SequenceMismatches counts the heimdall broadcasts failing with a stale
+	// account sequence
+	SequenceMismatches = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "broadcaster",
+		Name:      "heimdall_sequence_mismatches_total",
+		Help:      "Number of heimdall broadcasts after which the account sequence had to be refetched.",
+	})
+
+	// HeimdallTxResubmissions counts the pending heimdall txs sent again, with
+	// the same or a new sequence
+	HeimdallTxResubmissions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "broadcaster",
+		Name:      "heimdall_tx_resubmissions_total",
+		Help:      "Number of pending heimdall txs sent again.",
+	}, []string{"reason"})
+
+	// HeimdallBatchMsgs observes the number of msgs of the multi-msg heimdall txs
+	HeimdallBatchMsgs = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "broadcaster",
+		Name:      "heimdall_batch_msgs",
+		Help:      "Number of msgs of the batched heimdall txs.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 9),
+	}, []string{"msg"})
+
+	// RootChainGasUsed counts the gas spent by txs of the validator on the rootchain
+	RootChainGasUsed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "broadcaster",
+		Name:      "rootchain_gas_used_total",
+		Help:      "Gas used by the rootchain txs sent by the validator.",
+	}, []string{"method"})
+)
+
+func init() {
+	Registry.MustRegister(
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		prometheus.NewGoCollector(),
+		HeadersProcessed,
+		LastProcessedBlock,
+		TasksEnqueued,
+		TasksSucceeded,
+		TasksFailed,
+		TasksDeadLettered,
+		BroadcastDuration,
+		SequenceMismatches,
+		HeimdallTxResubmissions,
+		HeimdallBatchMsgs,
+		RootChainGasUsed,
+	)
+}
+
+// BroadcastStatus returns the status label of a broadcast
+func BroadcastStatus(err error) string {
+	if err != nil {
+		return "failure"
+	}
+	return "success"
+}
@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const serverShutdownTimeout = 5 * time.Second
+
+// Server serves the prometheus metrics on /metrics and the liveness of the
+// bridge services on /health
+type Server struct {
+	logger log.Logger
+	server *http.Server
+}
+
+// NewServer creates the metrics server listening on the given address
+func NewServer(addr string, logger log.Logger, reporters ...HealthReporter) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(Registry, promhttp.HandlerOpts{}))
+	mux.Handle("/health", HealthHandler(reporters...))
+
+	return &Server{
+		logger: logger,
+		server: &http.Server{
+			Addr:    addr,
+			Handler: mux,
+		},
+	}
+}
+
+// Start starts serving in the background
+func (s *Server) Start() {
+	s.logger.Info("Starting metrics server", "address", s.server.Addr)
+
+	go func() {
+		if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			s.logger.Error("Metrics server stopped", "error", err)
+		}
+	}()
+}
+
+// Stop shuts the server down
+func (s *Server) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), serverShutdownTimeout)
+	defer cancel()
+
+	return s.server.Shutdown(ctx)
+}
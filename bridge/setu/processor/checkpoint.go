@@ -10,14 +10,11 @@ import (
 	"strconv"
 	"time"
 
-	topuptypes "github.com/maticnetwork/heimdall/x/topup/types"
-
-	"github.com/gogo/protobuf/jsonpb"
-
 	"github.com/cosmos/cosmos-sdk/client"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	bor "github.com/maticnetwork/bor"
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
@@ -69,13 +66,13 @@ func (cp *CheckpointProcessor) Start() error {
 // RegisterTasks - Registers checkpoint related tasks with machinery
 func (cp *CheckpointProcessor) RegisterTasks() {
 	cp.Logger.Info("Registering checkpoint tasks")
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointToHeimdall", cp.sendCheckpointToHeimdall); err != nil {
+	if err := cp.queueConnector.RegisterTask("sendCheckpointToHeimdall", cp.sendCheckpointToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointToHeimdall", "error", err)
 	}
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointToRootchain", cp.sendCheckpointToRootchain); err != nil {
+	if err := cp.queueConnector.RegisterTask("sendCheckpointToRootchain", cp.sendCheckpointToRootchain); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointToRootchain", "error", err)
 	}
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointAckToHeimdall", cp.sendCheckpointAckToHeimdall); err != nil {
+	if err := cp.queueConnector.RegisterTask("sendCheckpointAckToHeimdall", cp.sendCheckpointAckToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointAckToHeimdall", "error", err)
 	}
 }
@@ -519,17 +516,19 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(params util.Pa
 		chainParams := params.ChainmanagerParams.ChainParams
 		// root chain address
 		rootChainAddress := common.HexToAddress(chainParams.RootChainAddress)
-		// root chain instance
-		rootChainInstance, err := cp.contractConnector.GetRootChainInstance(rootChainAddress)
+		// submit header block tx data
+		data, err := cp.contractConnector.RootChainABI.Pack("submitHeaderBlock", sideTxData, sigs)
 		if err != nil {
-			cp.Logger.Info("Error while creating rootchain instance", "error", err)
+			cp.Logger.Error("Unable to pack tx for submitHeaderBlock", "error", err)
 			return err
 		}
 
-		if err := cp.contractConnector.SendCheckpoint(sideTxData, sigs, rootChainAddress, rootChainInstance); err != nil {
+		txHash, err := cp.txBroadcaster.BroadcastToRootchain("submitHeaderBlock", bor.CallMsg{To: &rootChainAddress, Data: data})
+		if err != nil {
 			cp.Logger.Info("Error submitting checkpoint to rootchain", "error", err)
 			return err
 		}
+		cp.Logger.Info("Submitted new checkpoint to rootchain", "txHash", txHash, "start", start, "end", end)
 	}
 
 	return nil
@@ -537,19 +536,15 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(params util.Pa
 
 // fetchDividendAccountRoot - fetches dividend accountroothash
 func (cp *CheckpointProcessor) fetchDividendAccountRoot() (accountRootHash hmCommonTypes.HeimdallHash, err error) {
-	cp.Logger.Info("Sending Rest call to Get Dividend AccountRootHash")
-	response, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(util.DividendAccountRootURL))
+	cp.Logger.Info("Sending query to Get Dividend AccountRootHash")
+	accountRootHash, err = util.GetHeimdallClient().DividendAccountRoot()
 	if err != nil {
-		cp.Logger.Error("Error Fetching account-root-hash from HeimdallServer ", "error", err)
+		cp.Logger.Error("Error Fetching account-root-hash from Heimdall", "error", err)
 		return accountRootHash, err
 	}
+
 	cp.Logger.Info("Divident account root fetched")
-	var accountRootHashResponse topuptypes.QueryDividendAccountRootResponse
-	if err := jsonpb.UnmarshalString(string(response), &accountRootHashResponse); err != nil {
-		cp.Logger.Error("Error unmarshalling account-root-hash received from Heimdall Server", "error", err)
-		return accountRootHash, err
-	}
-	return hmCommonTypes.HexToHeimdallHash(accountRootHashResponse.GetAccountRootHash()), nil
+	return accountRootHash, nil
 }
 
 // fetchLatestCheckpointTime - get latest checkpoint time from rootchain
@@ -580,19 +575,13 @@ func (cp *CheckpointProcessor) getLatestCheckpointTime(params util.Params) (int6
 }
 
 func (cp *CheckpointProcessor) getLastNoAckTime() uint64 {
-	response, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(util.LastNoAckURL))
+	lastNoAck, err := util.GetHeimdallClient().LastNoAck()
 	if err != nil {
 		cp.Logger.Error("Error while sending request for last no-ack", "Error", err)
 		return 0
 	}
 
-	var noackObject util.LastNoAckResponse
-	if err := json.Unmarshal(response, &noackObject); err != nil {
-		cp.Logger.Error("Error unmarshalling no-ack data ", "error", err)
-		return 0
-	}
-
-	return noackObject.LastNoAck
+	return lastNoAck
 }
 
 // checkIfNoAckIsRequired - check if NoAck has to be sent or not
@@ -1,16 +1,26 @@
 package processor
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
+	"math/big"
+	"sync"
+	"time"
 
+	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethereum "github.com/maticnetwork/bor"
 	"github.com/maticnetwork/bor/accounts/abi"
+	ethCommon "github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/contracts/statesender"
 	"github.com/maticnetwork/heimdall/helper"
 	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
+	clerkKeeper "github.com/maticnetwork/heimdall/x/clerk/keeper"
 	clerkTypes "github.com/maticnetwork/heimdall/x/clerk/types"
 )
 
@@ -18,8 +28,20 @@ import (
 type ClerkProcessor struct {
 	BaseProcessor
 	stateSenderAbi *abi.ABI
+
+	// missing records polling
+	cancelMissingRecordsPolling context.CancelFunc
+	// keeps the poll and the record events from resending the same records
+	missingRecordsMu sync.Mutex
 }
 
+const (
+	// missingRecordsLimit is the max number of missing records resubmitted per poll
+	missingRecordsLimit = 10
+	// missingRecordsBlockRange is the max number of rootchain blocks filtered per logs query
+	missingRecordsBlockRange = 5000
+)
+
 // NewClerkProcessor - add statesender abi to clerk processor
 func NewClerkProcessor(stateSenderAbi *abi.ABI) *ClerkProcessor {
 	clerkProcessor := &ClerkProcessor{
@@ -31,15 +53,30 @@ func NewClerkProcessor(stateSenderAbi *abi.ABI) *ClerkProcessor {
 // Start starts new block subscription
 func (cp *ClerkProcessor) Start() error {
 	cp.Logger.Info("Starting")
+
+	// missing records
+	missingRecordsCtx, cancelMissingRecordsPolling := context.WithCancel(context.Background())
+	cp.cancelMissingRecordsPolling = cancelMissingRecordsPolling
+	cp.Logger.Info("Start polling for missing records", "pollInterval", helper.GetConfig().ClerkPollInterval)
+	go cp.startPollingForMissingRecords(missingRecordsCtx, helper.GetConfig().ClerkPollInterval)
 	return nil
 }
 
+// Stop stops all necessary go routines
+func (cp *ClerkProcessor) Stop() {
+	// cancel missing records polling
+	cp.cancelMissingRecordsPolling()
+}
+
 // RegisterTasks - Registers clerk related tasks with machinery
 func (cp *ClerkProcessor) RegisterTasks() {
 	cp.Logger.Info("Registering clerk tasks")
-	if err := cp.queueConnector.Server.RegisterTask("sendStateSyncedToHeimdall", cp.sendStateSyncedToHeimdall); err != nil {
+	if err := cp.queueConnector.RegisterTaskWithRetryPolicy("sendStateSyncedToHeimdall", cp.sendStateSyncedToHeimdall, queue.RootChainEventRetryPolicy); err != nil {
 		cp.Logger.Error("RegisterTasks | sendStateSyncedToHeimdall", "error", err)
 	}
+	if err := cp.queueConnector.RegisterTask("sendMissingRecordsToHeimdall", cp.sendMissingRecordsToHeimdall); err != nil {
+		cp.Logger.Error("RegisterTasks | sendMissingRecordsToHeimdall", "error", err)
+	}
 }
 
 // HandleStateSyncEvent - handle state sync event from rootchain
@@ -110,29 +147,186 @@ func (cp *ClerkProcessor) sendStateSyncedToHeimdall(eventName string, logBytes s
 	return nil
 }
 
-// isOldTx  checks if tx is already processed or not
-func (cp *ClerkProcessor) isOldTx(cliCtx client.Context, txHash string, logIndex uint64) (bool, error) {
-	queryParam := map[string]interface{}{
-		"txhash":   txHash,
-		"logindex": logIndex,
+func (cp *ClerkProcessor) startPollingForMissingRecords(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	// stop ticker when everything done
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			// run inline so that a slow resend skips the ticks overlapping it
+			cp.resendMissingRecords()
+		case <-ctx.Done():
+			cp.Logger.Info("Missing records polling stopped")
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+// sendMissingRecordsToHeimdall - handles a record committed on heimdall, records
+// buffered behind missing ones have the missing records sent again right away
+// instead of on the next poll
+func (cp *ClerkProcessor) sendMissingRecordsToHeimdall(eventBytes string, txHash string, blockHeight int64) error {
+	var event sdk.StringEvent
+	if err := json.Unmarshal([]byte(eventBytes), &event); err != nil {
+		cp.Logger.Error("Error unmarshalling event from heimdall", "error", err)
+		return err
+	}
+
+	if !isRecordBuffered(event) {
+		return nil
 	}
 
-	endpoint := helper.GetHeimdallServerEndpoint(util.ClerkTxStatusURL)
-	url, err := util.CreateURLWithQuery(endpoint, queryParam)
+	cp.Logger.Info("Record buffered on heimdall, sending missing records", "txHash", txHash, "blockHeight", blockHeight)
+	cp.resendMissingRecords()
+	return nil
+}
+
+// isRecordBuffered checks if the record of the event was buffered behind missing records
+func isRecordBuffered(event sdk.StringEvent) bool {
+	for _, attr := range event.Attributes {
+		if attr.Key == clerkTypes.AttributeKeyRecordBuffered {
+			return attr.Value == "true"
+		}
+	}
+	return false
+}
+
+// resendMissingRecords - fetches the StateSynced logs of the records missing on heimdall
+// from rootchain and queues them to be sent to heimdall again, if we are the current proposer
+func (cp *ClerkProcessor) resendMissingRecords() {
+	cp.missingRecordsMu.Lock()
+	defer cp.missingRecordsMu.Unlock()
+
+	if isProposer, err := util.IsProposer(cp.cliCtx); err != nil || !isProposer {
+		return
+	}
+
+	heimdallClient := util.GetHeimdallClient()
+	missingIDs, err := heimdallClient.ClerkMissingRecords(missingRecordsLimit)
 	if err != nil {
-		cp.Logger.Error("Error in creating url", "endpoint", endpoint, "error", err)
-		return false, err
+		cp.Logger.Error("Error fetching missing records", "error", err)
+		return
+	} else if len(missingIDs) == 0 {
+		return
 	}
 
-	res, err := helper.FetchFromAPI(url)
+	params, err := cp.paramsContext.GetParams()
 	if err != nil {
-		cp.Logger.Error("Error fetching tx status", "url", url, "error", err)
-		return false, err
+		cp.Logger.Error("Error fetching params", "error", err)
+		return
+	}
+
+	cp.Logger.Info("Missing records found", "ids", missingIDs)
+
+	eventName := "StateSynced"
+	logs, err := cp.getMissingRecordLogs(heimdallClient, params.ChainmanagerParams.ChainParams.StateSenderAddress, eventName, missingIDs)
+	if err != nil {
+		cp.Logger.Error("Error while filtering missing record logs", "error", err)
+		return
 	}
 
-	var status bool
-	if err := json.Unmarshal(res, &status); err != nil {
-		cp.Logger.Error("Error unmarshalling tx status received from Heimdall Server", "error", err)
+	cp.queueMissingRecords(eventName, logs)
+}
+
+// queueMissingRecords - queues a task sending the log of each missing record to heimdall,
+// so that each record is retried on its own with the retry policy of rootchain events
+func (cp *ClerkProcessor) queueMissingRecords(eventName string, logs []types.Log) {
+	for _, vLog := range logs {
+		logBytes, err := json.Marshal(vLog)
+		if err != nil {
+			cp.Logger.Error("Error while marshalling missing record log", "error", err)
+			continue
+		}
+
+		signature := &tasks.Signature{
+			Name: "sendStateSyncedToHeimdall",
+			Args: []tasks.Arg{
+				{
+					Type:  "string",
+					Value: eventName,
+				},
+				{
+					Type:  "string",
+					Value: string(logBytes),
+				},
+			},
+		}
+		if err := cp.queueConnector.SendTask(signature); err != nil {
+			cp.Logger.Error("Error while queueing missing record", "txHash", vLog.TxHash, "logIndex", vLog.Index, "error", err)
+		}
+	}
+}
+
+// getMissingRecordLogs - fetches the StateSynced logs of the missing records from rootchain,
+// starting at the block of the record stored before the first missing one. Logs are
+// filtered in capped block ranges until all records are found or the latest block is reached.
+func (cp *ClerkProcessor) getMissingRecordLogs(heimdallClient *util.HeimdallClient, stateSenderAddress string, eventName string, missingIDs []uint64) ([]types.Log, error) {
+	// records are synced in order, so the missing ones were emitted after the one before them
+	fromBlock := big.NewInt(0)
+	if missingIDs[0] > clerkKeeper.FirstRecordID {
+		record, err := heimdallClient.ClerkRecord(missingIDs[0] - 1)
+		if err != nil {
+			return nil, err
+		}
+
+		receipt, err := cp.contractConnector.MainChainClient.TransactionReceipt(context.Background(), ethCommon.HexToHash(record.TxHash))
+		if err != nil {
+			return nil, err
+		}
+		fromBlock = receipt.BlockNumber
+	}
+
+	// the record id is an indexed topic of the StateSynced event
+	idTopics := make([]ethCommon.Hash, 0, len(missingIDs))
+	for _, id := range missingIDs {
+		idTopics = append(idTopics, ethCommon.BigToHash(new(big.Int).SetUint64(id)))
+	}
+	remaining := make(map[ethCommon.Hash]bool, len(idTopics))
+	for _, idTopic := range idTopics {
+		remaining[idTopic] = true
+	}
+
+	latest, err := cp.contractConnector.MainChainClient.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []types.Log
+	for from := fromBlock.Uint64(); from <= latest.Number.Uint64() && len(remaining) > 0; from += missingRecordsBlockRange {
+		to := from + missingRecordsBlockRange - 1
+		if to > latest.Number.Uint64() {
+			to = latest.Number.Uint64()
+		}
+
+		query := ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Addresses: []ethCommon.Address{ethCommon.HexToAddress(stateSenderAddress)},
+			Topics:    [][]ethCommon.Hash{{cp.stateSenderAbi.Events[eventName].ID}, idTopics},
+		}
+		rangeLogs, err := cp.contractConnector.MainChainClient.FilterLogs(context.Background(), query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, vLog := range rangeLogs {
+			if len(vLog.Topics) > 1 {
+				delete(remaining, vLog.Topics[1])
+			}
+		}
+		logs = append(logs, rangeLogs...)
+	}
+
+	return logs, nil
+}
+
+// isOldTx  checks if tx is already processed or not
+func (cp *ClerkProcessor) isOldTx(cliCtx client.Context, txHash string, logIndex uint64) (bool, error) {
+	status, err := util.GetHeimdallClient().ClerkOldTx(txHash, logIndex)
+	if err != nil {
+		cp.Logger.Error("Error fetching tx status", "txHash", txHash, "logIndex", logIndex, "error", err)
 		return false, err
 	}
 
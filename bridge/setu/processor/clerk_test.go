@@ -0,0 +1,231 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/accounts/abi"
+	ethCommon "github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/ethclient"
+	"github.com/maticnetwork/bor/rpc"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/contracts/statesender"
+	"github.com/maticnetwork/heimdall/helper"
+	clerkTypes "github.com/maticnetwork/heimdall/x/clerk/types"
+)
+
+// clerkQueryServer serves the stored records
+type clerkQueryServer struct {
+	clerkTypes.UnimplementedQueryServer
+
+	records map[uint64]*clerkTypes.EventRecord
+}
+
+func (s *clerkQueryServer) Record(ctx context.Context, req *clerkTypes.QueryRecordParams) (*clerkTypes.QueryRecordResponse, error) {
+	record, ok := s.records[req.RecordId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "record not found")
+	}
+
+	return &clerkTypes.QueryRecordResponse{EventRecord: record}, nil
+}
+
+// rootChainService serves the receipts and logs and records the log filters of a rootchain
+type rootChainService struct {
+	latest   uint64
+	receipts map[ethCommon.Hash]*types.Receipt
+	filters  []map[string]interface{}
+	logs     []types.Log
+}
+
+func (s *rootChainService) GetBlockByNumber(ctx context.Context, number string, _ bool) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).SetUint64(s.latest), Difficulty: big.NewInt(1)}, nil
+}
+
+func (s *rootChainService) GetTransactionReceipt(ctx context.Context, hash ethCommon.Hash) (*types.Receipt, error) {
+	return s.receipts[hash], nil
+}
+
+func (s *rootChainService) GetLogs(ctx context.Context, filter map[string]interface{}) ([]types.Log, error) {
+	s.filters = append(s.filters, filter)
+
+	from, err := hexutil.DecodeUint64(filter["fromBlock"].(string))
+	if err != nil {
+		return nil, err
+	}
+	to, err := hexutil.DecodeUint64(filter["toBlock"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	logs := []types.Log{}
+	for _, vLog := range s.logs {
+		if from <= vLog.BlockNumber && vLog.BlockNumber <= to {
+			logs = append(logs, vLog)
+		}
+	}
+	return logs, nil
+}
+
+func newTestClerkProcessor(t *testing.T, records map[uint64]*clerkTypes.EventRecord, rootChain *rootChainService) (*ClerkProcessor, *util.HeimdallClient) {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	clerkTypes.RegisterQueryServer(grpcServer, &clerkQueryServer{records: records})
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithInsecure(),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	rpcServer := rpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("eth", rootChain))
+	t.Cleanup(rpcServer.Stop)
+
+	stateSenderAbi, err := abi.JSON(strings.NewReader(statesender.StatesenderABI))
+	require.NoError(t, err)
+
+	cp := NewClerkProcessor(&stateSenderAbi)
+	cp.BaseProcessor = BaseProcessor{
+		contractConnector: helper.ContractCaller{MainChainClient: ethclient.NewClient(rpc.DialInProc(rpcServer))},
+	}
+
+	return cp, util.NewHeimdallClient(conn, codectypes.NewInterfaceRegistry(), 5*time.Second)
+}
+
+func TestGetMissingRecordLogs(t *testing.T) {
+	stateSender := "0x0000000000000000000000000000000000001001"
+	txHash := ethCommon.HexToHash("0x01")
+	records := map[uint64]*clerkTypes.EventRecord{
+		4: {Id: 4, TxHash: txHash.Hex()},
+	}
+	idTopic := func(id int64) ethCommon.Hash { return ethCommon.BigToHash(big.NewInt(id)) }
+	rootChain := &rootChainService{
+		latest: 120 + 3*missingRecordsBlockRange,
+		receipts: map[ethCommon.Hash]*types.Receipt{
+			txHash: {TxHash: txHash, BlockNumber: big.NewInt(120), Logs: []*types.Log{}},
+		},
+		logs: []types.Log{
+			{TxHash: ethCommon.HexToHash("0x02"), BlockNumber: 130, Topics: []ethCommon.Hash{{}, idTopic(5)}, Data: []byte{}},
+			{TxHash: ethCommon.HexToHash("0x03"), BlockNumber: 130 + missingRecordsBlockRange, Topics: []ethCommon.Hash{{}, idTopic(7)}, Data: []byte{}},
+		},
+	}
+	cp, heimdallClient := newTestClerkProcessor(t, records, rootChain)
+
+	// the filter starts at the block of the record before the gap, and stops once all records are found
+	logs, err := cp.getMissingRecordLogs(heimdallClient, stateSender, "StateSynced", []uint64{5, 7})
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	require.Equal(t, ethCommon.HexToHash("0x02"), logs[0].TxHash)
+	require.Equal(t, ethCommon.HexToHash("0x03"), logs[1].TxHash)
+
+	require.Len(t, rootChain.filters, 2)
+	require.Equal(t, hexutil.EncodeUint64(120), rootChain.filters[0]["fromBlock"])
+	require.Equal(t, hexutil.EncodeUint64(119+missingRecordsBlockRange), rootChain.filters[0]["toBlock"])
+	require.Equal(t, hexutil.EncodeUint64(120+missingRecordsBlockRange), rootChain.filters[1]["fromBlock"])
+	require.Equal(t, hexutil.EncodeUint64(119+2*missingRecordsBlockRange), rootChain.filters[1]["toBlock"])
+	topics := rootChain.filters[0]["topics"].([]interface{})
+	require.Equal(t, []interface{}{cp.stateSenderAbi.Events["StateSynced"].ID.Hex()}, topics[0])
+	require.Equal(t, []interface{}{idTopic(5).Hex(), idTopic(7).Hex()}, topics[1])
+
+	// no record to start from when the first record is missing, records not found are
+	// filtered up to the latest block
+	rootChain.filters = nil
+	_, err = cp.getMissingRecordLogs(heimdallClient, stateSender, "StateSynced", []uint64{1})
+	require.NoError(t, err)
+	require.Len(t, rootChain.filters, 4)
+	require.Equal(t, "0x0", rootChain.filters[0]["fromBlock"])
+	require.Equal(t, hexutil.EncodeUint64(rootChain.latest), rootChain.filters[3]["toBlock"])
+
+	// the record before the gap is required
+	rootChain.filters = nil
+	_, err = cp.getMissingRecordLogs(heimdallClient, stateSender, "StateSynced", []uint64{3})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Empty(t, rootChain.filters)
+}
+
+func TestIsRecordBuffered(t *testing.T) {
+	event := func(buffered string) sdk.StringEvent {
+		return sdk.StringEvent{
+			Type: clerkTypes.EventTypeRecord,
+			Attributes: []sdk.Attribute{
+				{Key: clerkTypes.AttributeKeyRecordID, Value: "7"},
+				{Key: clerkTypes.AttributeKeyRecordBuffered, Value: buffered},
+			},
+		}
+	}
+
+	require.True(t, isRecordBuffered(event("true")))
+	require.False(t, isRecordBuffered(event("false")))
+	require.False(t, isRecordBuffered(sdk.StringEvent{Type: clerkTypes.EventTypeRecord}))
+
+	// records stored in order don't resend anything
+	cp := NewClerkProcessor(nil)
+	eventBytes, err := json.Marshal(event("false"))
+	require.NoError(t, err)
+	require.NoError(t, cp.sendMissingRecordsToHeimdall(string(eventBytes), "0xabcd", 12))
+}
+
+func TestQueueMissingRecords(t *testing.T) {
+	viper.Set("log_level", "error")
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	queueConnector, err := queue.NewQueueConnector(queue.LocalBackend, "", db)
+	require.NoError(t, err)
+	t.Cleanup(queueConnector.Stop)
+
+	sent := make(chan ethCommon.Hash, 2)
+	require.NoError(t, queueConnector.RegisterTask("sendStateSyncedToHeimdall", func(eventName string, logBytes string) error {
+		require.Equal(t, "StateSynced", eventName)
+		var vLog types.Log
+		require.NoError(t, json.Unmarshal([]byte(logBytes), &vLog))
+		sent <- vLog.TxHash
+		return nil
+	}))
+	queueConnector.StartWorker()
+
+	cp := NewClerkProcessor(nil)
+	cp.BaseProcessor = BaseProcessor{Logger: log.NewNopLogger(), queueConnector: queueConnector}
+
+	// each record is sent by its own task
+	cp.queueMissingRecords("StateSynced", []types.Log{
+		{TxHash: ethCommon.HexToHash("0x02"), Topics: []ethCommon.Hash{}, Data: []byte{}},
+		{TxHash: ethCommon.HexToHash("0x03"), Topics: []ethCommon.Hash{}, Data: []byte{}},
+	})
+
+	var txHashes []ethCommon.Hash
+	for i := 0; i < 2; i++ {
+		select {
+		case txHash := <-sent:
+			txHashes = append(txHashes, txHash)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "missing record wasn't queued")
+		}
+	}
+	require.ElementsMatch(t, []ethCommon.Hash{ethCommon.HexToHash("0x02"), ethCommon.HexToHash("0x03")}, txHashes)
+}
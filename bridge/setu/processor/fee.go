@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
 	"github.com/maticnetwork/heimdall/helper"
@@ -39,7 +40,7 @@ func (fp *FeeProcessor) Start() error {
 // RegisterTasks - Registers clerk related tasks with machinery
 func (fp *FeeProcessor) RegisterTasks() {
 	fp.Logger.Info("Registering fee related tasks")
-	if err := fp.queueConnector.Server.RegisterTask("sendTopUpFeeToHeimdall", fp.sendTopUpFeeToHeimdall); err != nil {
+	if err := fp.queueConnector.RegisterTaskWithRetryPolicy("sendTopUpFeeToHeimdall", fp.sendTopUpFeeToHeimdall, queue.RootChainEventRetryPolicy); err != nil {
 		fp.Logger.Error("RegisterTasks | sendTopUpFeeToHeimdall", "error", err)
 	}
 }
@@ -95,27 +96,9 @@ func (fp *FeeProcessor) sendTopUpFeeToHeimdall(eventName string, logBytes string
 
 // isOldTx  checks if tx is already processed or not
 func (fp *FeeProcessor) isOldTx(cliCtx client.Context, txHash string, logIndex uint64) (bool, error) {
-	queryParam := map[string]interface{}{
-		"txhash":   txHash,
-		"logindex": logIndex,
-	}
-
-	endpoint := helper.GetHeimdallServerEndpoint(util.TopupTxStatusURL)
-	url, err := util.CreateURLWithQuery(endpoint, queryParam)
+	status, err := util.GetHeimdallClient().TopupOldTx(txHash, logIndex)
 	if err != nil {
-		fp.Logger.Error("Error in creating url", "endpoint", endpoint, "error", err)
-		return false, err
-	}
-
-	res, err := helper.FetchFromAPI(url)
-	if err != nil {
-		fp.Logger.Error("Error fetching tx status", "url", url, "error", err)
-		return false, err
-	}
-
-	var status bool
-	if err := json.Unmarshal(res, &status); err != nil {
-		fp.Logger.Error("Error unmarshalling tx status received from Heimdall Server", "error", err)
+		fp.Logger.Error("Error fetching tx status", "txHash", txHash, "logIndex", logIndex, "error", err)
 		return false, err
 	}
 
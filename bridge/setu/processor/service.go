@@ -1,6 +1,8 @@
 package processor
 
 import (
+	"sync"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/viper"
@@ -8,6 +10,7 @@ import (
 	httpClient "github.com/tendermint/tendermint/rpc/client/http"
 
 	"github.com/maticnetwork/heimdall/bridge/setu/broadcaster"
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
@@ -26,6 +29,9 @@ type ProcessorService struct {
 	queueConnector *queue.QueueConnector
 
 	processors []Processor
+
+	// start errors of the processors
+	startErrors sync.Map
 }
 
 // NewProcessorService returns new service object for processing queue msg
@@ -75,8 +81,8 @@ func NewProcessorService(
 	spanProcessor.BaseProcessor = *NewBaseProcessor(cliCtx, queueConnector, httpClient, txBroadcaster, paramsContext, "span", spanProcessor)
 
 	// initialize slashing processor
-	//slashingProcessor := NewSlashingProcessor(&contractCaller.StakingInfoABI)
-	//slashingProcessor.BaseProcessor = *NewBaseProcessor(cliCtx, queueConnector, httpClient, txBroadcaster, paramsContext, "slashing", slashingProcessor)
+	slashingProcessor := NewSlashingProcessor(&contractCaller.StakingInfoABI)
+	slashingProcessor.BaseProcessor = *NewBaseProcessor(cliCtx, queueConnector, httpClient, txBroadcaster, paramsContext, "slashing", slashingProcessor)
 
 	//
 	// Select processors
@@ -107,8 +113,8 @@ func NewProcessorService(
 				processorService.processors = append(processorService.processors, feeProcessor)
 			case "span":
 				processorService.processors = append(processorService.processors, spanProcessor)
-				//case "slashing":
-				//	processorService.processors = append(processorService.processors, nil)
+			case "slashing":
+				processorService.processors = append(processorService.processors, slashingProcessor)
 			}
 		}
 	}
@@ -133,6 +139,7 @@ func (processorService *ProcessorService) OnStart() error {
 		go func() {
 			if err := processor.Start(); err != nil {
 				processorService.Logger.Error("processor is failed", "Err", err)
+				processorService.startErrors.Store(processor.String(), err)
 			}
 		}()
 	}
@@ -151,3 +158,22 @@ func (processorService *ProcessorService) OnStop() {
 
 	processorService.Logger.Info("all processors stopped")
 }
+
+// Health reports the service liveness and whether each processor started
+func (processorService *ProcessorService) Health() metrics.ServiceHealth {
+	running := processorService.IsRunning()
+
+	health := metrics.ServiceHealth{
+		Running:    running,
+		Components: make(map[string]metrics.ComponentHealth, len(processorService.processors)),
+	}
+	for _, processor := range processorService.processors {
+		component := metrics.ComponentHealth{Alive: running}
+		if err, failed := processorService.startErrors.Load(processor.String()); failed {
+			component = metrics.ComponentHealth{Error: err.(error).Error()}
+		}
+		health.Components[processor.String()] = component
+	}
+
+	return health
+}
@@ -1,13 +1,14 @@
 package processor
 
 import (
-	"encoding/json"
+	"math/big"
 
 	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 
 	"github.com/maticnetwork/bor/core/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	borTypes "github.com/maticnetwork/heimdall/x/bor/types"
 )
 
@@ -25,9 +26,12 @@ func (sp *SpanProcessor) Start() error {
 // RegisterTasks
 func (sp *SpanProcessor) RegisterTasks() {
 	sp.Logger.Info("Registering span tasks")
-	if err := sp.queueConnector.Server.RegisterTask("sendSpanToHeimdall", sp.sendSpanToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask("sendSpanToHeimdall", sp.sendSpanToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendSpanToHeimdall", "error", err)
 	}
+	if err := sp.queueConnector.RegisterTask("sendReplaceSpanToHeimdall", sp.sendReplaceSpanToHeimdall); err != nil {
+		sp.Logger.Error("RegisterTasks | sendReplaceSpanToHeimdall", "error", err)
+	}
 }
 
 // HandleSendSpanTask - handle send span task
@@ -87,19 +91,128 @@ func (sp *SpanProcessor) sendSpanToHeimdall(headerBlockStr string) error {
 	return nil
 }
 
+// sendReplaceSpanToHeimdall - proposes a span replacing the rest of the current span
+// 1. check the sprints of the current span the producers in turn missed
+// 2. create and broadcast replace span transaction to heimdall if producers missed enough sprints
+func (sp *SpanProcessor) sendReplaceSpanToHeimdall(headerBlockStr string) error {
+	var header = types.Header{}
+	if err := header.UnmarshalJSON([]byte(headerBlockStr)); err != nil {
+		sp.Logger.Error("Error while unmarshalling the header block", "error", err)
+		return err
+	}
+
+	params, err := util.GetHeimdallClient().BorParams()
+	if err != nil {
+		sp.Logger.Error("Error while fetching bor params", "error", err)
+		return err
+	}
+	if params.ReplacementMissedSprints == 0 {
+		return nil
+	}
+
+	number := header.Number.Uint64()
+	currentSpan, err := util.GetHeimdallClient().SpanByBlock(number)
+	if err != nil {
+		sp.Logger.Error("Error while fetching current span", "blockNumber", number, "error", err)
+		return err
+	}
+
+	lastSpan, err := util.GetLastSpan(sp.cliCtx)
+	if err != nil {
+		return err
+	}
+
+	// current span is replaced already
+	if lastSpan.ID != currentSpan.ID && lastSpan.StartBlock <= currentSpan.EndBlock {
+		sp.Logger.Debug("Span already replaced", "spanId", currentSpan.ID, "lastSpanId", lastSpan.ID)
+		return nil
+	}
+
+	// replacement starts at the sprint after the next one, giving time to commit it
+	startBlock := number - number%params.Sprint + 2*params.Sprint
+	if startBlock > currentSpan.EndBlock {
+		return nil
+	}
+
+	missedSprints, err := sp.fetchMissedSprints(currentSpan, number, params.Sprint)
+	if err != nil {
+		return err
+	}
+
+	offlineIDs := borTypes.OfflineProducers(missedSprints, params.ReplacementMissedSprints)
+	if len(offlineIDs) == 0 {
+		return nil
+	}
+
+	configParams, err := util.GetChainmanagerParams(sp.cliCtx)
+	if err != nil {
+		sp.Logger.Error("Error while fetching chainmanager params", "error", err)
+		return err
+	}
+
+	// Get NextSpanSeed from HeimdallServer
+	var seed common.Hash
+	if seed, err = sp.fetchNextSpanSeed(); err != nil {
+		sp.Logger.Info("Error while fetching next span seed from HeimdallServer", "err", err)
+		return err
+	}
+
+	msg := borTypes.NewMsgReplaceSpan(
+		lastSpan.ID+1,
+		helper.GetAddressStr(),
+		startBlock,
+		startBlock+params.SpanDuration-1,
+		configParams.ChainParams.BorChainID,
+		seed.String(),
+		missedSprints,
+	)
+
+	// log replacement span
+	sp.Logger.Info("✅ Proposing replacement span", "spanId", msg.SpanId, "replacedSpanId", currentSpan.ID, "startBlock", msg.StartBlock, "endBlock", msg.EndBlock, "offlineProducerIds", offlineIDs)
+
+	// return broadcast to heimdall
+	if err := sp.txBroadcaster.BroadcastToHeimdall(&msg); err != nil {
+		sp.Logger.Error("Error while broadcasting replacement span to heimdall", "spanId", msg.SpanId, "startBlock", msg.StartBlock, "endBlock", msg.EndBlock, "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// fetchMissedSprints - fetches the first block of the sprints of the span up to the block,
+// returning the sprints whose producer in turn didn't sign it
+func (sp *SpanProcessor) fetchMissedSprints(span *hmTypes.Span, number uint64, sprint uint64) ([]borTypes.MissedSprint, error) {
+	missedSprints := make([]borTypes.MissedSprint, 0)
+	for block := span.StartBlock; block <= number; block += sprint {
+		header, err := sp.contractConnector.GetMaticChainBlock(new(big.Int).SetUint64(block))
+		if err != nil {
+			sp.Logger.Error("Error while fetching child block", "blockNumber", block, "error", err)
+			return nil, err
+		}
+
+		producerID, signed, err := borTypes.InTurnProducer(span, header)
+		if err != nil {
+			sp.Logger.Error("Error while checking producer in turn", "blockNumber", block, "error", err)
+			return nil, err
+		}
+
+		if !signed {
+			missedSprints = append(missedSprints, borTypes.MissedSprint{Block: block, ProducerId: producerID})
+		}
+	}
+
+	return missedSprints, nil
+}
+
 // fetchNextSpanSeed - fetches seed for next span
 func (sp *SpanProcessor) fetchNextSpanSeed() (nextSpanSeed common.Hash, err error) {
-	sp.Logger.Debug("Sending Rest call to Get Seed for next span")
-	response, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(util.NextSpanSeedURL))
+	sp.Logger.Debug("Sending query to Get Seed for next span")
+	nextSpanSeed, err = util.GetHeimdallClient().NextSpanSeed()
 	if err != nil {
-		sp.Logger.Error("Error Fetching nextspanseed from HeimdallServer ", "error", err)
+		sp.Logger.Error("Error Fetching nextspanseed from Heimdall", "error", err)
 		return nextSpanSeed, err
 	}
+
 	sp.Logger.Debug("Next span seed fetched")
-	var nextSpanSeedResponse util.NextSpanSeedResponse
-	if err := json.Unmarshal(response, &nextSpanSeedResponse); err != nil {
-		sp.Logger.Error("Error unmarshalling nextSpanSeed received from Heimdall Server", "error", err)
-		return nextSpanSeed, err
-	}
-	return common.HexToHash(nextSpanSeedResponse.NextSpanSeed), nil
+	return nextSpanSeed, nil
 }
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
 	"github.com/maticnetwork/heimdall/helper"
@@ -38,16 +39,16 @@ func (sp *StakingProcessor) Start() error {
 // RegisterTasks - Registers staking tasks with machinery
 func (sp *StakingProcessor) RegisterTasks() {
 	sp.Logger.Info("Registering staking related tasks")
-	if err := sp.queueConnector.Server.RegisterTask("sendValidatorJoinToHeimdall", sp.sendValidatorJoinToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTaskWithRetryPolicy("sendValidatorJoinToHeimdall", sp.sendValidatorJoinToHeimdall, queue.RootChainEventRetryPolicy); err != nil {
 		sp.Logger.Error("RegisterTasks | sendValidatorJoinToHeimdall", "error", err)
 	}
-	if err := sp.queueConnector.Server.RegisterTask("sendUnstakeInitToHeimdall", sp.sendUnstakeInitToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTaskWithRetryPolicy("sendUnstakeInitToHeimdall", sp.sendUnstakeInitToHeimdall, queue.RootChainEventRetryPolicy); err != nil {
 		sp.Logger.Error("RegisterTasks | sendUnstakeInitToHeimdall", "error", err)
 	}
-	if err := sp.queueConnector.Server.RegisterTask("sendStakeUpdateToHeimdall", sp.sendStakeUpdateToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTaskWithRetryPolicy("sendStakeUpdateToHeimdall", sp.sendStakeUpdateToHeimdall, queue.RootChainEventRetryPolicy); err != nil {
 		sp.Logger.Error("RegisterTasks | sendStakeUpdateToHeimdall", "error", err)
 	}
-	if err := sp.queueConnector.Server.RegisterTask("sendSignerChangeToHeimdall", sp.sendSignerChangeToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTaskWithRetryPolicy("sendSignerChangeToHeimdall", sp.sendSignerChangeToHeimdall, queue.RootChainEventRetryPolicy); err != nil {
 		sp.Logger.Error("RegisterTasks | sendSignerChangeToHeimdall", "error", err)
 	}
 }
@@ -307,27 +308,9 @@ func (sp *StakingProcessor) sendSignerChangeToHeimdall(eventName string, logByte
 
 // isOldTx  checks if tx is already processed or not
 func (sp *StakingProcessor) isOldTx(cliCtx client.Context, txHash string, logIndex uint64) (bool, error) {
-	queryParam := map[string]interface{}{
-		"txhash":   txHash,
-		"logindex": logIndex,
-	}
-
-	endpoint := helper.GetHeimdallServerEndpoint(util.StakingTxStatusURL)
-	url, err := util.CreateURLWithQuery(endpoint, queryParam)
+	status, err := util.GetHeimdallClient().StakingOldTx(txHash, logIndex)
 	if err != nil {
-		sp.Logger.Error("Error in creating url", "endpoint", endpoint, "error", err)
-		return false, err
-	}
-
-	res, err := helper.FetchFromAPI(url)
-	if err != nil {
-		sp.Logger.Error("Error fetching tx status", "url", url, "error", err)
-		return false, err
-	}
-
-	var status bool
-	if err := json.Unmarshal(res, &status); err != nil {
-		sp.Logger.Error("Error unmarshalling tx status received from Heimdall Server", "error", err)
+		sp.Logger.Error("Error fetching tx status", "txHash", txHash, "logIndex", logIndex, "error", err)
 		return false, err
 	}
 
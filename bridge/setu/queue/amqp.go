@@ -0,0 +1,87 @@
+package queue
+
+import (
+	"github.com/RichardKnop/machinery/v1"
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/streadway/amqp"
+)
+
+// amqpBackend queues tasks on an AMQP broker through machinery
+type amqpBackend struct {
+	dialer string
+	server *machinery.Server
+	worker *machinery.Worker
+}
+
+// NewAMQPBackend returns the machinery backend for the AMQP broker at dialer
+func NewAMQPBackend(dialer string) (Backend, error) {
+	// amqp dialer
+	conn, err := amqp.Dial(dialer)
+	if err != nil {
+		return nil, err
+	}
+	conn.Close()
+
+	var cnf = &config.Config{
+		Broker:        dialer,
+		DefaultQueue:  QueueName,
+		ResultBackend: dialer,
+		AMQP: &config.AMQPConfig{
+			Exchange:     "machinery_exchange",
+			ExchangeType: "direct",
+			BindingKey:   "machinery_task",
+		},
+	}
+
+	server, err := machinery.NewServer(cnf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &amqpBackend{dialer: dialer, server: server}, nil
+}
+
+// RegisterTask registers the function run for the tasks with given name
+func (b *amqpBackend) RegisterTask(name string, taskFunc interface{}) error {
+	return b.server.RegisterTask(name, taskFunc)
+}
+
+// SendTask publishes the task to the broker
+func (b *amqpBackend) SendTask(signature *tasks.Signature) error {
+	_, err := b.server.SendTask(signature)
+	return err
+}
+
+// StartWorker launches the machinery worker
+func (b *amqpBackend) StartWorker() error {
+	b.worker = b.server.NewWorker("invoke-processor", 10)
+	errors := make(chan error)
+	b.worker.LaunchAsync(errors)
+	return nil
+}
+
+// Purge purges the machinery task queue
+func (b *amqpBackend) Purge() error {
+	conn, err := amqp.Dial(b.dialer)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// initialize exchange
+	channel, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+
+	_, err = channel.QueuePurge(QueueName, false)
+	return err
+}
+
+// Stop quits the machinery worker
+func (b *amqpBackend) Stop() {
+	if b.worker != nil {
+		b.worker.Quit()
+	}
+}
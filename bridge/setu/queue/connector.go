@@ -1,64 +1,169 @@
 package queue
 
 import (
+	"errors"
 	"fmt"
 
-	"github.com/streadway/amqp"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/tendermint/tendermint/libs/log"
 
-	"github.com/RichardKnop/machinery/v1"
-	"github.com/RichardKnop/machinery/v1/config"
-
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 )
 
-type QueueConnector struct {
-	logger log.Logger
-	Server *machinery.Server
-}
-
 const (
 	// machinery task queue
 	QueueName = "machinery_tasks"
+
+	// AMQPBackend queues tasks on an AMQP broker
+	AMQPBackend = "amqp"
+	// LocalBackend queues tasks in process and persists them in the bridge db
+	LocalBackend = "local"
 )
 
-func NewQueueConnector(dialer string) *QueueConnector {
-	// amqp dialer
-	_, err := amqp.Dial(dialer)
-	if err != nil {
-		panic(err)
-	}
+var errNoDeadLetterStore = errors.New("dead-letter store requires the bridge db")
+
+// Backend represents the task queue between listeners and processors
+type Backend interface {
+	// RegisterTask registers the function run for the tasks with given name
+	RegisterTask(name string, taskFunc interface{}) error
+	// SendTask queues a task, to be run at its ETA if set
+	SendTask(signature *tasks.Signature) error
+	// StartWorker starts processing the queued tasks
+	StartWorker() error
+	// Purge removes all queued tasks
+	Purge() error
+	// Stop stops processing tasks
+	Stop()
+}
+
+type QueueConnector struct {
+	logger      log.Logger
+	backend     Backend
+	deadLetters *DeadLetterStore
+}
+
+// NewQueueConnector returns the queue connector for the given backend type.
+// The dialer is the AMQP url, and the db persists tasks of the local backend.
+func NewQueueConnector(backendType string, dialer string, db *leveldb.DB) (*QueueConnector, error) {
+	logger := util.Logger().With("module", "QueueConnector")
 
-	var cnf = &config.Config{
-		Broker:        dialer,
-		DefaultQueue:  QueueName,
-		ResultBackend: dialer,
-		AMQP: &config.AMQPConfig{
-			Exchange:     "machinery_exchange",
-			ExchangeType: "direct",
-			BindingKey:   "machinery_task",
-		},
+	var backend Backend
+	var err error
+
+	switch backendType {
+	case AMQPBackend, "":
+		backend, err = NewAMQPBackend(dialer)
+	case LocalBackend:
+		backend, err = NewLocalBackend(db, logger)
+	default:
+		err = fmt.Errorf("unknown queue backend %s", backendType)
 	}
 
-	server, err := machinery.NewServer(cnf)
 	if err != nil {
-		fmt.Printf("error while starting the new server %v error ", err)
+		return nil, err
 	}
 
 	// queue connector
 	connector := QueueConnector{
-		logger: util.Logger().With("module", "QueueConnector"),
-		Server: server,
+		logger:  logger,
+		backend: backend,
+	}
+
+	if db != nil {
+		connector.deadLetters = NewDeadLetterStore(db)
 	}
 
 	// connector
-	return &connector
+	return &connector, nil
+}
+
+// RegisterTask registers the function run for the tasks with given name,
+// failed tasks are retried with the default retry policy
+func (qc *QueueConnector) RegisterTask(name string, taskFunc interface{}) error {
+	return qc.RegisterTaskWithRetryPolicy(name, taskFunc, DefaultRetryPolicy)
+}
+
+// RegisterTaskWithRetryPolicy registers the function run for the tasks with
+// given name, failed tasks are retried with the given retry policy
+func (qc *QueueConnector) RegisterTaskWithRetryPolicy(name string, taskFunc interface{}, policy RetryPolicy) error {
+	wrapped, err := qc.withRetryPolicy(name, taskFunc, policy)
+	if err != nil {
+		return err
+	}
+
+	return qc.backend.RegisterTask(name, wrapped)
+}
+
+// SendTask sends task to the queue
+func (qc *QueueConnector) SendTask(signature *tasks.Signature) error {
+	// retries are handled by the retry policy of the task
+	signature.RetryCount = 0
+	if err := qc.backend.SendTask(signature); err != nil {
+		return err
+	}
+
+	metrics.TasksEnqueued.WithLabelValues(signature.Name).Inc()
+	return nil
+}
+
+// DeadLetters returns the tasks which failed all their attempts
+func (qc *QueueConnector) DeadLetters() ([]*DeadLetter, error) {
+	if qc.deadLetters == nil {
+		return nil, errNoDeadLetterStore
+	}
+
+	return qc.deadLetters.List()
+}
+
+// RetryDeadLetter sends the dead-letter task with given id to the queue again
+func (qc *QueueConnector) RetryDeadLetter(taskID string) error {
+	if qc.deadLetters == nil {
+		return errNoDeadLetterStore
+	}
+
+	deadLetter, err := qc.deadLetters.Get(taskID)
+	if err != nil {
+		return err
+	}
+
+	// start over as a new task
+	signature := deadLetter.Signature
+	signature.UUID = ""
+	signature.ETA = nil
+	delete(signature.Headers, attemptsHeader)
+
+	if err := qc.SendTask(signature); err != nil {
+		return err
+	}
+
+	return qc.deadLetters.Delete(taskID)
+}
+
+// DropDeadLetter deletes the dead-letter task with given id
+func (qc *QueueConnector) DropDeadLetter(taskID string) error {
+	if qc.deadLetters == nil {
+		return errNoDeadLetterStore
+	}
+
+	return qc.deadLetters.Delete(taskID)
 }
 
 // StartWorker - starts worker to process registered tasks
 func (qc *QueueConnector) StartWorker() {
-	worker := qc.Server.NewWorker("invoke-processor", 10)
-	qc.logger.Info("Starting machinery worker")
-	errors := make(chan error)
-	worker.LaunchAsync(errors)
+	qc.logger.Info("Starting queue worker")
+	if err := qc.backend.StartWorker(); err != nil {
+		qc.logger.Error("Error while starting queue worker", "error", err)
+	}
+}
+
+// Purge removes all queued tasks
+func (qc *QueueConnector) Purge() error {
+	return qc.backend.Purge()
+}
+
+// Stop stops the worker
+func (qc *QueueConnector) Stop() {
+	qc.backend.Stop()
 }
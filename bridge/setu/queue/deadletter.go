@@ -0,0 +1,116 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const deadLetterPrefix = "dead-letter-"
+
+// ErrDeadLetterNotFound is returned for unknown dead-letter tasks
+var ErrDeadLetterNotFound = errors.New("dead-letter task not found")
+
+// DeadLetter is a task which failed all its attempts
+type DeadLetter struct {
+	Signature *tasks.Signature `json:"signature"`
+	Attempts  int              `json:"attempts"`
+	Error     string           `json:"error"`
+	FailedAt  time.Time        `json:"failed_at"`
+}
+
+// DeadLetterStore keeps the dead-letter tasks in the bridge db
+type DeadLetterStore struct {
+	db *leveldb.DB
+}
+
+// NewDeadLetterStore returns the dead-letter store on the bridge db
+func NewDeadLetterStore(db *leveldb.DB) *DeadLetterStore {
+	return &DeadLetterStore{db: db}
+}
+
+// Add stores the failed task
+func (s *DeadLetterStore) Add(signature *tasks.Signature, attempts int, taskErr error) error {
+	value, err := json.Marshal(DeadLetter{
+		Signature: signature,
+		Attempts:  attempts,
+		Error:     taskErr.Error(),
+		FailedAt:  time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.db.Put(deadLetterKey(signature.UUID), value, nil)
+}
+
+// Get returns the dead-letter task with given id
+func (s *DeadLetterStore) Get(taskID string) (*DeadLetter, error) {
+	value, err := s.db.Get(deadLetterKey(taskID), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, ErrDeadLetterNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return decodeDeadLetter(value)
+}
+
+// List returns all dead-letter tasks, oldest first
+func (s *DeadLetterStore) List() ([]*DeadLetter, error) {
+	iter := s.db.NewIterator(leveldbUtil.BytesPrefix([]byte(deadLetterPrefix)), nil)
+	defer iter.Release()
+
+	var deadLetters []*DeadLetter
+	for iter.Next() {
+		deadLetter, err := decodeDeadLetter(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(deadLetters, func(i, j int) bool {
+		return deadLetters[i].FailedAt.Before(deadLetters[j].FailedAt)
+	})
+
+	return deadLetters, nil
+}
+
+// Delete drops the dead-letter task with given id
+func (s *DeadLetterStore) Delete(taskID string) error {
+	if ok, err := s.db.Has(deadLetterKey(taskID), nil); err != nil {
+		return err
+	} else if !ok {
+		return ErrDeadLetterNotFound
+	}
+
+	return s.db.Delete(deadLetterKey(taskID), nil)
+}
+
+func decodeDeadLetter(value []byte) (*DeadLetter, error) {
+	deadLetter := new(DeadLetter)
+
+	// numbers are decoded the same way machinery brokers do
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+	if err := decoder.Decode(deadLetter); err != nil {
+		return nil, err
+	}
+
+	return deadLetter, nil
+}
+
+func deadLetterKey(taskID string) []byte {
+	return []byte(deadLetterPrefix + taskID)
+}
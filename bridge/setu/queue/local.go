@@ -0,0 +1,278 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/retry"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/pborman/uuid"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	localTaskPrefix = "queue-task-"
+
+	// number of tasks processed concurrently
+	localWorkerConcurrency = 10
+
+	// delay before a task is retried if no function is registered for it yet
+	unregisteredTaskRetryDelay = 5 * time.Second
+)
+
+// localBackend queues tasks in process. Queued tasks are persisted in the
+// bridge db until they are processed, so they survive bridge restarts.
+type localBackend struct {
+	logger log.Logger
+	db     *leveldb.DB
+
+	registeredTasks   map[string]interface{}
+	registeredTasksMu sync.RWMutex
+
+	queue    chan *tasks.Signature
+	quit     chan struct{}
+	quitOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewLocalBackend returns the in process backend persisting its tasks in db,
+// the tasks left over from a previous run are queued again
+func NewLocalBackend(db *leveldb.DB, logger log.Logger) (Backend, error) {
+	if db == nil {
+		return nil, fmt.Errorf("local queue backend requires the bridge db")
+	}
+
+	b := &localBackend{
+		logger:          logger,
+		db:              db,
+		registeredTasks: make(map[string]interface{}),
+		queue:           make(chan *tasks.Signature),
+		quit:            make(chan struct{}),
+	}
+
+	pending, err := b.pendingTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, signature := range pending {
+		b.schedule(signature)
+	}
+
+	if len(pending) > 0 {
+		logger.Info("Queued pending tasks from bridge db", "count", len(pending))
+	}
+
+	return b, nil
+}
+
+// RegisterTask registers the function run for the tasks with given name
+func (b *localBackend) RegisterTask(name string, taskFunc interface{}) error {
+	if err := tasks.ValidateTask(taskFunc); err != nil {
+		return err
+	}
+
+	b.registeredTasksMu.Lock()
+	defer b.registeredTasksMu.Unlock()
+
+	b.registeredTasks[name] = taskFunc
+	return nil
+}
+
+// SendTask persists the task and queues it
+func (b *localBackend) SendTask(signature *tasks.Signature) error {
+	// Auto generate a UUID if not set already
+	if signature.UUID == "" {
+		signature.UUID = fmt.Sprintf("task_%v", uuid.New())
+	}
+
+	if err := b.saveTask(signature); err != nil {
+		return err
+	}
+
+	b.schedule(signature)
+	return nil
+}
+
+// StartWorker starts the workers processing queued tasks
+func (b *localBackend) StartWorker() error {
+	for i := 0; i < localWorkerConcurrency; i++ {
+		b.wg.Add(1)
+		go func() {
+			defer b.wg.Done()
+
+			for {
+				select {
+				case signature := <-b.queue:
+					b.process(signature)
+				case <-b.quit:
+					return
+				}
+			}
+		}()
+	}
+
+	return nil
+}
+
+// Purge deletes all queued tasks
+func (b *localBackend) Purge() error {
+	iter := b.db.NewIterator(leveldbUtil.BytesPrefix([]byte(localTaskPrefix)), nil)
+	defer iter.Release()
+
+	batch := new(leveldb.Batch)
+	for iter.Next() {
+		batch.Delete(append([]byte{}, iter.Key()...))
+	}
+
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	return b.db.Write(batch, nil)
+}
+
+// Stop stops the workers, queued tasks stay persisted
+func (b *localBackend) Stop() {
+	b.quitOnce.Do(func() {
+		close(b.quit)
+	})
+	b.wg.Wait()
+}
+
+// schedule hands the task over to the workers once its ETA is reached
+func (b *localBackend) schedule(signature *tasks.Signature) {
+	go func() {
+		if signature.ETA != nil {
+			if delay := time.Until(*signature.ETA); delay > 0 {
+				timer := time.NewTimer(delay)
+				select {
+				case <-timer.C:
+				case <-b.quit:
+					timer.Stop()
+					return
+				}
+			}
+		}
+
+		select {
+		case b.queue <- signature:
+		case <-b.quit:
+		}
+	}()
+}
+
+// process runs the task and retries it the same way machinery workers do
+func (b *localBackend) process(signature *tasks.Signature) {
+	// task was purged
+	if ok, err := b.db.Has(localTaskKey(signature.UUID), nil); err != nil || !ok {
+		return
+	}
+
+	b.registeredTasksMu.RLock()
+	taskFunc, ok := b.registeredTasks[signature.Name]
+	b.registeredTasksMu.RUnlock()
+
+	if !ok {
+		b.logger.Debug("Task not registered yet, retrying later", "taskName", signature.Name)
+		b.retryTaskIn(signature, unregisteredTaskRetryDelay)
+		return
+	}
+
+	task, err := tasks.NewWithSignature(taskFunc, signature)
+	if err != nil {
+		b.taskFailed(signature, err)
+		return
+	}
+
+	if _, err = task.Call(); err != nil {
+		// If a tasks.ErrRetryTaskLater was returned from the task,
+		// retry the task after specified duration
+		if retriableErr, ok := interface{}(err).(tasks.ErrRetryTaskLater); ok {
+			b.retryTaskIn(signature, retriableErr.RetryIn())
+			return
+		}
+
+		// Otherwise, execute default retry logic based on signature.RetryCount
+		// and signature.RetryTimeout values
+		if signature.RetryCount > 0 {
+			signature.RetryCount--
+			signature.RetryTimeout = retry.FibonacciNext(signature.RetryTimeout)
+			b.retryTaskIn(signature, time.Duration(signature.RetryTimeout)*time.Second)
+			return
+		}
+
+		b.taskFailed(signature, err)
+		return
+	}
+
+	b.logger.Debug("Processed task", "taskName", signature.Name, "taskID", signature.UUID)
+	b.deleteTask(signature)
+}
+
+// retryTaskIn queues the task again with an ETA of now + retryIn
+func (b *localBackend) retryTaskIn(signature *tasks.Signature, retryIn time.Duration) {
+	eta := time.Now().UTC().Add(retryIn)
+	signature.ETA = &eta
+
+	b.logger.Debug("Retrying task", "taskName", signature.Name, "taskID", signature.UUID, "retryIn", retryIn)
+
+	if err := b.saveTask(signature); err != nil {
+		b.logger.Error("Error while persisting task", "taskName", signature.Name, "error", err)
+	}
+
+	b.schedule(signature)
+}
+
+// taskFailed drops the task
+func (b *localBackend) taskFailed(signature *tasks.Signature, err error) {
+	b.logger.Error("Task failed", "taskName", signature.Name, "taskID", signature.UUID, "error", err)
+	b.deleteTask(signature)
+}
+
+func (b *localBackend) saveTask(signature *tasks.Signature) error {
+	value, err := json.Marshal(signature)
+	if err != nil {
+		return err
+	}
+
+	return b.db.Put(localTaskKey(signature.UUID), value, nil)
+}
+
+func (b *localBackend) deleteTask(signature *tasks.Signature) {
+	if err := b.db.Delete(localTaskKey(signature.UUID), nil); err != nil {
+		b.logger.Error("Error while deleting task", "taskName", signature.Name, "error", err)
+	}
+}
+
+// pendingTasks returns the tasks persisted in the db
+func (b *localBackend) pendingTasks() ([]*tasks.Signature, error) {
+	iter := b.db.NewIterator(leveldbUtil.BytesPrefix([]byte(localTaskPrefix)), nil)
+	defer iter.Release()
+
+	var pending []*tasks.Signature
+	for iter.Next() {
+		signature := new(tasks.Signature)
+
+		// numbers are decoded the same way machinery brokers do
+		decoder := json.NewDecoder(bytes.NewReader(iter.Value()))
+		decoder.UseNumber()
+		if err := decoder.Decode(signature); err != nil {
+			b.logger.Error("Error while decoding persisted task", "key", string(iter.Key()), "error", err)
+			continue
+		}
+
+		pending = append(pending, signature)
+	}
+
+	return pending, iter.Error()
+}
+
+func localTaskKey(taskID string) []byte {
+	return []byte(localTaskPrefix + taskID)
+}
@@ -0,0 +1,165 @@
+package queue
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func newTestDB(t *testing.T) *leveldb.DB {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func newTestBackend(t *testing.T, db *leveldb.DB) Backend {
+	backend, err := NewLocalBackend(db, log.NewNopLogger())
+	require.NoError(t, err)
+	t.Cleanup(backend.Stop)
+	return backend
+}
+
+func newSignature(name string, eventBytes string, blockHeight int64) *tasks.Signature {
+	return &tasks.Signature{
+		Name: name,
+		Args: []tasks.Arg{
+			{Type: "string", Value: eventBytes},
+			{Type: "int64", Value: blockHeight},
+		},
+	}
+}
+
+type taskCall struct {
+	eventBytes  string
+	blockHeight int64
+}
+
+func pendingCount(t *testing.T, db *leveldb.DB) int {
+	pending, err := (&localBackend{db: db, logger: log.NewNopLogger()}).pendingTasks()
+	require.NoError(t, err)
+	return len(pending)
+}
+
+func TestLocalBackendProcessesTasks(t *testing.T) {
+	db := newTestDB(t)
+	backend := newTestBackend(t, db)
+
+	calls := make(chan taskCall, 1)
+	require.NoError(t, backend.RegisterTask("sendTask", func(eventBytes string, blockHeight int64) error {
+		calls <- taskCall{eventBytes, blockHeight}
+		return nil
+	}))
+	require.NoError(t, backend.StartWorker())
+
+	signature := newSignature("sendTask", "event", 10)
+	eta := time.Now().Add(100 * time.Millisecond)
+	signature.ETA = &eta
+	require.NoError(t, backend.SendTask(signature))
+	require.NotEmpty(t, signature.UUID)
+
+	select {
+	case call := <-calls:
+		require.False(t, time.Now().Before(eta), "task ran before its eta")
+		require.Equal(t, taskCall{"event", 10}, call)
+	case <-time.After(5 * time.Second):
+		t.Fatal("task was not processed")
+	}
+
+	require.Eventually(t, func() bool { return pendingCount(t, db) == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestLocalBackendRetriesTasks(t *testing.T) {
+	backend := newTestBackend(t, newTestDB(t))
+
+	attempts := make(chan struct{}, 3)
+	require.NoError(t, backend.RegisterTask("retryLater", func(eventBytes string, blockHeight int64) error {
+		attempts <- struct{}{}
+		if len(attempts) < 2 {
+			return tasks.NewErrRetryTaskLater("not yet", 10*time.Millisecond)
+		}
+		return nil
+	}))
+
+	failures := make(chan struct{}, 2)
+	require.NoError(t, backend.RegisterTask("failing", func(eventBytes string, blockHeight int64) error {
+		failures <- struct{}{}
+		return errors.New("failed")
+	}))
+	require.NoError(t, backend.StartWorker())
+
+	require.NoError(t, backend.SendTask(newSignature("retryLater", "event", 1)))
+	require.Eventually(t, func() bool { return len(attempts) == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	// failing task without retries is run once and dropped
+	require.NoError(t, backend.SendTask(newSignature("failing", "event", 1)))
+	time.Sleep(200 * time.Millisecond)
+	require.Len(t, failures, 1)
+}
+
+func TestLocalBackendPersistsTasks(t *testing.T) {
+	db := newTestDB(t)
+
+	// tasks queued before the bridge stopped
+	backend, err := NewLocalBackend(db, log.NewNopLogger())
+	require.NoError(t, err)
+	require.NoError(t, backend.SendTask(newSignature("sendTask", "first", 1)))
+	require.NoError(t, backend.SendTask(newSignature("sendTask", "second", 2)))
+	backend.Stop()
+	require.Equal(t, 2, pendingCount(t, db))
+
+	// are processed after a restart
+	backend = newTestBackend(t, db)
+	calls := make(chan taskCall, 2)
+	require.NoError(t, backend.RegisterTask("sendTask", func(eventBytes string, blockHeight int64) error {
+		calls <- taskCall{eventBytes, blockHeight}
+		return nil
+	}))
+	require.NoError(t, backend.StartWorker())
+
+	received := map[string]int64{}
+	for i := 0; i < 2; i++ {
+		select {
+		case call := <-calls:
+			received[call.eventBytes] = call.blockHeight
+		case <-time.After(5 * time.Second):
+			t.Fatal("persisted task was not processed")
+		}
+	}
+	require.Equal(t, map[string]int64{"first": 1, "second": 2}, received)
+}
+
+func TestLocalBackendPurge(t *testing.T) {
+	db := newTestDB(t)
+	backend := newTestBackend(t, db)
+
+	called := make(chan struct{}, 1)
+	require.NoError(t, backend.RegisterTask("sendTask", func(eventBytes string, blockHeight int64) error {
+		called <- struct{}{}
+		return nil
+	}))
+
+	require.NoError(t, backend.SendTask(newSignature("sendTask", "event", 1)))
+	require.Equal(t, 1, pendingCount(t, db))
+
+	require.NoError(t, backend.Purge())
+	require.Equal(t, 0, pendingCount(t, db))
+
+	require.NoError(t, backend.StartWorker())
+	select {
+	case <-called:
+		t.Fatal("purged task was processed")
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestNewLocalBackendRequiresDB(t *testing.T) {
+	_, err := NewLocalBackend(nil, log.NewNopLogger())
+	require.Error(t, err)
+}
@@ -0,0 +1,186 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/metrics"
+)
+
+// attemptsHeader is the task header counting the failed attempts of a task
+const attemptsHeader = "attempts"
+
+// RetryPolicy defines how often a failed task is retried before it's moved
+// to the dead-letter store
+type RetryPolicy struct {
+	MaxAttempts    int           // attempts including the first run
+	InitialBackoff time.Duration // delay before the first retry
+	MaxBackoff     time.Duration // maximum delay between retries
+}
+
+// DefaultRetryPolicy is used for tasks registered without a retry policy
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 10 * time.Second,
+	MaxBackoff:     5 * time.Minute,
+}
+
+// Backoff returns the delay before the retry following the given failed
+// attempt, doubling with every attempt
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+
+	return backoff
+}
+
+// RootChainEventRetryPolicy is used for tasks of root chain events, which are
+// not emitted again and are lost if their task fails
+var RootChainEventRetryPolicy = RetryPolicy{
+	MaxAttempts:    10,
+	InitialBackoff: 30 * time.Second,
+	MaxBackoff:     30 * time.Minute,
+}
+
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// withRetryPolicy wraps the task function so failed tasks are retried with
+// backoff and dead-lettered once the policy is exhausted. The wrapper takes
+// a context to get hold of the signature of the task being run.
+func (qc *QueueConnector) withRetryPolicy(name string, taskFunc interface{}, policy RetryPolicy) (interface{}, error) {
+	if err := tasks.ValidateTask(taskFunc); err != nil {
+		return nil, err
+	}
+
+	fn := reflect.ValueOf(taskFunc)
+	fnType := fn.Type()
+	usesContext := fnType.NumIn() > 0 && tasks.IsContextType(fnType.In(0))
+
+	in := []reflect.Type{contextType}
+	for i := 0; i < fnType.NumIn(); i++ {
+		if i == 0 && usesContext {
+			continue
+		}
+		in = append(in, fnType.In(i))
+	}
+
+	out := make([]reflect.Type, fnType.NumOut())
+	for i := range out {
+		out[i] = fnType.Out(i)
+	}
+
+	wrapped := reflect.MakeFunc(reflect.FuncOf(in, out, fnType.IsVariadic()), func(args []reflect.Value) []reflect.Value {
+		ctx, _ := args[0].Interface().(context.Context)
+		if !usesContext {
+			args = args[1:]
+		}
+
+		results, err := callTask(fn, args)
+		if err == nil {
+			metrics.TasksSucceeded.WithLabelValues(name).Inc()
+			return results
+		}
+
+		// tasks asking to be retried later are left as they are
+		if _, ok := err.(tasks.ErrRetryTaskLater); ok {
+			return results
+		}
+
+		metrics.TasksFailed.WithLabelValues(name).Inc()
+
+		if signature := tasks.SignatureFromContext(ctx); signature != nil {
+			err = qc.handleTaskFailure(signature, policy, err)
+		}
+
+		errValue := reflect.New(errorType).Elem()
+		errValue.Set(reflect.ValueOf(err))
+		results[len(results)-1] = errValue
+		return results
+	})
+
+	return wrapped.Interface(), nil
+}
+
+// handleTaskFailure returns an error retrying the task after the policy backoff,
+// or dead-letters the task once it ran out of attempts
+func (qc *QueueConnector) handleTaskFailure(signature *tasks.Signature, policy RetryPolicy, err error) error {
+	attempt := TaskAttempts(signature) + 1
+	setTaskAttempts(signature, attempt)
+
+	if attempt < policy.MaxAttempts {
+		retryIn := policy.Backoff(attempt)
+		qc.logger.Info("Task failed, retrying", "taskName", signature.Name, "attempt", attempt, "retryIn", retryIn, "error", err)
+		return tasks.NewErrRetryTaskLater(err.Error(), retryIn)
+	}
+
+	qc.logger.Error("Task failed, moving to dead-letter store", "taskName", signature.Name, "attempts", attempt, "error", err)
+	metrics.TasksDeadLettered.WithLabelValues(signature.Name).Inc()
+	if qc.deadLetters != nil {
+		if dlErr := qc.deadLetters.Add(signature, attempt, err); dlErr != nil {
+			qc.logger.Error("Error while storing dead-letter task", "taskName", signature.Name, "error", dlErr)
+		}
+	}
+
+	return err
+}
+
+// callTask calls the task function, turning panics into errors
+func callTask(fn reflect.Value, args []reflect.Value) (results []reflect.Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("task panicked: %v", r)
+
+			results = make([]reflect.Value, fn.Type().NumOut())
+			for i := range results {
+				results[i] = reflect.Zero(fn.Type().Out(i))
+			}
+		}
+	}()
+
+	results = fn.Call(args)
+	if errValue := results[len(results)-1]; !errValue.IsNil() {
+		err, _ = errValue.Interface().(error)
+		if err == nil {
+			err = errors.New("task failed")
+		}
+	}
+
+	return results, err
+}
+
+// TaskAttempts returns the number of failed attempts of the task
+func TaskAttempts(signature *tasks.Signature) int {
+	if signature.Headers == nil {
+		return 0
+	}
+
+	// headers go through amqp tables and json, the attempts are kept as string
+	value, ok := signature.Headers[attemptsHeader].(string)
+	if !ok {
+		return 0
+	}
+
+	attempts, _ := strconv.Atoi(value)
+	return attempts
+}
+
+func setTaskAttempts(signature *tasks.Signature, attempts int) {
+	if signature.Headers == nil {
+		signature.Headers = tasks.Headers{}
+	}
+
+	signature.Headers[attemptsHeader] = strconv.Itoa(attempts)
+}
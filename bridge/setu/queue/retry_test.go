@@ -0,0 +1,142 @@
+package queue
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func newTestConnector(t *testing.T) *QueueConnector {
+	db := newTestDB(t)
+	return &QueueConnector{
+		logger:      log.NewNopLogger(),
+		backend:     newTestBackend(t, db),
+		deadLetters: NewDeadLetterStore(db),
+	}
+}
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 10 * time.Millisecond,
+	MaxBackoff:     20 * time.Millisecond,
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	require.Equal(t, time.Second, policy.Backoff(1))
+	require.Equal(t, 2*time.Second, policy.Backoff(2))
+	require.Equal(t, 4*time.Second, policy.Backoff(3))
+	require.Equal(t, 5*time.Second, policy.Backoff(4))
+	require.Equal(t, 5*time.Second, policy.Backoff(100))
+}
+
+func TestRetryPolicyRetriesTask(t *testing.T) {
+	qc := newTestConnector(t)
+
+	attempts := make(chan struct{}, 3)
+	require.NoError(t, qc.RegisterTaskWithRetryPolicy("flaky", func(eventBytes string, blockHeight int64) error {
+		attempts <- struct{}{}
+		if len(attempts) < 2 {
+			return errors.New("failed")
+		}
+		return nil
+	}, testRetryPolicy))
+	qc.StartWorker()
+
+	require.NoError(t, qc.SendTask(newSignature("flaky", "event", 1)))
+	require.Eventually(t, func() bool { return len(attempts) == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	time.Sleep(100 * time.Millisecond)
+	require.Len(t, attempts, 2)
+
+	deadLetters, err := qc.DeadLetters()
+	require.NoError(t, err)
+	require.Empty(t, deadLetters)
+}
+
+func TestRetryPolicyDeadLettersTask(t *testing.T) {
+	qc := newTestConnector(t)
+
+	attempts := make(chan struct{}, 10)
+	require.NoError(t, qc.RegisterTaskWithRetryPolicy("failing", func(eventBytes string, blockHeight int64) error {
+		attempts <- struct{}{}
+		panic("boom")
+	}, testRetryPolicy))
+	qc.StartWorker()
+
+	signature := newSignature("failing", "event", 7)
+	require.NoError(t, qc.SendTask(signature))
+
+	var deadLetters []*DeadLetter
+	require.Eventually(t, func() bool {
+		var err error
+		deadLetters, err = qc.DeadLetters()
+		require.NoError(t, err)
+		return len(deadLetters) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Len(t, attempts, testRetryPolicy.MaxAttempts)
+
+	deadLetter := deadLetters[0]
+	require.Equal(t, signature.UUID, deadLetter.Signature.UUID)
+	require.Equal(t, "failing", deadLetter.Signature.Name)
+	require.Equal(t, testRetryPolicy.MaxAttempts, deadLetter.Attempts)
+	require.Contains(t, deadLetter.Error, "boom")
+}
+
+func TestRetryDeadLetter(t *testing.T) {
+	qc := newTestConnector(t)
+
+	signature := newSignature("sendTask", "event", 3)
+	signature.UUID = "task_1"
+	setTaskAttempts(signature, testRetryPolicy.MaxAttempts)
+	require.NoError(t, qc.deadLetters.Add(signature, testRetryPolicy.MaxAttempts, errors.New("failed")))
+
+	calls := make(chan taskCall, 1)
+	require.NoError(t, qc.RegisterTask("sendTask", func(eventBytes string, blockHeight int64) error {
+		calls <- taskCall{eventBytes, blockHeight}
+		return nil
+	}))
+	qc.StartWorker()
+
+	require.NoError(t, qc.RetryDeadLetter("task_1"))
+
+	select {
+	case call := <-calls:
+		require.Equal(t, taskCall{"event", 3}, call)
+	case <-time.After(5 * time.Second):
+		t.Fatal("retried task was not processed")
+	}
+
+	_, err := qc.deadLetters.Get("task_1")
+	require.Equal(t, ErrDeadLetterNotFound, err)
+	require.Equal(t, ErrDeadLetterNotFound, qc.RetryDeadLetter("task_1"))
+}
+
+func TestDropDeadLetter(t *testing.T) {
+	qc := newTestConnector(t)
+
+	signature := newSignature("sendTask", "event", 3)
+	signature.UUID = "task_1"
+	require.NoError(t, qc.deadLetters.Add(signature, 1, errors.New("failed")))
+
+	require.NoError(t, qc.DropDeadLetter("task_1"))
+	require.Equal(t, ErrDeadLetterNotFound, qc.DropDeadLetter("task_1"))
+
+	deadLetters, err := qc.DeadLetters()
+	require.NoError(t, err)
+	require.Empty(t, deadLetters)
+}
+
+func TestTaskAttempts(t *testing.T) {
+	signature := &tasks.Signature{}
+	require.Equal(t, 0, TaskAttempts(signature))
+
+	setTaskAttempts(signature, 2)
+	require.Equal(t, 2, TaskAttempts(signature))
+}
@@ -4,62 +4,28 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"net/url"
 	"os"
 	"strconv"
 	"sync"
 	"time"
 
-	borTypes "github.com/maticnetwork/heimdall/x/bor/types"
-
-	chainmanagerTypes "github.com/maticnetwork/heimdall/x/chainmanager/types"
-
-	types2 "github.com/maticnetwork/heimdall/x/staking/types"
-
-	"github.com/gogo/protobuf/jsonpb"
-
-	checkpointTypes "github.com/maticnetwork/heimdall/x/checkpoint/types"
-
 	mLog "github.com/RichardKnop/machinery/v1/log"
 	"github.com/cosmos/cosmos-sdk/client"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/log"
 	httpClient "github.com/tendermint/tendermint/rpc/client/http"
 	tmTypes "github.com/tendermint/tendermint/types"
 
-	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/maticnetwork/heimdall/helper"
-	"github.com/maticnetwork/heimdall/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/x/chainmanager/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/x/checkpoint/types"
 )
 
 const (
-	AccountDetailsURL       = "/cosmos/auth/v1beta1/accounts/%v"
-	LastNoAckURL            = "/heimdall/checkpoint/v1beta1/last-no-ack"
-	CheckpointParamsURL     = "/heimdall/checkpoint/v1beta1/params"
-	ChainManagerParamsURL   = "/heimdall/chainmanager/v1beta1/params"
-	ProposersURL            = "/heimdall/staking/v1beta1/proposer/%v"
-	BufferedCheckpointURL   = "/heimdall/checkpoint/v1beta1/buffer"
-	LatestCheckpointURL     = "/heimdall/checkpoint/v1beta1/latest"
-	LatestSpanURL           = "/heimdall/bor/v1beta1/latest-span"
-	NextSpanInfoURL         = "/heimdall/bor/v1beta1/prepare-next-span"
-	NextSpanSeedURL         = "/heimdall/bor/v1beta1/next-span-seed"
-	DividendAccountRootURL  = "/heimdall/topup/v1beta1/dividend-account-root"
-	ValidatorURL            = "/heimdall/staking/v1beta1/validator/%v"
-	CurrentValidatorSetURL  = "/heimdall/staking/v1beta1/validator-set"
-	StakingTxStatusURL      = "/heimdall/staking/v1beta1/isoldtx"
-	TopupTxStatusURL        = "/heimdall/topup/v1beta1/isoldtx"
-	ClerkTxStatusURL        = "/heimdall/clerk/v1beta1/isoldtx"
-	LatestSlashInfoBytesURL = "/slashing/latest_slash_info_bytes"
-	TickSlashInfoListURL    = "/slashing/tick_slash_infos"
-	SlashingTxStatusURL     = "/slashing/isoldtx"
-	SlashingTickCountURL    = "/slashing/tick-count"
-
 	TransactionTimeout      = 1 * time.Minute
 	CommitTimeout           = 2 * time.Minute
 	BlockInterval           = 6 * time.Second
@@ -90,21 +56,13 @@ func Logger() log.Logger {
 
 // IsProposer  checks if we are proposer
 func IsProposer(cliCtx client.Context) (bool, error) {
-	var validatorSet types2.QueryValidatorSetResponse
-	result, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(CurrentValidatorSetURL))
-
+	validatorSet, err := GetHeimdallClient().ValidatorSet()
 	if err != nil {
-		logger.Error("Error fetching proposers", "url", CurrentValidatorSetURL, "error", err)
-		return false, err
-	}
-
-	err = jsonpb.UnmarshalString(string(result), &validatorSet)
-	if err != nil {
-		logger.Error("error unmarshalling proposer slice", "error", err)
+		logger.Error("Error fetching proposers", "error", err)
 		return false, err
 	}
 
-	if bytes.Equal(validatorSet.ValidatorSet.Proposer.GetSigner(), helper.GetAddress()) {
+	if bytes.Equal(validatorSet.Proposer.GetSigner(), helper.GetAddress()) {
 		return true, nil
 	}
 
@@ -114,22 +72,14 @@ func IsProposer(cliCtx client.Context) (bool, error) {
 // IsInProposerList checks if we are in current proposer
 func IsInProposerList(cliCtx client.Context, count uint64) (bool, error) {
 	logger.Debug("Skipping proposers", "count", strconv.FormatUint(count, 10))
-	response, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(fmt.Sprintf(ProposersURL, strconv.FormatUint(count, 10))))
+	proposers, err := GetHeimdallClient().Proposers(count)
 	if err != nil {
-		logger.Error("Unable to send request for next proposers", "url", ProposersURL, "error", err)
-		return false, err
-	}
-
-	// unmarshall data from buffer
-	var proposers types2.QueryProposerResponse
-
-	if err := jsonpb.UnmarshalString(string(response), &proposers); err != nil {
-		logger.Error("Error unmarshalling validator data ", "error", err)
+		logger.Error("Unable to send request for next proposers", "error", err)
 		return false, err
 	}
 
 	logger.Debug("Fetched proposers list", "numberOfProposers", count)
-	for _, proposer := range proposers.Proposers {
+	for _, proposer := range proposers {
 		if bytes.Equal(proposer.GetSigner(), helper.GetAddress()) {
 			return true, nil
 		}
@@ -143,21 +93,14 @@ func CalculateTaskDelay(cliCtx client.Context) (bool, time.Duration) {
 	// calculate validator position
 	valPosition := 0
 	isCurrentValidator := false
-	response, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(CurrentValidatorSetURL))
+	validatorSet, err := GetHeimdallClient().ValidatorSet()
 	if err != nil {
-		logger.Error("Unable to send request for current validatorset", "url", CurrentValidatorSetURL, "error", err)
-		return false, 0
-	}
-	// unmarshall data from buffer
-	var validatorSet types2.QueryValidatorSetResponse
-	err = jsonpb.UnmarshalString(string(response), &validatorSet)
-	if err != nil {
-		logger.Error("Error unmarshalling current validatorset data ", "error", err)
+		logger.Error("Unable to send request for current validatorset", "error", err)
 		return false, 0
 	}
 
-	logger.Info("Fetched current validatorset list", "currentValidatorcount", len(validatorSet.ValidatorSet.Validators))
-	for i, validator := range validatorSet.ValidatorSet.Validators {
+	logger.Info("Fetched current validatorset list", "currentValidatorcount", len(validatorSet.Validators))
+	for i, validator := range validatorSet.Validators {
 		if bytes.Equal(validator.GetSigner(), helper.GetAddress()) {
 			valPosition = i + 1
 			isCurrentValidator = true
@@ -198,21 +141,14 @@ func CalculateSpanTaskDelay(cliContext client.Context, id uint64, start uint64)
 
 // IsCurrentProposer checks if we are current proposer
 func IsCurrentProposer(cliCtx client.Context) (bool, error) {
-	var validatorSet types2.QueryValidatorSetResponse
-	result, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(CurrentValidatorSetURL))
+	validatorSet, err := GetHeimdallClient().ValidatorSet()
 	if err != nil {
 		logger.Error("Error fetching proposers", "error", err)
 		return false, err
 	}
+	logger.Debug("Current proposer fetched", "validator", validatorSet.Proposer.Signer)
 
-	err = jsonpb.UnmarshalString(string(result), &validatorSet)
-	if err != nil {
-		logger.Error("error unmarshalling validator", "error", err)
-		return false, err
-	}
-	logger.Debug("Current proposer fetched", "validator", validatorSet.ValidatorSet.Proposer.Signer)
-
-	if bytes.Equal(validatorSet.ValidatorSet.Proposer.GetSigner(), helper.GetAddress()) {
+	if bytes.Equal(validatorSet.Proposer.GetSigner(), helper.GetAddress()) {
 		return true, nil
 	}
 
@@ -221,39 +157,14 @@ func IsCurrentProposer(cliCtx client.Context) (bool, error) {
 
 // IsEventSender check if we are the EventSender
 func IsEventSender(cliCtx client.Context, validatorID uint64) bool {
-	var validator types2.QueryValidatorResponse
-
-	result, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(fmt.Sprintf(ValidatorURL, strconv.FormatUint(validatorID, 10))))
+	validator, err := GetHeimdallClient().Validator(validatorID)
 	if err != nil {
 		logger.Error("Error fetching proposers", "error", err)
 		return false
 	}
-
-	err = jsonpb.UnmarshalString(string(result), &validator)
-	if err != nil {
-		logger.Error("error unmarshalling proposer slice", "error", err)
-		return false
-	}
 	logger.Debug("Current event sender received", "validator", validator.String())
 
-	return bytes.Equal(validator.Validator.GetSigner(), helper.GetAddress())
-}
-
-//CreateURLWithQuery receives the uri and parameters in key value form
-//it will return the new url with the given query from the parameter
-func CreateURLWithQuery(uri string, param map[string]interface{}) (string, error) {
-	urlObj, err := url.Parse(uri)
-	if err != nil {
-		return uri, err
-	}
-
-	query := urlObj.Query()
-	for k, v := range param {
-		query.Set(k, fmt.Sprintf("%v", v))
-	}
-
-	urlObj.RawQuery = query.Encode()
-	return urlObj.String(), nil
+	return bytes.Equal(validator.GetSigner(), helper.GetAddress())
 }
 
 // WaitForOneEvent subscribes to a websocket event for the given
@@ -303,90 +214,58 @@ func IsCatchingUp(cliCtx client.Context) bool {
 }
 
 // GetAccount returns heimdall auth account
-func GetAccount(cliCtx client.Context, address hmCommonTypes.HeimdallAddress) (account authTypes.BaseAccount, err error) {
-	url := helper.GetHeimdallServerEndpoint(fmt.Sprintf(AccountDetailsURL, address))
-	// call account rest api
-	response, err := helper.FetchFromAPI(url)
+func GetAccount(cliCtx client.Context, address hmCommonTypes.HeimdallAddress) (authTypes.AccountI, error) {
+	account, err := GetHeimdallClient().Account(address.Bytes())
 	if err != nil {
-		return
+		logger.Error("Error fetching account details", "address", address, "error", err)
+		return nil, err
 	}
 
-	if err = json.Unmarshal(response, &account); err != nil {
-		logger.Error("Error unmarshalling account details", "url", url, "Error", err)
-		return
-	}
-	return
+	return account, nil
 }
 
 // GetChainmanagerParams return chain manager params
 func GetChainmanagerParams(cliCtx client.Context) (*chainmanagerTypes.Params, error) {
-	response, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(ChainManagerParamsURL))
-
+	params, err := GetHeimdallClient().ChainmanagerParams()
 	if err != nil {
 		logger.Error("Error fetching chainmanager params", "err", err)
 		return nil, err
 	}
 
-	var chainmanagerParamsResponse chainmanagerTypes.QueryParamsResponse
-	if err := jsonpb.UnmarshalString(string(response), &chainmanagerParamsResponse); err != nil {
-		logger.Error("Error unmarshalling chainmanager params", "url", ChainManagerParamsURL, "err", err)
-		return nil, err
-	}
-	return chainmanagerParamsResponse.Params, nil
+	return params, nil
 }
 
 // GetCheckpointParams return params
 func GetCheckpointParams(cliCtx client.Context) (*checkpointTypes.Params, error) {
-	response, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(CheckpointParamsURL))
-
+	params, err := GetHeimdallClient().CheckpointParams()
 	if err != nil {
 		logger.Error("Error fetching Checkpoint params", "err", err)
 		return nil, err
 	}
 
-	var params checkpointTypes.QueryParamsResponse
-	if err := jsonpb.UnmarshalString(string(response), &params); err != nil {
-		logger.Error("Error unmarshalling Checkpoint params", "url", CheckpointParamsURL, "Error", err)
-		return nil, err
-	}
-
-	return &params.Params, nil
+	return params, nil
 }
 
 // GetBufferedCheckpoint return checkpoint from buffer
 func GetBufferedCheckpoint(cliCtx client.Context) (*hmTypes.Checkpoint, error) {
-	response, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(BufferedCheckpointURL))
-
+	checkpoint, err := GetHeimdallClient().CheckpointBuffer()
 	if err != nil {
 		logger.Debug("Error fetching buffered checkpoint", "err", err)
 		return nil, err
 	}
 
-	var checkpoint hmTypes.Checkpoint
-	if err := jsonpb.UnmarshalString(string(response), &checkpoint); err != nil {
-		logger.Error("Error unmarshalling buffered checkpoint", "url", BufferedCheckpointURL, "err", err)
-		return nil, err
-	}
-
-	return &checkpoint, nil
+	return checkpoint, nil
 }
 
 // GetlastestCheckpoint return last successful checkpoint
 func GetlastestCheckpoint(cliCtx client.Context) (*hmTypes.Checkpoint, error) {
-	response, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(LatestCheckpointURL))
-
+	checkpoint, err := GetHeimdallClient().LatestCheckpoint()
 	if err != nil {
 		logger.Debug("Error fetching latest checkpoint", "err", err)
 		return nil, err
 	}
 
-	var checkpoint hmTypes.Checkpoint
-	if err := jsonpb.UnmarshalString(string(response), &checkpoint); err != nil {
-		logger.Error("Error unmarshalling latest checkpoint", "url", LatestCheckpointURL, "err", err)
-		return nil, err
-	}
-
-	return &checkpoint, nil
+	return checkpoint, nil
 }
 
 // AppendPrefix returns publickey in uncompressed format
@@ -400,55 +279,32 @@ func AppendPrefix(signerPubKey []byte) []byte {
 }
 
 // fetch next span details from heimdall.
-func FetchNextSpanDetails(cliCtx client.Context, id uint64, start uint64) (*types.Span, error) {
-	req, err := http.NewRequest("GET", helper.GetHeimdallServerEndpoint(NextSpanInfoURL), nil)
-	if err != nil {
-		logger.Error("Error creating a new request", "error", err)
-		return nil, err
-	}
+func FetchNextSpanDetails(cliCtx client.Context, id uint64, start uint64) (*hmTypes.Span, error) {
 	configParams, err := GetChainmanagerParams(cliCtx)
 	if err != nil {
 		logger.Error("Error while fetching chainmanager params", "error", err)
 		return nil, err
 	}
 
-	q := req.URL.Query()
-	q.Add("span_id", strconv.FormatUint(id, 10))
-	q.Add("start_block", strconv.FormatUint(start, 10))
-	q.Add("chain_id", configParams.ChainParams.BorChainID)
-	q.Add("proposer", helper.GetFromAddress(cliCtx).String())
-	req.URL.RawQuery = q.Encode()
-
 	// fetch next span details
-	result, err := helper.FetchFromAPI(req.URL.String())
+	span, err := GetHeimdallClient().PrepareNextSpan(id, start, configParams.ChainParams.BorChainID)
 	if err != nil {
-		logger.Error("Error fetching proposers", "error", err)
-		return nil, err
-	}
-
-	var msg borTypes.PrepareNextSpanResponse
-	if err = jsonpb.UnmarshalString(string(result), &msg); err != nil {
-		logger.Error("Error unmarshalling propose tx msg ", "error", err)
+		logger.Error("Error fetching next span details", "error", err)
 		return nil, err
 	}
 
-	logger.Debug("◽ Generated proposer span msg", "msg", msg.String())
-	return msg.Span, nil
+	logger.Debug("◽ Generated proposer span", "span", span.String())
+	return span, nil
 }
 
 // get Last span
-func GetLastSpan(cliCtx client.Context) (*types.Span, error) {
+func GetLastSpan(cliCtx client.Context) (*hmTypes.Span, error) {
 	// fetch last span
-	result, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(LatestSpanURL))
+	lastSpan, err := GetHeimdallClient().LatestSpan()
 	if err != nil {
-		logger.Error("Error while fetching latest span")
+		logger.Error("Error while fetching latest span", "error", err)
 		return nil, err
 	}
-	var lastSpan borTypes.QueryLatestSpanResponse
-	err = jsonpb.UnmarshalString(string(result), &lastSpan)
-	if err != nil {
-		logger.Error("Error unmarshalling span", "error", err)
-		return nil, err
-	}
-	return lastSpan.Span, nil
+
+	return lastSpan, nil
 }
@@ -0,0 +1,516 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/maticnetwork/bor/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
+	borTypes "github.com/maticnetwork/heimdall/x/bor/types"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/x/chainmanager/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/x/checkpoint/types"
+	clerkTypes "github.com/maticnetwork/heimdall/x/clerk/types"
+	slashingTypes "github.com/maticnetwork/heimdall/x/slashing/types"
+	stakingTypes "github.com/maticnetwork/heimdall/x/staking/types"
+	topupTypes "github.com/maticnetwork/heimdall/x/topup/types"
+)
+
+// HeimdallQueryTimeout is the deadline of every query sent to heimdall
+const HeimdallQueryTimeout = 30 * time.Second
+
+var heimdallClient *HeimdallClient
+var heimdallClientMu sync.Mutex
+
+// HeimdallClient queries heimdall modules over gRPC. Queries are sent with a
+// deadline and, for clients returned by AtHeight, against the given height.
+type HeimdallClient struct {
+	conn     *grpc.ClientConn
+	registry codectypes.InterfaceRegistry
+	timeout  time.Duration
+	height   int64
+
+	auth         authTypes.QueryClient
+	staking      stakingTypes.QueryClient
+	checkpoint   checkpointTypes.QueryClient
+	chainmanager chainmanagerTypes.QueryClient
+	bor          borTypes.QueryClient
+	topup        topupTypes.QueryClient
+	clerk        clerkTypes.QueryClient
+	slashing     slashingTypes.QueryClient
+}
+
+// NewHeimdallClient creates heimdall client on the given connection
+func NewHeimdallClient(conn *grpc.ClientConn, registry codectypes.InterfaceRegistry, timeout time.Duration) *HeimdallClient {
+	return &HeimdallClient{
+		conn:     conn,
+		registry: registry,
+		timeout:  timeout,
+
+		auth:         authTypes.NewQueryClient(conn),
+		staking:      stakingTypes.NewQueryClient(conn),
+		checkpoint:   checkpointTypes.NewQueryClient(conn),
+		chainmanager: chainmanagerTypes.NewQueryClient(conn),
+		bor:          borTypes.NewQueryClient(conn),
+		topup:        topupTypes.NewQueryClient(conn),
+		clerk:        clerkTypes.NewQueryClient(conn),
+		slashing:     slashingTypes.NewQueryClient(conn),
+	}
+}
+
+// InitHeimdallClient connects to the heimdall gRPC server, the connection is shared by all bridge services
+func InitHeimdallClient(registry codectypes.InterfaceRegistry) error {
+	heimdallClientMu.Lock()
+	defer heimdallClientMu.Unlock()
+
+	if heimdallClient != nil {
+		return nil
+	}
+
+	url := helper.GetConfig().HeimdallGRPCServerURL
+	if url == "" {
+		url = helper.DefaultHeimdallGRPCServerURL
+	}
+
+	// dial doesn't block, connection is (re)established on use
+	conn, err := grpc.Dial(url, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+
+	heimdallClient = NewHeimdallClient(conn, registry, HeimdallQueryTimeout)
+	return nil
+}
+
+// GetHeimdallClient returns heimdall client singleton instance
+func GetHeimdallClient() *HeimdallClient {
+	heimdallClientMu.Lock()
+	defer heimdallClientMu.Unlock()
+
+	if heimdallClient == nil {
+		panic("heimdall client is not initialised")
+	}
+
+	return heimdallClient
+}
+
+// CloseHeimdallClient closes the heimdall gRPC connection
+func CloseHeimdallClient() {
+	heimdallClientMu.Lock()
+	defer heimdallClientMu.Unlock()
+
+	if heimdallClient != nil {
+		if err := heimdallClient.conn.Close(); err != nil {
+			Logger().Error("CloseHeimdallClient | Close", "Error", err)
+		}
+		heimdallClient = nil
+	}
+}
+
+// AtHeight returns a client sending its queries against the given heimdall height
+func (c *HeimdallClient) AtHeight(height int64) *HeimdallClient {
+	pinned := *c
+	pinned.height = height
+	return &pinned
+}
+
+// context returns the context of a query
+func (c *HeimdallClient) context() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	if c.height > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(c.height, 10))
+	}
+
+	return ctx, cancel
+}
+
+//
+// Auth
+//
+
+// Account returns the heimdall account of the address
+func (c *HeimdallClient) Account(address sdk.AccAddress) (authTypes.AccountI, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.auth.Account(ctx, &authTypes.QueryAccountRequest{Address: address.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	var account authTypes.AccountI
+	if err := c.registry.UnpackAny(res.Account, &account); err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+//
+// Staking
+//
+
+// ValidatorSet returns the current validator set
+func (c *HeimdallClient) ValidatorSet() (*hmTypes.ValidatorSet, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.staking.ValidatorSet(ctx, &stakingTypes.QueryValidatorSetRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.ValidatorSet == nil {
+		return nil, errors.New("validator set not found")
+	}
+
+	return res.ValidatorSet, nil
+}
+
+// Validator returns the validator with given id
+func (c *HeimdallClient) Validator(validatorID uint64) (*hmTypes.Validator, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.staking.Validator(ctx, &stakingTypes.QueryValidatorRequest{ValidatorId: int32(validatorID)})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Validator == nil {
+		return nil, errors.New("validator not found")
+	}
+
+	return res.Validator, nil
+}
+
+// Proposers returns the next proposers
+func (c *HeimdallClient) Proposers(times uint64) ([]*hmTypes.Validator, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.staking.QueryProposer(ctx, &stakingTypes.QueryProposerRequest{Times: uint32(times)})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Proposers, nil
+}
+
+// StakingOldTx checks if the staking event was already processed
+func (c *HeimdallClient) StakingOldTx(txHash string, logIndex uint64) (bool, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.staking.StakingOldTx(ctx, &stakingTypes.QueryStakingOldTxRequest{TxHash: txHash, LogIndex: logIndex})
+	if err != nil {
+		return false, err
+	}
+
+	return res.Status, nil
+}
+
+//
+// Chainmanager
+//
+
+// ChainmanagerParams returns the chain manager params
+func (c *HeimdallClient) ChainmanagerParams() (*chainmanagerTypes.Params, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.chainmanager.Params(ctx, &chainmanagerTypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Params == nil {
+		return nil, errors.New("chainmanager params not found")
+	}
+
+	return res.Params, nil
+}
+
+//
+// Checkpoint
+//
+
+// CheckpointParams returns the checkpoint params
+func (c *HeimdallClient) CheckpointParams() (*checkpointTypes.Params, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.checkpoint.Params(ctx, &checkpointTypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Params, nil
+}
+
+// CheckpointBuffer returns the checkpoint in buffer
+func (c *HeimdallClient) CheckpointBuffer() (*hmTypes.Checkpoint, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.checkpoint.CheckpointBuffer(ctx, &checkpointTypes.QueryCheckpointBufferRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.CheckpointBuffer == nil {
+		return nil, errors.New("no checkpoint in buffer")
+	}
+
+	return res.CheckpointBuffer, nil
+}
+
+// LatestCheckpoint returns the last acknowledged checkpoint
+func (c *HeimdallClient) LatestCheckpoint() (*hmTypes.Checkpoint, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.checkpoint.LatestCheckpoint(ctx, &checkpointTypes.QueryLatestCheckpointRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.LatestCheckpoint == nil {
+		return nil, errors.New("latest checkpoint not found")
+	}
+
+	return res.LatestCheckpoint, nil
+}
+
+// LastNoAck returns the time of the last no-ack
+func (c *HeimdallClient) LastNoAck() (uint64, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.checkpoint.LastNoAck(ctx, &checkpointTypes.QueryLastNoAckRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	return res.LastNoAck, nil
+}
+
+//
+// Bor
+//
+
+// LatestSpan returns the latest span
+func (c *HeimdallClient) LatestSpan() (*hmTypes.Span, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.bor.LatestSpan(ctx, &borTypes.QueryLatestSpanRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Span == nil {
+		return nil, errors.New("latest span not found")
+	}
+
+	return res.Span, nil
+}
+
+// SpanByBlock returns the span covering the bor block
+func (c *HeimdallClient) SpanByBlock(block uint64) (*hmTypes.Span, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.bor.SpanByBlock(ctx, &borTypes.QuerySpanByBlockRequest{Block: block})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Span == nil {
+		return nil, errors.New("span not found")
+	}
+
+	return res.Span, nil
+}
+
+// BorParams returns the bor params
+func (c *HeimdallClient) BorParams() (*borTypes.QueryParamsResponse, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	return c.bor.Params(ctx, &borTypes.QueryParamsRequest{})
+}
+
+// PrepareNextSpan returns the span heimdall would create for the given span id and start block
+func (c *HeimdallClient) PrepareNextSpan(spanID uint64, startBlock uint64, borChainID string) (*hmTypes.Span, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.bor.PrepareNextSpan(ctx, &borTypes.PrepareNextSpanRequest{
+		SpanId:     spanID,
+		StartBlock: startBlock,
+		BorChainId: borChainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Span == nil {
+		return nil, errors.New("next span not found")
+	}
+
+	return res.Span, nil
+}
+
+// NextSpanSeed returns the seed of the next span
+func (c *HeimdallClient) NextSpanSeed() (common.Hash, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.bor.NextSpanSeed(ctx, &borTypes.QueryNextSpanSeedRequest{})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.HexToHash(res.NextSpanSeed), nil
+}
+
+//
+// Topup
+//
+
+// DividendAccountRoot returns the root hash of the dividend accounts
+func (c *HeimdallClient) DividendAccountRoot() (hmCommonTypes.HeimdallHash, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.topup.QueryDividendAccountRoot(ctx, &topupTypes.QueryDividendAccountRootRequest{})
+	if err != nil {
+		return hmCommonTypes.HeimdallHash{}, err
+	}
+
+	return hmCommonTypes.HexToHeimdallHash(res.AccountRootHash), nil
+}
+
+// TopupOldTx checks if the topup event was already processed
+func (c *HeimdallClient) TopupOldTx(txHash string, logIndex uint64) (bool, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.topup.IsOldTx(ctx, &topupTypes.QueryIsOldTxSequenceRequest{TxHash: txHash, LogIndex: logIndex})
+	if err != nil {
+		return false, err
+	}
+
+	return res.Status, nil
+}
+
+//
+// Clerk
+//
+
+// ClerkOldTx checks if the state sync event was already processed
+func (c *HeimdallClient) ClerkOldTx(txHash string, logIndex uint64) (bool, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.clerk.QueryIsOldTxClerk(ctx, &clerkTypes.QueryIsOldTxRequest{TxHash: txHash, LogIndex: logIndex})
+	if err != nil {
+		return false, err
+	}
+
+	return res.Status, nil
+}
+
+// ClerkRecord returns the stored state sync record with the id
+func (c *HeimdallClient) ClerkRecord(recordID uint64) (*clerkTypes.EventRecord, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.clerk.Record(ctx, &clerkTypes.QueryRecordParams{RecordId: recordID})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.EventRecord, nil
+}
+
+// ClerkMissingRecords returns the ids of the state sync records missing before the latest record
+func (c *HeimdallClient) ClerkMissingRecords(limit uint64) ([]uint64, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.clerk.MissingRecords(ctx, &clerkTypes.QueryMissingRecordsRequest{Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.MissingRecordIds, nil
+}
+
+//
+// Slashing
+//
+
+// LatestSlashInfoBytes returns the rlp encoded slash infos of the latest tick
+func (c *HeimdallClient) LatestSlashInfoBytes() (hmTypes.HexBytes, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.slashing.LatestSlashInfoBytes(ctx, &slashingTypes.QueryLatestSlashInfoBytesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return hmTypes.HexToHexBytes(res.SlashInfoBytes), nil
+}
+
+// TickCount returns the slashing tick count
+func (c *HeimdallClient) TickCount() (uint64, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.slashing.TickCount(ctx, &slashingTypes.QueryTickCountRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	return res.TickCount, nil
+}
+
+// TickSlashInfos returns the slash infos of the latest tick
+func (c *HeimdallClient) TickSlashInfos() ([]*hmTypes.ValidatorSlashingInfo, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.slashing.TickSlashInfos(ctx, &slashingTypes.QueryTickSlashInfosRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.SlashingInfos, nil
+}
+
+// SlashingOldTx checks if the slashing event was already processed
+func (c *HeimdallClient) SlashingOldTx(txHash string, logIndex uint64) (bool, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.slashing.SlashingOldTx(ctx, &slashingTypes.QuerySlashingOldTxRequest{TxHash: txHash, LogIndex: logIndex})
+	if err != nil {
+		return false, err
+	}
+
+	return res.Status, nil
+}
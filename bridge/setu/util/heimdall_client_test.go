@@ -0,0 +1,99 @@
+package util
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	stakingTypes "github.com/maticnetwork/heimdall/x/staking/types"
+)
+
+// stakingQueryServer records the incoming metadata and deadline of queries
+type stakingQueryServer struct {
+	stakingTypes.UnimplementedQueryServer
+
+	heights   chan []string
+	deadlines chan bool
+}
+
+func (s *stakingQueryServer) ValidatorSet(ctx context.Context, req *stakingTypes.QueryValidatorSetRequest) (*stakingTypes.QueryValidatorSetResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.heights <- md.Get(grpctypes.GRPCBlockHeightHeader)
+
+	_, hasDeadline := ctx.Deadline()
+	s.deadlines <- hasDeadline
+
+	return &stakingTypes.QueryValidatorSetResponse{
+		ValidatorSet: &hmTypes.ValidatorSet{
+			Validators: []*hmTypes.Validator{{ID: 1, Signer: "0x1"}},
+			Proposer:   &hmTypes.Validator{ID: 1, Signer: "0x1"},
+		},
+	}, nil
+}
+
+func (s *stakingQueryServer) Validator(ctx context.Context, req *stakingTypes.QueryValidatorRequest) (*stakingTypes.QueryValidatorResponse, error) {
+	return nil, status.Error(codes.NotFound, "validator not found")
+}
+
+func newTestHeimdallClient(t *testing.T, server *stakingQueryServer) *HeimdallClient {
+	listener := bufconn.Listen(1024 * 1024)
+
+	grpcServer := grpc.NewServer()
+	stakingTypes.RegisterQueryServer(grpcServer, server)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithInsecure(),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return NewHeimdallClient(conn, codectypes.NewInterfaceRegistry(), 5*time.Second)
+}
+
+func TestHeimdallClientQuery(t *testing.T) {
+	server := &stakingQueryServer{heights: make(chan []string, 1), deadlines: make(chan bool, 1)}
+	client := newTestHeimdallClient(t, server)
+
+	validatorSet, err := client.ValidatorSet()
+	require.NoError(t, err)
+	require.Len(t, validatorSet.Validators, 1)
+	require.Equal(t, "0x1", validatorSet.Proposer.Signer)
+
+	require.Empty(t, <-server.heights)
+	require.True(t, <-server.deadlines)
+}
+
+func TestHeimdallClientAtHeight(t *testing.T) {
+	server := &stakingQueryServer{heights: make(chan []string, 2), deadlines: make(chan bool, 2)}
+	client := newTestHeimdallClient(t, server)
+
+	_, err := client.AtHeight(42).ValidatorSet()
+	require.NoError(t, err)
+	require.Equal(t, []string{"42"}, <-server.heights)
+
+	// the original client is not pinned
+	_, err = client.ValidatorSet()
+	require.NoError(t, err)
+	require.Empty(t, <-server.heights)
+}
+
+func TestHeimdallClientError(t *testing.T) {
+	client := newTestHeimdallClient(t, &stakingQueryServer{})
+
+	_, err := client.Validator(1)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -57,11 +57,3 @@ type ValidatorSetResponse struct {
 //type CheckPointParamsResponse struct {
 //	Params CheckPointParams `json:"params"`
 //}
-
-type LastNoAckResponse struct {
-	LastNoAck uint64 `json:"last_no_ack,string"`
-}
-
-type NextSpanSeedResponse struct {
-	NextSpanSeed string `json:"next_span_seed"`
-}
@@ -8,6 +8,7 @@ import (
 
 	borTypes "github.com/maticnetwork/heimdall/x/bor/types"
 
+	slashingTypes "github.com/maticnetwork/heimdall/x/slashing/types"
 	topupTypes "github.com/maticnetwork/heimdall/x/topup/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -100,8 +101,7 @@ func initCmd(ctx *server.Context, amino *codec.LegacyAmino, mbm module.BasicMana
 
 			// create validator signing info
 			valSigningInfo := hmtypes.NewValidatorSigningInfo(validator.ID, 0, 0, 0)
-			valSigningInfoMap := make(map[string]hmtypes.ValidatorSigningInfo)
-			valSigningInfoMap[valSigningInfo.ValID.String()] = valSigningInfo
+			valSigningInfos := []hmtypes.ValidatorSigningInfo{valSigningInfo}
 
 			// create genesis state
 			// appStateBytes := app.NewDefaultGenesisState()
@@ -136,11 +136,11 @@ func initCmd(ctx *server.Context, amino *codec.LegacyAmino, mbm module.BasicMana
 				return err
 			}
 
-			// // slashing state change
-			//appStateBytes, err = slashingTypes.SetGenesisStateToAppState(appStateBytes, valSigningInfoMap)
-			//if err != nil {
-			//	return err
-			//}
+			// slashing state change
+			appState, err = slashingTypes.SetGenesisStateToAppState(cdc, appState, valSigningInfos)
+			if err != nil {
+				return err
+			}
 
 			// bor state change
 			appState, err = borTypes.SetGenesisStateToAppState(appState, *validatorSet)
@@ -411,9 +411,12 @@ func showPrivateKeyCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "show-privatekey",
 		Short: "Print the account's private key",
-		Run: func(cmd *cobra.Command, args []string) {
-			// get private and public keys
-			privObject := helper.GetPrivKey()
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// the private key is only held by the file signer
+			privObject, err := helper.GetPrivKey()
+			if err != nil {
+				return err
+			}
 
 			account := &ValidatorAccountFormatter{
 				PrivKey: "0x" + hex.EncodeToString(privObject[:]),
@@ -421,11 +424,12 @@ func showPrivateKeyCmd() *cobra.Command {
 
 			b, err := json.MarshalIndent(account, "", "    ")
 			if err != nil {
-				panic(err)
+				return err
 			}
 
 			// prints json info
 			fmt.Printf("%s", string(b))
+			return nil
 		},
 	}
 }
@@ -46,6 +46,8 @@ var (
 	ErrUnableToFreezeValSet = sdkerrors.Register(ModuleName, 3502, "Unable to freeze validator set for next span")
 	ErrValSetMisMatch       = sdkerrors.Register(ModuleName, 3504, "Validator set mismatch")
 	ErrProducerMisMatch     = sdkerrors.Register(ModuleName, 3505, "Producer set mismatch")
+	ErrInvalidReplacement   = sdkerrors.Register(ModuleName, 3508, "Invalid replacement span")
+	ErrSpanAlreadyReplaced  = sdkerrors.Register(ModuleName, 3509, "Span already replaced")
 )
 
 // ErrorSideTx represents side-tx error
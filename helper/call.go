@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"math/big"
 	"strings"
 
@@ -11,6 +12,7 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
 	ethTypes "github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/bor/ethclient"
 	"github.com/maticnetwork/bor/rpc"
@@ -26,6 +28,9 @@ import (
 	"github.com/maticnetwork/heimdall/merr"
 )
 
+// maticChainBlockBatchSize is the max number of headers fetched in one batched rpc call
+const maticChainBlockBatchSize uint64 = 100
+
 // IContractCaller represents contract caller
 type IContractCaller interface {
 	GetHeaderInfo(headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (root common.Hash, start, end, createdAt uint64, proposer sdk.AccAddress, err error)
@@ -33,11 +38,10 @@ type IContractCaller interface {
 	GetLastChildBlock(rootChainInstance *rootchain.Rootchain) (uint64, error)
 	CurrentHeaderBlock(rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error)
 	GetBalance(address common.Address) (*big.Int, error)
-	SendCheckpoint(sigedData []byte, sigs []byte, rootchainAddress common.Address, rootChainInstance *rootchain.Rootchain) (err error)
-	SendTick(sigedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) (err error)
 	GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error)
 	GetMainChainBlock(*big.Int) (*ethTypes.Header, error)
 	GetMaticChainBlock(*big.Int) (*ethTypes.Header, error)
+	GetMaticChainBlocks(start uint64, end uint64) ([]*ethTypes.Header, error)
 	IsTxConfirmed(common.Hash, uint64) bool
 	GetConfirmedTxReceipt(common.Hash, uint64) (*ethTypes.Receipt, error)
 	GetBlockNumberFromTxHash(common.Hash) (*big.Int, error)
@@ -355,6 +359,47 @@ func (c *ContractCaller) GetMaticChainBlock(blockNum *big.Int) (header *ethTypes
 	return latestBlock, nil
 }
 
+// GetMaticChainBlocks returns child chain block headers from start to end
+// (both inclusive), fetched in batched rpc calls
+func (c *ContractCaller) GetMaticChainBlocks(start uint64, end uint64) ([]*ethTypes.Header, error) {
+	if start > end {
+		return nil, errors.New("start is greater than end")
+	}
+
+	headers := make([]*ethTypes.Header, end-start+1)
+	for from := start; from <= end; from += maticChainBlockBatchSize {
+		to := from + maticChainBlockBatchSize - 1
+		if to > end {
+			to = end
+		}
+
+		batch := make([]rpc.BatchElem, 0, to-from+1)
+		for number := from; number <= to; number++ {
+			batch = append(batch, rpc.BatchElem{
+				Method: "eth_getBlockByNumber",
+				Args:   []interface{}{hexutil.EncodeUint64(number), false},
+				Result: &headers[number-start],
+			})
+		}
+
+		if err := c.MaticChainRPC.BatchCallContext(context.Background(), batch); err != nil {
+			Logger.Error("Unable to connect to matic chain", "Error", err)
+			return nil, err
+		}
+
+		for i, elem := range batch {
+			if elem.Error != nil {
+				return nil, elem.Error
+			}
+			if headers[from-start+uint64(i)] == nil {
+				return nil, fmt.Errorf("block %d not found on matic chain", from+uint64(i))
+			}
+		}
+	}
+
+	return headers, nil
+}
+
 // GetBlockNumberFromTxHash gets block number of transaction
 func (c *ContractCaller) GetBlockNumberFromTxHash(tx common.Hash) (*big.Int, error) {
 	var rpcTx rpcTransaction
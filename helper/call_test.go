@@ -0,0 +1,54 @@
+package helper
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/ethclient"
+	"github.com/maticnetwork/bor/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// maticChainService serves bor headers up to latest
+type maticChainService struct {
+	latest uint64
+}
+
+func (s *maticChainService) GetBlockByNumber(_ context.Context, number hexutil.Uint64, _ bool) (*types.Header, error) {
+	if uint64(number) > s.latest {
+		return nil, nil
+	}
+
+	return &types.Header{
+		Number:     new(big.Int).SetUint64(uint64(number)),
+		Difficulty: big.NewInt(1),
+		Time:       1600000000 + uint64(number),
+	}, nil
+}
+
+func TestGetMaticChainBlocks(t *testing.T) {
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", &maticChainService{latest: 300}))
+	t.Cleanup(server.Stop)
+
+	client := rpc.DialInProc(server)
+	contractCaller := ContractCaller{MaticChainClient: ethclient.NewClient(client), MaticChainRPC: client}
+
+	// spans several batches
+	start, end := uint64(10), uint64(10+2*maticChainBlockBatchSize+5)
+	headers, err := contractCaller.GetMaticChainBlocks(start, end)
+	require.NoError(t, err)
+	require.Len(t, headers, int(end-start+1))
+	for i, header := range headers {
+		require.Equal(t, start+uint64(i), header.Number.Uint64())
+	}
+
+	_, err = contractCaller.GetMaticChainBlocks(290, 310)
+	require.Error(t, err)
+
+	_, err = contractCaller.GetMaticChainBlocks(20, 10)
+	require.Error(t, err)
+}
@@ -586,6 +586,29 @@ func (_m *IContractCaller) GetMaticChainBlock(_a0 *big.Int) (*types.Header, erro
 	return r0, r1
 }
 
+// GetMaticChainBlocks provides a mock function with given fields: start, end
+func (_m *IContractCaller) GetMaticChainBlocks(start uint64, end uint64) ([]*types.Header, error) {
+	ret := _m.Called(start, end)
+
+	var r0 []*types.Header
+	if rf, ok := ret.Get(0).(func(uint64, uint64) []*types.Header); ok {
+		r0 = rf(start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Header)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, uint64) error); ok {
+		r1 = rf(start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMaticTokenInstance provides a mock function with given fields: maticTokenAddress
 func (_m *IContractCaller) GetMaticTokenInstance(maticTokenAddress common.Address) (*erc20.Erc20, error) {
 	ret := _m.Called(maticTokenAddress)
@@ -891,31 +914,3 @@ func (_m *IContractCaller) IsTxConfirmed(_a0 common.Hash, _a1 uint64) bool {
 
 	return r0
 }
-
-// SendCheckpoint provides a mock function with given fields: sigedData, sigs, rootchainAddress, rootChainInstance
-func (_m *IContractCaller) SendCheckpoint(sigedData []byte, sigs []byte, rootchainAddress common.Address, rootChainInstance *rootchain.Rootchain) error {
-	ret := _m.Called(sigedData, sigs, rootchainAddress, rootChainInstance)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func([]byte, []byte, common.Address, *rootchain.Rootchain) error); ok {
-		r0 = rf(sigedData, sigs, rootchainAddress, rootChainInstance)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// SendTick provides a mock function with given fields: sigedData, sigs, slashManagerAddress, slashManagerInstance
-func (_m *IContractCaller) SendTick(sigedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) error {
-	ret := _m.Called(sigedData, sigs, slashManagerAddress, slashManagerInstance)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func([]byte, []byte, common.Address, *slashmanager.Slashmanager) error); ok {
-		r0 = rf(sigedData, sigs, slashManagerAddress, slashManagerInstance)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
@@ -0,0 +1,290 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/maticnetwork/bor/accounts/abi/bind"
+	"github.com/maticnetwork/bor/accounts/keystore"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/core/types"
+	ethcrypto "github.com/maticnetwork/bor/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/privval"
+)
+
+const (
+	// FileSignerType signs with the raw key of priv_validator_key.json
+	FileSignerType = "file"
+	// KeystoreSignerType signs with the key of an encrypted keystore file
+	KeystoreSignerType = "keystore"
+	// RemoteSignerType signs over HTTP with a key held by a remote signer
+	RemoteSignerType = "remote"
+
+	// DefaultSignerType represents default signer type
+	DefaultSignerType = FileSignerType
+
+	// RemoteSignerTimeout is the timeout of requests to the remote signer
+	RemoteSignerTimeout = 10 * time.Second
+
+	remoteSignerPubKeyPath = "pubkey"
+	remoteSignerSignPath   = "sign"
+)
+
+// Signer signs with the validator key
+type Signer interface {
+	// PubKey returns the compressed public key of the validator
+	PubKey() secp256k1.PubKey
+
+	// SignHash signs the 32 byte hash, returning a 65 byte [R || S || V] signature
+	SignHash(hash []byte) ([]byte, error)
+}
+
+// signer used for heimdall and ethereum txs
+var signer Signer
+
+// GetSigner returns the validator signer
+func GetSigner() Signer {
+	return signer
+}
+
+// SetSigner sets the validator signer
+func SetSigner(s Signer) {
+	signer = s
+}
+
+// initSigner creates the signer of the configured type
+func initSigner(configDir string, dataDir string) error {
+	switch conf.SignerType {
+	case "", FileSignerType:
+		FilePV = privval.LoadFilePV(filepath.Join(configDir, "priv_validator_key.json"), filepath.Join(dataDir, "priv_validator_state.json"))
+		signer = NewFileSigner(FilePV.Key.PrivKey.Bytes())
+
+	case KeystoreSignerType:
+		password, err := ioutil.ReadFile(conf.SignerKeystorePasswordFile)
+		if err != nil {
+			return fmt.Errorf("unable to read keystore password file: %v", err)
+		}
+
+		if signer, err = NewKeystoreSigner(conf.SignerKeystoreFile, strings.TrimRight(string(password), "\r\n")); err != nil {
+			return err
+		}
+
+	case RemoteSignerType:
+		var err error
+		if signer, err = NewRemoteSigner(conf.SignerRemoteURL, RemoteSignerTimeout); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("unknown signer type %q", conf.SignerType)
+	}
+
+	return nil
+}
+
+//
+// Local signers
+//
+
+// LocalSigner signs with a key held in memory
+type LocalSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+var _ Signer = (*LocalSigner)(nil)
+
+// NewFileSigner creates signer from the raw private key of priv_validator_key.json
+func NewFileSigner(privKey secp256k1.PrivKey) *LocalSigner {
+	return &LocalSigner{key: privKey.ToECDSA()}
+}
+
+// NewKeystoreSigner creates signer decrypting the key of the keystore file,
+// e.g. one written by generate-keystore
+func NewKeystoreSigner(keystoreFile string, password string) (*LocalSigner, error) {
+	keyJSON, err := ioutil.ReadFile(keystoreFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read keystore file: %v", err)
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt keystore file: %v", err)
+	}
+
+	return &LocalSigner{key: key.PrivateKey}, nil
+}
+
+// PubKey returns the compressed public key
+func (s *LocalSigner) PubKey() secp256k1.PubKey {
+	return ethcrypto.CompressPubkey(&s.key.PublicKey)
+}
+
+// SignHash signs the hash with the key
+func (s *LocalSigner) SignHash(hash []byte) ([]byte, error) {
+	return ethcrypto.Sign(hash, s.key)
+}
+
+//
+// Remote signer
+//
+
+// RemoteSigner signs with a key held by a remote signer, e.g. a KMS or HSM
+// service. It fetches the public key with `GET <url>/pubkey` and signs with
+// `POST <url>/sign`; every signature is checked against the public key.
+type RemoteSigner struct {
+	url    *url.URL
+	client *http.Client
+	pubKey secp256k1.PubKey
+}
+
+var _ Signer = (*RemoteSigner)(nil)
+
+// RemoteSignerPubKeyResponse is the response of the pubkey endpoint
+type RemoteSignerPubKeyResponse struct {
+	PubKey hexutil.Bytes `json:"pub_key"` // compressed or uncompressed public key
+}
+
+// RemoteSignerSignRequest is the request of the sign endpoint
+type RemoteSignerSignRequest struct {
+	Hash hexutil.Bytes `json:"hash"` // 32 byte hash
+}
+
+// RemoteSignerSignResponse is the response of the sign endpoint
+type RemoteSignerSignResponse struct {
+	Signature hexutil.Bytes `json:"signature"` // 65 byte [R || S || V] signature
+}
+
+// NewRemoteSigner creates signer for the remote signer at the url and fetches its public key
+func NewRemoteSigner(signerURL string, timeout time.Duration) (*RemoteSigner, error) {
+	u, err := url.Parse(signerURL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid remote signer url %q", signerURL)
+	}
+
+	s := &RemoteSigner{
+		url:    u,
+		client: &http.Client{Timeout: timeout},
+	}
+
+	var response RemoteSignerPubKeyResponse
+	if err := s.call(http.MethodGet, remoteSignerPubKeyPath, nil, &response); err != nil {
+		return nil, fmt.Errorf("unable to fetch remote signer public key: %v", err)
+	}
+
+	pubKey, err := parsePubKey(response.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	s.pubKey = ethcrypto.CompressPubkey(pubKey)
+
+	return s, nil
+}
+
+// PubKey returns the compressed public key of the remote signer
+func (s *RemoteSigner) PubKey() secp256k1.PubKey {
+	return s.pubKey
+}
+
+// SignHash requests the signature of the hash from the remote signer
+func (s *RemoteSigner) SignHash(hash []byte) ([]byte, error) {
+	var response RemoteSignerSignResponse
+	if err := s.call(http.MethodPost, remoteSignerSignPath, &RemoteSignerSignRequest{Hash: hash}, &response); err != nil {
+		return nil, err
+	}
+
+	// signature has to be made by the validator key
+	pubKey, err := ethcrypto.SigToPub(hash, response.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer signature: %v", err)
+	}
+	if !bytes.Equal(ethcrypto.CompressPubkey(pubKey), s.pubKey) {
+		return nil, errors.New("remote signer signature does not match its public key")
+	}
+
+	return response.Signature, nil
+}
+
+func (s *RemoteSigner) call(method string, endpoint string, request interface{}, response interface{}) error {
+	u := *s.url
+	u.Path = path.Join(u.Path, endpoint)
+
+	var body []byte
+	if request != nil {
+		var err error
+		if body, err = json.Marshal(request); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.client.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("remote signer responded with status %v: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+// parsePubKey parses compressed or uncompressed secp256k1 public key
+func parsePubKey(pubKey []byte) (*ecdsa.PublicKey, error) {
+	switch len(pubKey) {
+	case COMPRESSED_PUBKEY_SIZE_WITH_PREFIX:
+		return ethcrypto.DecompressPubkey(pubKey)
+	case UNCOMPRESSED_PUBKEY_SIZE_WITH_PREFIX:
+		return ethcrypto.UnmarshalPubkey(pubKey)
+	default:
+		return nil, fmt.Errorf("invalid public key length %v", len(pubKey))
+	}
+}
+
+// NewSignerTransactor creates transact opts signing ethereum txs with the signer
+func NewSignerTransactor(s Signer) (*bind.TransactOpts, error) {
+	pubKey, err := ethcrypto.DecompressPubkey(s.PubKey())
+	if err != nil {
+		return nil, err
+	}
+
+	from := ethcrypto.PubkeyToAddress(*pubKey)
+	return &bind.TransactOpts{
+		From: from,
+		Signer: func(txSigner types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != from {
+				return nil, errors.New("not authorized to sign this account")
+			}
+
+			signature, err := s.SignHash(txSigner.Hash(tx).Bytes())
+			if err != nil {
+				return nil, err
+			}
+
+			return tx.WithSignature(txSigner, signature)
+		},
+	}, nil
+}
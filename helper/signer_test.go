@@ -0,0 +1,134 @@
+package helper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/maticnetwork/bor/accounts/keystore"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	ethcrypto "github.com/maticnetwork/bor/crypto"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// newRemoteSignerServer serves the remote signer protocol signing with the key
+func newRemoteSignerServer(t *testing.T, pubKey []byte, key Signer) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/signer/pubkey", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(RemoteSignerPubKeyResponse{PubKey: pubKey})
+	})
+	mux.HandleFunc("/signer/sign", func(w http.ResponseWriter, r *http.Request) {
+		var request RemoteSignerSignRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		signature, err := key.SignHash(request.Hash)
+		require.NoError(t, err)
+		_ = json.NewEncoder(w).Encode(RemoteSignerSignResponse{Signature: signature})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func requireSignedBy(t *testing.T, signer Signer, pubKey secp256k1.PubKey) {
+	hash := ethcrypto.Keccak256([]byte("checkpoint"))
+	signature, err := signer.SignHash(hash)
+	require.NoError(t, err)
+	require.Len(t, signature, 65)
+
+	recovered, err := ethcrypto.SigToPub(hash, signature)
+	require.NoError(t, err)
+	require.Equal(t, []byte(pubKey), ethcrypto.CompressPubkey(recovered))
+}
+
+func TestFileSigner(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	signer := NewFileSigner(privKey)
+
+	require.Equal(t, privKey.PubKey(), signer.PubKey())
+	requireSignedBy(t, signer, signer.PubKey())
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	key := &keystore.Key{
+		Id:         uuid.NewRandom(),
+		Address:    ethcrypto.PubkeyToAddress(privKey.ToECDSA().PublicKey),
+		PrivateKey: privKey.ToECDSA(),
+	}
+	keyJSON, err := keystore.EncryptKey(key, "secret", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	keystoreFile := filepath.Join(t.TempDir(), "keystore.json")
+	require.NoError(t, ioutil.WriteFile(keystoreFile, keyJSON, 0600))
+
+	_, err = NewKeystoreSigner(keystoreFile, "wrong")
+	require.Error(t, err)
+
+	signer, err := NewKeystoreSigner(keystoreFile, "secret")
+	require.NoError(t, err)
+	require.Equal(t, privKey.PubKey(), signer.PubKey())
+	requireSignedBy(t, signer, signer.PubKey())
+}
+
+func TestRemoteSigner(t *testing.T) {
+	key := NewFileSigner(secp256k1.GenPrivKey())
+
+	// uncompressed public key
+	uncompressed := ethcrypto.FromECDSAPub(&key.key.PublicKey)
+	server := newRemoteSignerServer(t, uncompressed, key)
+
+	signer, err := NewRemoteSigner(server.URL+"/signer", RemoteSignerTimeout)
+	require.NoError(t, err)
+	require.Equal(t, key.PubKey(), signer.PubKey())
+	requireSignedBy(t, signer, key.PubKey())
+}
+
+func TestRemoteSignerWrongKey(t *testing.T) {
+	key := NewFileSigner(secp256k1.GenPrivKey())
+	other := NewFileSigner(secp256k1.GenPrivKey())
+	server := newRemoteSignerServer(t, key.PubKey(), other)
+
+	signer, err := NewRemoteSigner(server.URL+"/signer", RemoteSignerTimeout)
+	require.NoError(t, err)
+
+	_, err = signer.SignHash(ethcrypto.Keccak256([]byte("checkpoint")))
+	require.Error(t, err)
+}
+
+func TestSignerTransactor(t *testing.T) {
+	signer := NewFileSigner(secp256k1.GenPrivKey())
+
+	auth, err := NewSignerTransactor(signer)
+	require.NoError(t, err)
+	require.Equal(t, common.BytesToAddress(signer.PubKey().Address()), auth.From)
+
+	tx := types.NewTransaction(0, common.HexToAddress("0x1"), big.NewInt(0), 21000, big.NewInt(1), nil)
+	signedTx, err := auth.Signer(types.HomesteadSigner{}, auth.From, tx)
+	require.NoError(t, err)
+
+	sender, err := types.Sender(types.HomesteadSigner{}, signedTx)
+	require.NoError(t, err)
+	require.Equal(t, auth.From, sender)
+
+	_, err = auth.Signer(types.HomesteadSigner{}, common.HexToAddress("0x2"), tx)
+	require.Error(t, err)
+}
+
+func TestGetPrivKeyWithoutFileSigner(t *testing.T) {
+	filePV := FilePV
+	t.Cleanup(func() { FilePV = filePV })
+	FilePV = nil
+	SetTestConfig(Configuration{SignerType: KeystoreSignerType})
+
+	_, err := GetPrivKey()
+	require.EqualError(t, err, "private key is not available, the validator key is held by the keystore signer")
+}
@@ -27,11 +27,31 @@ tendermint_rpc_url = "{{ .TendermintRPCUrl }}"
 # Heimdall REST server endpoint
 heimdall_rest_server = "{{ .HeimdallServerURL }}"
 
+# Heimdall gRPC server endpoint, used by the bridge to query heimdall
+heimdall_grpc_server = "{{ .HeimdallGRPCServerURL }}"
+
 #### Bridge configs ####
 
-# AMQP endpoint
+# Queue backend between listeners and processors, "amqp" or "local".
+# The local backend runs in process and persists its tasks in the bridge db.
+queue_backend = "{{ .QueueBackend }}"
+
+# AMQP endpoint, used by the amqp queue backend
 amqp_url = "{{ .AmqpURL }}"
 
+# Prometheus metrics (/metrics) and health (/health) server of the bridge,
+# leave empty to disable
+bridge_metrics_server = "{{ .BridgeMetricsServerURL }}"
+
+## Signer of heimdall and ethereum txs
+# "file" signs with the key of priv_validator_key.json, "keystore" with the
+# key of an encrypted keystore file (see generate-keystore) and "remote" with
+# a remote signer serving GET <url>/pubkey and POST <url>/sign
+signer_type = "{{ .SignerType }}"
+signer_keystore_file = "{{ .SignerKeystoreFile }}"
+signer_keystore_password_file = "{{ .SignerKeystorePasswordFile }}"
+signer_remote_url = "{{ .SignerRemoteURL }}"
+
 ## Poll intervals
 checkpoint_poll_interval = "{{ .CheckpointerPollInterval }}"
 syncer_poll_interval = "{{ .SyncerPollInterval }}"
@@ -42,6 +62,26 @@ span_poll_interval = "{{ .SpanPollInterval }}"
 #### gas limits ####
 main_chain_gas_limit = "{{ .MainchainGasLimit }}"
 
+#### mainchain txs ####
+# pending txs are replaced with fees raised by main_chain_gas_price_bump percent
+# once they are pending for main_chain_tx_resubmit_interval
+main_chain_tx_resubmit_interval = "{{ .MainchainTxResubmitInterval }}"
+main_chain_gas_price_bump = "{{ .MainchainGasPriceBump }}"
+# cap of the gas price (fee cap for EIP-1559 txs) in wei, 0 for no cap
+main_chain_max_gas_price = "{{ .MainchainMaxGasPrice }}"
+main_chain_dynamic_fee = {{ .MainchainDynamicFee }}
+
+#### heimdall txs ####
+# independent msgs, e.g. state syncs and topups, are queued for
+# heimdall_tx_batch_interval and sent in multi-msg txs, 1 max msgs disables it
+heimdall_tx_batch_interval = "{{ .HeimdallTxBatchInterval }}"
+heimdall_tx_batch_max_msgs = "{{ .HeimdallTxBatchMaxMsgs }}"
+heimdall_tx_batch_max_bytes = "{{ .HeimdallTxBatchMaxBytes }}"
+heimdall_tx_batch_max_gas = "{{ .HeimdallTxBatchMaxGas }}"
+# pending txs, neither committed nor in the mempool after
+# heimdall_tx_resubmit_interval, are sent again
+heimdall_tx_resubmit_interval = "{{ .HeimdallTxResubmitInterval }}"
+
 ##### Timeout Config #####
 no_ack_wait_time = "{{ .NoACKWaitTime }}"
 
@@ -263,8 +263,9 @@ func GetSignedTxBytesNew(cliCtx client.Context, txf tx.Factory, msgs []sdk.Msg)
 	return txBytes, nil
 }
 
+// Sign signs the keccak256 hash of the message with the validator signer
 func Sign(msg []byte) ([]byte, error) {
-	return ethcrypto.Sign(ethcrypto.Keccak256Hash(msg).Bytes(), GetPrivKey().ToECDSA())
+	return GetSigner().SignHash(ethcrypto.Keccak256Hash(msg).Bytes())
 }
 
 // SignWithPrivKey signs a given tx with the given private key, and returns the
@@ -9,6 +9,7 @@ import (
 	"github.com/cbergoon/merkletree"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/crypto"
 )
 
@@ -64,8 +65,38 @@ func SortDividendAccountByAddress(dividendAccounts []*DividendAccount) []*Divide
 	return dividendAccounts
 }
 
+// LeafBytes returns the encoding of a DividendAccount as a leaf of the account
+// tree, matching abi.encode(user, accumFeeAmount) in the root chain claimFee
+func (da DividendAccount) LeafBytes() []byte {
+	fee, ok := big.NewInt(0).SetString(da.FeeAmount, 10)
+	if !ok {
+		fee = big.NewInt(0)
+	}
+
+	return append(
+		common.LeftPadBytes(common.FromHex(da.User), 32),
+		common.LeftPadBytes(fee.Bytes(), 32)...,
+	)
+}
+
 //CalculateHash hashes the values of a DividendAccount
 func (da DividendAccount) CalculateHash() ([]byte, error) {
+	return crypto.Keccak256(da.LeafBytes()), nil
+}
+
+//Equals tests for equality of two Contents
+func (da DividendAccount) Equals(other merkletree.Content) (bool, error) {
+	return da.User == other.(*DividendAccount).User, nil
+}
+
+// LegacyDividendAccount is a DividendAccount hashed the way the leaves of the account
+// tree were before they matched the root chain claimFee encoding
+type LegacyDividendAccount struct {
+	DividendAccount
+}
+
+// CalculateHash hashes the values of a LegacyDividendAccount
+func (da LegacyDividendAccount) CalculateHash() ([]byte, error) {
 	fee, _ := big.NewInt(0).SetString(da.FeeAmount, 10)
 	divAccountHash := crypto.Keccak256(appendBytes32(
 		[]byte(da.User),
@@ -75,6 +106,11 @@ func (da DividendAccount) CalculateHash() ([]byte, error) {
 	return divAccountHash, nil
 }
 
+// Equals tests for equality of two Contents
+func (da LegacyDividendAccount) Equals(other merkletree.Content) (bool, error) {
+	return da.User == other.(*LegacyDividendAccount).User, nil
+}
+
 func appendBytes32(data ...[]byte) []byte {
 	var result []byte
 	for _, v := range data {
@@ -94,8 +130,3 @@ func convertTo32(input []byte) (output [32]byte, err error) {
 	copy(output[32-l:], input[:])
 	return
 }
-
-//Equals tests for equality of two Contents
-func (da DividendAccount) Equals(other merkletree.Content) (bool, error) {
-	return da.User == other.(*DividendAccount).User, nil
-}
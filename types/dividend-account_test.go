@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/accounts/abi"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// claimFeeLeaf returns the leaf the root chain claimFee checks the proof of,
+// keccak256(abi.encode(user, accumFeeAmount))
+func claimFeeLeaf(t *testing.T, user common.Address, accumFeeAmount *big.Int) []byte {
+	addressTy, err := abi.NewType("address", "", nil)
+	require.NoError(t, err)
+	uintTy, err := abi.NewType("uint256", "", nil)
+	require.NoError(t, err)
+
+	bz, err := abi.Arguments{{Type: addressTy}, {Type: uintTy}}.Pack(user, accumFeeAmount)
+	require.NoError(t, err)
+
+	return crypto.Keccak256(bz)
+}
+
+func TestDividendAccountLeaf(t *testing.T) {
+	user := common.HexToAddress("0x5973918275c01f50555d44e92c9d9b353cadad54")
+	fee, _ := big.NewInt(0).SetString("1000000000000000000", 10)
+	account := types.NewDividendAccount(sdk.AccAddress(user.Bytes()), fee.String())
+
+	require.Equal(t,
+		"0000000000000000000000005973918275c01f50555d44e92c9d9b353cadad54"+
+			"0000000000000000000000000000000000000000000000000de0b6b3a7640000",
+		common.Bytes2Hex(account.LeafBytes()),
+	)
+
+	hash, err := account.CalculateHash()
+	require.NoError(t, err)
+	require.Equal(t, "df0c2004048cebb38b103cf7ef48e19d374d6e8bc5739088f3fcba741bab309d", common.Bytes2Hex(hash))
+	require.Equal(t, claimFeeLeaf(t, user, fee), hash)
+
+	// the legacy leaves don't match the root chain
+	legacyHash, err := types.LegacyDividendAccount{DividendAccount: account}.CalculateHash()
+	require.NoError(t, err)
+	require.NotEqual(t, hash, legacyHash)
+}
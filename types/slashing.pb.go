@@ -0,0 +1,617 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: heimdall/base/v1beta1/slashing.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ValidatorSigningInfo defines the signing info for a validator
+type ValidatorSigningInfo struct {
+	ValID ValidatorID `protobuf:"varint,1,opt,name=val_id,json=valId,proto3,enum=heimdall.types.ValidatorID" json:"valID" yaml:"val_id"`
+	// height at which validator was first a candidate OR was unjailed
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"startHeight" yaml:"start_height"`
+	// index offset into signed block bit array
+	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"indexOffset" yaml:"index_offset"`
+	// missed blocks counter (to avoid scanning the array every time)
+	MissedBlocksCounter int64 `protobuf:"varint,4,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty" yaml:"missed_blocks_counter"`
+}
+
+func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
+func (*ValidatorSigningInfo) ProtoMessage() {}
+func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e346b5b65bc4aa, []int{0}
+}
+func (m *ValidatorSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSigningInfo.Merge(m, src)
+}
+func (m *ValidatorSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSigningInfo proto.InternalMessageInfo
+
+// ValidatorSlashingInfo contains ID, slashed amount and jail status
+type ValidatorSlashingInfo struct {
+	ID            ValidatorID `protobuf:"varint,1,opt,name=ID,proto3,enum=heimdall.types.ValidatorID" json:"ID"`
+	SlashedAmount uint64      `protobuf:"varint,2,opt,name=slashed_amount,json=slashedAmount,proto3" json:"SlashedAmount" yaml:"slashed_amount"`
+	IsJailed      bool        `protobuf:"varint,3,opt,name=is_jailed,json=isJailed,proto3" json:"IsJailed" yaml:"is_jailed"`
+}
+
+func (m *ValidatorSlashingInfo) Reset()      { *m = ValidatorSlashingInfo{} }
+func (*ValidatorSlashingInfo) ProtoMessage() {}
+func (*ValidatorSlashingInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e346b5b65bc4aa, []int{1}
+}
+func (m *ValidatorSlashingInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSlashingInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSlashingInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSlashingInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSlashingInfo.Merge(m, src)
+}
+func (m *ValidatorSlashingInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSlashingInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSlashingInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSlashingInfo proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ValidatorSigningInfo)(nil), "heimdall.types.ValidatorSigningInfo")
+	proto.RegisterType((*ValidatorSlashingInfo)(nil), "heimdall.types.ValidatorSlashingInfo")
+}
+
+func init() {
+	proto.RegisterFile("heimdall/base/v1beta1/slashing.proto", fileDescriptor_89e346b5b65bc4aa)
+}
+
+var fileDescriptor_89e346b5b65bc4aa = []byte{
+	// 490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x6b, 0xdb, 0x40,
+	0x14, 0xc7, 0x25, 0x3b, 0x31, 0xae, 0x5a, 0x9b, 0xa2, 0xc4, 0x60, 0xd2, 0xa2, 0x33, 0x6a, 0x1b,
+	0x42, 0x29, 0x12, 0x69, 0xb6, 0x40, 0xa1, 0x55, 0x34, 0x54, 0x5d, 0x4a, 0x15, 0x48, 0xa1, 0x8b,
+	0x38, 0x59, 0x67, 0xe9, 0x9a, 0x93, 0xce, 0xe8, 0x2e, 0x4e, 0xfd, 0x0d, 0x32, 0x76, 0xec, 0x98,
+	0x8f, 0xd3, 0x31, 0x63, 0xa7, 0xa3, 0xd8, 0x4b, 0xd1, 0xa8, 0x4f, 0x50, 0x7c, 0xb2, 0x63, 0x19,
+	0x3c, 0x64, 0x3b, 0x7e, 0xef, 0x77, 0xff, 0xe3, 0xde, 0x7b, 0xda, 0xcb, 0x04, 0xe1, 0x34, 0x82,
+	0x84, 0xd8, 0x21, 0x64, 0xc8, 0x9e, 0x1c, 0x87, 0x88, 0xc3, 0x63, 0x9b, 0x11, 0xc8, 0x12, 0x9c,
+	0xc5, 0xd6, 0x38, 0xa7, 0x9c, 0xea, 0xdd, 0x95, 0x65, 0xf1, 0xe9, 0x18, 0xb1, 0x83, 0xfd, 0x98,
+	0xc6, 0x54, 0x96, 0xec, 0xc5, 0xa9, 0xb2, 0x0e, 0x5e, 0x6d, 0xcf, 0x9a, 0x40, 0x82, 0x23, 0xc8,
+	0x69, 0x5e, 0x69, 0xe6, 0x4d, 0x53, 0xdb, 0xbf, 0x58, 0xb1, 0x73, 0x1c, 0x67, 0x38, 0x8b, 0xbd,
+	0x6c, 0x44, 0xf5, 0xaf, 0x5a, 0x6b, 0x02, 0x49, 0x80, 0xa3, 0xbe, 0x3a, 0x50, 0x8f, 0xba, 0x6f,
+	0x9f, 0x59, 0x9b, 0xcf, 0x5a, 0xf7, 0xb7, 0x3c, 0xd7, 0x79, 0x31, 0x13, 0x60, 0xf7, 0x02, 0x12,
+	0xcf, 0x2d, 0x04, 0xd8, 0x9d, 0x2c, 0x0e, 0xa5, 0x00, 0x9d, 0x29, 0x4c, 0xc9, 0xa9, 0x59, 0xc5,
+	0x98, 0xbe, 0xe4, 0x91, 0xee, 0x69, 0x4f, 0x18, 0x87, 0x39, 0x0f, 0x12, 0x84, 0xe3, 0x84, 0xf7,
+	0x1b, 0x03, 0xf5, 0xa8, 0xe9, 0x1c, 0x16, 0x02, 0x3c, 0x96, 0xfc, 0xa3, 0xc4, 0xa5, 0x00, 0x7b,
+	0xd5, 0xf5, 0xba, 0x6c, 0xfa, 0x75, 0x67, 0x11, 0x85, 0xb3, 0x08, 0xfd, 0x08, 0xe8, 0x68, 0xc4,
+	0x10, 0xef, 0x37, 0xd7, 0x51, 0x92, 0x7f, 0x96, 0x78, 0x1d, 0x55, 0x97, 0x4d, 0xbf, 0xee, 0xe8,
+	0xd7, 0x5a, 0x2f, 0xc5, 0x8c, 0xa1, 0x28, 0x08, 0x09, 0x1d, 0x5e, 0xb2, 0x60, 0x48, 0xaf, 0x32,
+	0x8e, 0xf2, 0xfe, 0x8e, 0xcc, 0x3c, 0x2b, 0x04, 0x00, 0x5b, 0x85, 0x37, 0x34, 0xc5, 0x1c, 0xa5,
+	0x63, 0x3e, 0x2d, 0x05, 0x78, 0x5e, 0xbd, 0xb3, 0x55, 0x34, 0xfd, 0xbd, 0x8a, 0x3b, 0x12, 0x9f,
+	0x55, 0xf4, 0xb4, 0x7d, 0x73, 0x0b, 0x94, 0x5f, 0xb7, 0x40, 0x31, 0xff, 0xa9, 0x5a, 0x6f, 0x3d,
+	0x8a, 0xe5, 0xcc, 0xe5, 0x2c, 0x4e, 0xb4, 0x86, 0xe7, 0x3e, 0x64, 0x0e, 0xad, 0x42, 0x80, 0x86,
+	0xe7, 0xfa, 0x0d, 0xcf, 0xd5, 0xbf, 0x68, 0x5d, 0xb9, 0x38, 0x28, 0x0a, 0x60, 0xba, 0x78, 0x4c,
+	0x76, 0x7a, 0xc7, 0x79, 0x5d, 0x08, 0xd0, 0x39, 0xaf, 0x2a, 0x1f, 0x64, 0xa1, 0x14, 0xa0, 0xb7,
+	0xec, 0xf5, 0xc6, 0x05, 0xd3, 0xef, 0xb0, 0xba, 0xa7, 0xbf, 0xd3, 0x1e, 0x61, 0x16, 0x7c, 0x87,
+	0x98, 0xa0, 0x48, 0x36, 0xbb, 0xed, 0x0c, 0x0a, 0x01, 0xda, 0x1e, 0xfb, 0x24, 0x59, 0x29, 0xc0,
+	0xd3, 0x65, 0xa7, 0x57, 0x9a, 0xe9, 0xb7, 0xf1, 0xb2, 0xba, 0xfe, 0xaa, 0xf3, 0xfe, 0xf7, 0xcc,
+	0x50, 0xef, 0x66, 0x86, 0xfa, 0x77, 0x66, 0xa8, 0x3f, 0xe7, 0x86, 0x72, 0x37, 0x37, 0x94, 0x3f,
+	0x73, 0x43, 0xf9, 0x76, 0x18, 0x63, 0x9e, 0x5c, 0x85, 0xd6, 0x90, 0xa6, 0x76, 0x0a, 0x39, 0x1e,
+	0x66, 0x88, 0x5f, 0xd3, 0xfc, 0xd2, 0xbe, 0x5f, 0x67, 0xf9, 0xeb, 0xb0, 0x25, 0xd7, 0xf7, 0xe4,
+	0xff, 0x00, 0xd2, 0xf8, 0xf8, 0x28, 0x33, 0x03, 0x00, 0x00,
+}
+
+func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValID != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ValID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSlashingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSlashingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSlashingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsJailed {
+		i--
+		if m.IsJailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SlashedAmount != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SlashedAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValID != 0 {
+		n += 1 + sovSlashing(uint64(m.ValID))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovSlashing(uint64(m.IndexOffset))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	}
+	return n
+}
+
+func (m *ValidatorSlashingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovSlashing(uint64(m.ID))
+	}
+	if m.SlashedAmount != 0 {
+		n += 1 + sovSlashing(uint64(m.SlashedAmount))
+	}
+	if m.IsJailed {
+		n += 2
+	}
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValID", wireType)
+			}
+			m.ValID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValID |= ValidatorID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= ValidatorID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+			}
+			m.SlashedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsJailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsJailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlashing(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlashing
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlashing
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlashing
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlashing        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlashing          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlashing = fmt.Errorf("proto: unexpected end of group")
+)
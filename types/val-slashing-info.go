@@ -7,13 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
-// ValidatorSlashingInfo - contains ID, slashingAmount, isJailed
-type ValidatorSlashingInfo struct {
-	ID            ValidatorID `json:"ID"`
-	SlashedAmount uint64      `json:"SlashedAmount"`
-	IsJailed      bool        `json:"IsJailed"`
-}
-
+// NewValidatorSlashingInfo creates a new ValidatorSlashingInfo instance
 func NewValidatorSlashingInfo(id ValidatorID, slashedAmount uint64, isJailed bool) ValidatorSlashingInfo {
 
 	return ValidatorSlashingInfo{
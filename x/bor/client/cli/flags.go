@@ -8,4 +8,12 @@ const (
 	FlagParamTypes      = "param-type"
 	FlagPage            = "page"
 	FlagLimit           = "limit"
+
+	FlagSeed             = "seed"
+	FlagValidators       = "validators"
+	FlagProducerCount    = "producer-count"
+	FlagSelectionVersion = "selection-version"
+
+	FlagBlock       = "block"
+	FlagValidatorID = "validator-id"
 )
@@ -2,7 +2,10 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -10,6 +13,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/maticnetwork/bor/common"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/bor/keeper"
 	"github.com/maticnetwork/heimdall/x/bor/types"
 	"github.com/spf13/cobra"
 )
@@ -28,10 +34,13 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetQueryParams(),
 		GetQueryParam(),
 		GetQuerySpan(),
+		GetQuerySpanByBlock(),
 		GetQuerySpanList(),
+		GetQueryProducerHistory(),
 		GetQueryLatestSpan(),
 		GetQueryNextSpanSeed(),
 		PrepareNextSpan(),
+		GetSimulateSelection(),
 	)
 	return cmd
 }
@@ -181,6 +190,86 @@ $ %s query bor span --span-id 1
 	return cmd
 }
 
+func GetQuerySpanByBlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "span-by-block",
+		Short: "show the span covering a bor block",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the span info of the span covering the bor block.
+Example:
+$ %s query bor span-by-block --block 6400
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			block, err := cmd.Flags().GetUint64(FlagBlock)
+			if err != nil {
+				return err
+			}
+
+			cliCmd := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(cliCmd, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.SpanByBlock(context.Background(), &types.QuerySpanByBlockRequest{
+				Block: block,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintOutput(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(FlagBlock, 0, "--block=<bor-block-number>")
+	_ = cmd.MarkFlagRequired(FlagBlock)
+	return cmd
+}
+
+func GetQueryProducerHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "producer-history",
+		Short: "show the spans a validator produced blocks in",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the spans the validator was selected as producer of, with its slots in each span.
+Example:
+$ %s query bor producer-history --validator-id 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validatorID, err := cmd.Flags().GetUint64(FlagValidatorID)
+			if err != nil {
+				return err
+			}
+
+			cliCmd := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(cliCmd, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ProducerHistory(context.Background(), &types.QueryProducerHistoryRequest{
+				ValidatorId: validatorID,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintOutput(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--validator-id=<validator-id>")
+	_ = cmd.MarkFlagRequired(FlagValidatorID)
+	return cmd
+}
+
 func GetQueryParam() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "param [param-type]",
@@ -314,3 +403,117 @@ $ %s query bor prepare-next-span --bor-chain-id 1 --start-block 254 --span-id 1
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// simulatedProducer is a producer of the simulated selection with its slots
+type simulatedProducer struct {
+	ID    uint64 `json:"id"`
+	Slots uint64 `json:"slots"`
+}
+
+// GetSimulateSelection runs the producer selection locally with the given seed and validators
+func GetSimulateSelection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-selection",
+		Args:  cobra.NoArgs,
+		Short: "Simulate the producer selection of a span",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Run the producer selection with the seed, the rootchain block hash of the span, and the
+span eligible validators as id:power pairs. The selection runs locally, the selection algorithm
+version active at a span is listed in the selection_algorithms of the bor params.
+
+Example:
+$ %s query bor simulate-selection --seed 0x8f5bab218b6bb34476f51ca588e9f4553a3a7ce5e13a66c660a5283e97e9a85a --validators 1:10000,2:2000,3:30000 --producer-count 2
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			seed, err := cmd.Flags().GetString(FlagSeed)
+			if err != nil {
+				return err
+			}
+
+			validatorsStr, err := cmd.Flags().GetString(FlagValidators)
+			if err != nil {
+				return err
+			}
+
+			producerCount, err := cmd.Flags().GetUint64(FlagProducerCount)
+			if err != nil {
+				return err
+			}
+
+			selectionVersion, err := cmd.Flags().GetUint64(FlagSelectionVersion)
+			if err != nil {
+				return err
+			}
+
+			validators, err := parseSimulatedValidators(validatorsStr)
+			if err != nil {
+				return err
+			}
+
+			producerIds, err := keeper.SelectNextProducers(selectionVersion, common.HexToHash(seed), validators, producerCount)
+			if err != nil {
+				return err
+			}
+
+			slots := make(map[uint64]uint64)
+			for _, id := range producerIds {
+				slots[id]++
+			}
+
+			producers := make([]simulatedProducer, 0, len(slots))
+			for id, count := range slots {
+				producers = append(producers, simulatedProducer{ID: id, Slots: count})
+			}
+			sort.Slice(producers, func(i, j int) bool { return producers[i].ID < producers[j].ID })
+
+			out, err := json.MarshalIndent(struct {
+				SelectionVersion uint64              `json:"selection_version"`
+				ProducerIds      []uint64            `json:"producer_ids"`
+				Producers        []simulatedProducer `json:"producers"`
+			}{selectionVersion, producerIds, producers}, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			return clientCtx.PrintString(string(out) + "\n")
+		},
+	}
+	cmd.Flags().String(FlagSeed, "", "--seed=<rootchain block hash>")
+	cmd.Flags().String(FlagValidators, "", "--validators=<id>:<power>,<id>:<power>")
+	cmd.Flags().Uint64(FlagProducerCount, types.DefaultProducerCount, "--producer-count=4")
+	cmd.Flags().Uint64(FlagSelectionVersion, types.SelectionVersionWeighted, "--selection-version=1")
+
+	_ = cmd.MarkFlagRequired(FlagSeed)
+	_ = cmd.MarkFlagRequired(FlagValidators)
+
+	return cmd
+}
+
+// parseSimulatedValidators parses the validators from comma separated id:power pairs
+func parseSimulatedValidators(validatorsStr string) ([]hmTypes.Validator, error) {
+	var validators []hmTypes.Validator
+	for _, pair := range strings.Split(validatorsStr, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid validator %q, expected id:power", pair)
+		}
+
+		id, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator id %q: %w", parts[0], err)
+		}
+
+		power, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil || power < 0 {
+			return nil, fmt.Errorf("invalid validator power %q", parts[1])
+		}
+
+		validators = append(validators, hmTypes.Validator{ID: hmTypes.NewValidatorID(id), VotingPower: power})
+	}
+
+	return validators, nil
+}
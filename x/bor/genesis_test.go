@@ -45,7 +45,7 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	valSet := chSim.LoadValidatorSet(4, t, initApp.StakingKeeper, ctx, false, 10)
 	initApp.BorKeeper.SetParams(ctx, &params)
 	initApp.CheckpointKeeper.UpdateACKCountWithValue(ctx, 1)
-	producers, _ := initApp.BorKeeper.SelectNextProducers(ctx, hmCommonTypes.ZeroHeimdallHash.EthHash())
+	producers, _ := initApp.BorKeeper.SelectNextProducers(ctx, 1, hmCommonTypes.ZeroHeimdallHash.EthHash())
 	for i := 0; i < spanCount; i++ {
 		start = end + 1
 		end = end + 10
@@ -47,6 +47,8 @@ func (k Querier) Params(context context.Context, req *types.QueryParamsRequest)
 		LatestEthBlock: latestEthBlock.Uint64(),
 		ProducerCount:  getParams.GetProducerCount(),
 		Sprint:         getParams.GetSprintDuration(),
+
+		ReplacementMissedSprints: getParams.GetReplacementMissedSprints(),
 	}, nil
 }
 
@@ -198,7 +200,7 @@ func (k Querier) PrepareNextSpan(context context.Context, req *types.PrepareNext
 	if err != nil {
 		return nil, err
 	}
-	nextProducers, err := k.SelectNextProducers(ctx, nextSpanSeed)
+	nextProducers, err := k.SelectNextProducers(ctx, spanId, nextSpanSeed)
 	if err != nil {
 		return nil, err
 	}
@@ -223,3 +225,33 @@ func (k Querier) PrepareNextSpan(context context.Context, req *types.PrepareNext
 		Span: &newSpan,
 	}, nil
 }
+
+// SpanByBlock returns the span covering the bor block
+func (k Querier) SpanByBlock(goCtx context.Context, req *types.QuerySpanByBlockRequest) (*types.QuerySpanByBlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	span, err := k.GetSpanByBlock(ctx, req.GetBlock())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QuerySpanByBlockResponse{
+		Span: span,
+	}, nil
+}
+
+// ProducerHistory returns the spans the validator was selected as producer of
+func (k Querier) ProducerHistory(goCtx context.Context, req *types.QueryProducerHistoryRequest) (*types.QueryProducerHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	history, err := k.GetProducerHistory(ctx, req.GetValidatorId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryProducerHistoryResponse{
+		Spans: history,
+	}, nil
+}
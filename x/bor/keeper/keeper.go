@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
 
 	checkpointKeeper "github.com/maticnetwork/heimdall/x/checkpoint/keeper"
@@ -14,7 +15,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	hmCommon "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/merr"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -89,6 +92,17 @@ func (k Keeper) SetParams(ctx sdk.Context, params *types.Params) {
 	k.paramSpace.SetParamSet(ctx, params)
 }
 
+// SetMissingParams sets the parameters missing from the store to their defaults,
+// they're missing on chains started before the parameters were added
+func (k Keeper) SetMissingParams(ctx sdk.Context) {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
+
 // GetParams gets the bor module's parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -222,11 +236,74 @@ func (k *Keeper) GetLastSpan(ctx sdk.Context) (*hmTypes.Span, error) {
 	return k.GetSpan(ctx, lastSpanID)
 }
 
+// GetSpanByBlock fetches the span covering the bor block. Replacement spans start
+// before the end of the span they replace, the span with the highest id wins.
+func (k *Keeper) GetSpanByBlock(ctx sdk.Context, block uint64) (*hmTypes.Span, error) {
+	lastSpan, err := k.GetLastSpan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// later spans take over the blocks of the spans they replace, so the
+	// latest span covering the block is looked for first
+	for id := lastSpan.ID; ; id-- {
+		if k.HasSpan(ctx, id) {
+			span, err := k.GetSpan(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if span.StartBlock <= block && block <= span.EndBlock {
+				return span, nil
+			}
+		}
+
+		if id == 0 {
+			return nil, errors.New("span not found for block")
+		}
+	}
+}
+
+// GetProducerHistory fetches the spans the validator was selected as producer of, sorted by span id
+func (k *Keeper) GetProducerHistory(ctx sdk.Context, validatorID uint64) ([]types.ProducerSpan, error) {
+	history := make([]types.ProducerSpan, 0)
+	err := k.IterateSpansAndApplyFn(ctx, func(span hmTypes.Span) error {
+		for _, producer := range span.SelectedProducers {
+			if producer.ID.Uint64() == validatorID {
+				history = append(history, types.ProducerSpan{
+					SpanId:     span.ID,
+					StartBlock: span.StartBlock,
+					EndBlock:   span.EndBlock,
+					Slots:      uint64(producer.VotingPower),
+				})
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(history, func(i, j int) bool { return history[i].SpanId < history[j].SpanId })
+
+	return history, nil
+}
+
 // FreezeSet freezes validator set for next span
 func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlock uint64, borChainID string, seed common.Hash) error {
+	return k.freezeSet(ctx, id, startBlock, endBlock, borChainID, seed, nil)
+}
+
+// FreezeReplacementSet freezes validator set for the replacement span, the offline
+// producers aren't selected again
+func (k *Keeper) FreezeReplacementSet(ctx sdk.Context, id uint64, startBlock uint64, endBlock uint64, borChainID string, seed common.Hash, offlineIDs []uint64) error {
+	return k.freezeSet(ctx, id, startBlock, endBlock, borChainID, seed, offlineIDs)
+}
+
+func (k *Keeper) freezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlock uint64, borChainID string, seed common.Hash, excludedIDs []uint64) error {
 
 	// select next producers
-	newProducers, err := k.SelectNextProducers(ctx, seed)
+	newProducers, err := k.selectNextProducers(ctx, id, seed, excludedIDs)
 	if err != nil {
 		return err
 	}
@@ -255,11 +332,35 @@ func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlo
 	return k.AddNewSpan(ctx, newSpan)
 }
 
-// SelectNextProducers selects producers for next span
-func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash) (vals []hmTypes.Validator, err error) {
+// SelectNextProducers selects producers for the span with the selection algorithm active at the span
+func (k *Keeper) SelectNextProducers(ctx sdk.Context, spanID uint64, seed common.Hash) (vals []hmTypes.Validator, err error) {
+	return k.selectNextProducers(ctx, spanID, seed, nil)
+}
+
+func (k *Keeper) selectNextProducers(ctx sdk.Context, spanID uint64, seed common.Hash, excludedIDs []uint64) (vals []hmTypes.Validator, err error) {
 	// spanEligibleVals are current validators who are not getting deactivated in between next span
 	spanEligibleVals := k.sk.GetSpanEligibleValidators(ctx)
-	producerCount := k.GetParams(ctx).ProducerCount
+	if len(excludedIDs) > 0 {
+		excluded := make(map[uint64]bool)
+		for _, id := range excludedIDs {
+			excluded[id] = true
+		}
+
+		eligibleVals := make([]hmTypes.Validator, 0, len(spanEligibleVals))
+		for _, val := range spanEligibleVals {
+			if !excluded[val.ID.Uint64()] {
+				eligibleVals = append(eligibleVals, val)
+			}
+		}
+
+		if len(eligibleVals) == 0 {
+			return vals, errors.New("no eligible validators left for span")
+		}
+		spanEligibleVals = eligibleVals
+	}
+
+	params := k.GetParams(ctx)
+	producerCount := params.ProducerCount
 
 	// if producers to be selected is more than current validators no need to select/shuffle
 	if len(spanEligibleVals) <= int(producerCount) {
@@ -267,7 +368,7 @@ func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash) (vals []
 	}
 
 	// select next producers using seed as blockheader hash
-	newProducersIds, err := SelectNextProducers(seed, spanEligibleVals, producerCount)
+	newProducersIds, err := SelectNextProducers(params.SelectionVersion(spanID), seed, spanEligibleVals, producerCount)
 	if err != nil {
 		return vals, err
 	}
@@ -288,6 +389,76 @@ func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash) (vals []
 	return vals, nil
 }
 
+// ValidateReplacementSpan checks the replacement span against the spans and the missed
+// sprints evidence, returning the replaced span and the offline producer ids
+func (k *Keeper) ValidateReplacementSpan(ctx sdk.Context, msg types.MsgReplaceSpan) (*hmTypes.Span, []uint64, error) {
+	params := k.GetParams(ctx)
+	if params.ReplacementMissedSprints == 0 {
+		return nil, nil, sdkerrors.Wrap(hmCommon.ErrInvalidReplacement, "span replacement is disabled")
+	}
+
+	lastSpan, err := k.GetLastSpan(ctx)
+	if err != nil {
+		return nil, nil, hmCommon.ErrSpanNotFound
+	}
+	if lastSpan.ID+1 != msg.SpanId {
+		return nil, nil, hmCommon.ErrSpanNotInCountinuity
+	}
+
+	if params.SpanDuration != msg.EndBlock-msg.StartBlock+1 {
+		return nil, nil, hmCommon.ErrInvalidSpanDuration
+	}
+
+	// replacement starts in the middle of the replaced span
+	replacedSpan, err := k.GetSpanByBlock(ctx, msg.StartBlock)
+	if err != nil {
+		return nil, nil, hmCommon.ErrSpanNotFound
+	}
+	if msg.StartBlock == replacedSpan.StartBlock {
+		return nil, nil, sdkerrors.Wrap(hmCommon.ErrInvalidReplacement, "replacement must start after the replaced span start")
+	}
+
+	// later spans starting before the replaced span end replaced it already
+	for id := replacedSpan.ID + 1; id <= lastSpan.ID; id++ {
+		span, err := k.GetSpan(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if span.StartBlock <= replacedSpan.EndBlock {
+			return nil, nil, hmCommon.ErrSpanAlreadyReplaced
+		}
+	}
+
+	producers := make(map[uint64]bool)
+	for _, producer := range replacedSpan.SelectedProducers {
+		producers[producer.ID.Uint64()] = true
+	}
+
+	sprints := make(map[uint64]bool)
+	for _, sprint := range msg.MissedSprints {
+		if sprint.Block < replacedSpan.StartBlock || sprint.Block >= msg.StartBlock ||
+			(sprint.Block-replacedSpan.StartBlock)%params.SprintDuration != 0 {
+			return nil, nil, sdkerrors.Wrapf(hmCommon.ErrInvalidReplacement, "invalid missed sprint block %d", sprint.Block)
+		}
+
+		if sprints[sprint.Block] {
+			return nil, nil, sdkerrors.Wrapf(hmCommon.ErrInvalidReplacement, "duplicate missed sprint block %d", sprint.Block)
+		}
+		sprints[sprint.Block] = true
+
+		if !producers[sprint.ProducerId] {
+			return nil, nil, sdkerrors.Wrapf(hmCommon.ErrInvalidReplacement, "validator %d is not a producer of span %d", sprint.ProducerId, replacedSpan.ID)
+		}
+	}
+
+	offlineIDs := types.OfflineProducers(msg.MissedSprints, params.ReplacementMissedSprints)
+	if len(offlineIDs) == 0 {
+		return nil, nil, sdkerrors.Wrap(hmCommon.ErrInvalidReplacement, "no producer missed enough sprints")
+	}
+
+	return replacedSpan, offlineIDs, nil
+}
+
 // UpdateLastSpan updates the last span start block
 func (k *Keeper) UpdateLastSpan(ctx sdk.Context, id uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -1,12 +1,14 @@
 package keeper_test
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	hmCommon "github.com/maticnetwork/heimdall/common"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/x/bor/keeper"
 	borTypes "github.com/maticnetwork/heimdall/x/bor/types"
@@ -121,7 +123,7 @@ func (suite *KeeperTestSuite) TestSelectNextProducers() {
 		for _, v := range vals {
 			c.spanEligibleVals = append(c.spanEligibleVals, *v)
 		}
-		out, err := keeper.SelectNextProducers(c.hash, c.spanEligibleVals, c.producerCount)
+		out, err := keeper.SelectNextProducers(borTypes.SelectionVersionWeighted, c.hash, c.spanEligibleVals, c.producerCount)
 		if c.expOut {
 			suite.NotNil(out, c.msg)
 		} else {
@@ -169,7 +171,7 @@ func (suite *KeeperTestSuite) TestBorKeeperSelectNextProducers() {
 		cVals := initApp.StakingKeeper.GetValidatorSet(ctx)
 		initApp.BorKeeper.SetParams(ctx, &borTypes.Params{SprintDuration: 1, SpanDuration: 1, ProducerCount: c.producerCount})
 		cMsg := fmt.Sprintf("i: %v, msg: %v", i, c.msg)
-		out, err := initApp.BorKeeper.SelectNextProducers(ctx, c.seed)
+		out, err := initApp.BorKeeper.SelectNextProducers(ctx, 1, c.seed)
 
 		// pVals is used to check if validators are being modified during execution
 		pVals := initApp.StakingKeeper.GetValidatorSet(ctx)
@@ -188,6 +190,159 @@ func (suite *KeeperTestSuite) TestBorKeeperSelectNextProducers() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSelectNextProducersVersion() {
+	initApp, ctx := suite.app, suite.ctx
+
+	simulation.LoadValidatorSet(10, suite.T(), initApp.StakingKeeper, ctx, false, 0)
+	initApp.CheckpointKeeper.UpdateACKCountWithValue(ctx, 1)
+	params := borTypes.Params{
+		SprintDuration: 1,
+		SpanDuration:   1,
+		ProducerCount:  4,
+		SelectionAlgorithms: []borTypes.SelectionAlgorithm{
+			{Version: borTypes.SelectionVersionWeightedWithoutReplacement, StartSpanId: 5},
+		},
+	}
+	initApp.BorKeeper.SetParams(ctx, &params)
+	seed := common.HexToHash("testSeed")
+
+	// spans before the start span use the weighted selection
+	out, err := initApp.BorKeeper.SelectNextProducers(ctx, 4, seed)
+	suite.NoError(err)
+	slots := int64(0)
+	for _, v := range out {
+		slots += v.VotingPower
+	}
+	suite.Equal(int64(4), slots)
+
+	// producers are distinct from the start span on
+	out, err = initApp.BorKeeper.SelectNextProducers(ctx, 5, seed)
+	suite.NoError(err)
+	suite.Len(out, 4)
+	for _, v := range out {
+		suite.Equal(int64(1), v.VotingPower)
+	}
+}
+
+func (suite *KeeperTestSuite) TestReplaceSpan() {
+	initApp, ctx := suite.app, suite.ctx
+
+	vals := simulation.LoadValidatorSet(4, suite.T(), initApp.StakingKeeper, ctx, false, 0).Validators
+	initApp.CheckpointKeeper.UpdateACKCountWithValue(ctx, 1)
+	params := borTypes.DefaultParams()
+	params.SprintDuration = 4
+	params.SpanDuration = 16
+	params.ProducerCount = 2
+	params.ReplacementMissedSprints = 2
+	initApp.BorKeeper.SetParams(ctx, &params)
+
+	producers := []hmTypes.Validator{*vals[0], *vals[1]}
+	suite.NoError(initApp.BorKeeper.AddNewSpan(ctx, hmTypes.Span{ID: 0, StartBlock: 0, EndBlock: 15, SelectedProducers: producers}))
+
+	offlineID := vals[0].ID.Uint64()
+	replaceMsg := func(spanID, startBlock uint64, missedSprints ...borTypes.MissedSprint) borTypes.MsgReplaceSpan {
+		return borTypes.NewMsgReplaceSpan(spanID, "", startBlock, startBlock+15, "15001", "", missedSprints)
+	}
+
+	tc := []struct {
+		msg     string
+		replace borTypes.MsgReplaceSpan
+		expErr  error
+	}{
+		{
+			msg:     "not enough missed sprints",
+			replace: replaceMsg(1, 12, borTypes.MissedSprint{Block: 0, ProducerId: offlineID}),
+			expErr:  hmCommon.ErrInvalidReplacement,
+		},
+		{
+			msg:     "missed sprint not at a sprint start",
+			replace: replaceMsg(1, 12, borTypes.MissedSprint{Block: 0, ProducerId: offlineID}, borTypes.MissedSprint{Block: 5, ProducerId: offlineID}),
+			expErr:  hmCommon.ErrInvalidReplacement,
+		},
+		{
+			msg:     "missed sprint of a validator not producing",
+			replace: replaceMsg(1, 12, borTypes.MissedSprint{Block: 0, ProducerId: vals[2].ID.Uint64()}, borTypes.MissedSprint{Block: 4, ProducerId: vals[2].ID.Uint64()}),
+			expErr:  hmCommon.ErrInvalidReplacement,
+		},
+		{
+			msg:     "span not in continuity",
+			replace: replaceMsg(2, 12, borTypes.MissedSprint{Block: 0, ProducerId: offlineID}, borTypes.MissedSprint{Block: 4, ProducerId: offlineID}),
+			expErr:  hmCommon.ErrSpanNotInCountinuity,
+		},
+	}
+
+	for i, c := range tc {
+		_, _, err := initApp.BorKeeper.ValidateReplacementSpan(ctx, c.replace)
+		suite.True(errors.Is(err, c.expErr), fmt.Sprintf("i: %v, msg: %v, err: %v", i, c.msg, err))
+	}
+
+	replace := replaceMsg(1, 12, borTypes.MissedSprint{Block: 0, ProducerId: offlineID}, borTypes.MissedSprint{Block: 4, ProducerId: offlineID})
+	replacedSpan, offlineIDs, err := initApp.BorKeeper.ValidateReplacementSpan(ctx, replace)
+	suite.NoError(err)
+	suite.Equal(uint64(0), replacedSpan.ID)
+	suite.Equal([]uint64{offlineID}, offlineIDs)
+
+	// offline producers aren't selected for the replacement span
+	err = initApp.BorKeeper.FreezeReplacementSet(ctx, replace.SpanId, replace.StartBlock, replace.EndBlock, replace.BorChainId, common.HexToHash("testSeed"), offlineIDs)
+	suite.NoError(err)
+	span, err := initApp.BorKeeper.GetSpan(ctx, 1)
+	suite.NoError(err)
+	suite.NotEmpty(span.SelectedProducers)
+	for _, producer := range span.SelectedProducers {
+		suite.NotEqual(offlineID, producer.ID.Uint64())
+	}
+
+	// the replacement span covers the blocks from its start
+	span, err = initApp.BorKeeper.GetSpanByBlock(ctx, 11)
+	suite.NoError(err)
+	suite.Equal(uint64(0), span.ID)
+	span, err = initApp.BorKeeper.GetSpanByBlock(ctx, 12)
+	suite.NoError(err)
+	suite.Equal(uint64(1), span.ID)
+
+	// replaced span can't be replaced again
+	_, _, err = initApp.BorKeeper.ValidateReplacementSpan(ctx, replaceMsg(2, 8, borTypes.MissedSprint{Block: 0, ProducerId: offlineID}, borTypes.MissedSprint{Block: 4, ProducerId: offlineID}))
+	suite.True(errors.Is(err, hmCommon.ErrSpanAlreadyReplaced))
+
+	// replacements are disabled without missed sprints threshold
+	params.ReplacementMissedSprints = 0
+	initApp.BorKeeper.SetParams(ctx, &params)
+	_, _, err = initApp.BorKeeper.ValidateReplacementSpan(ctx, replaceMsg(2, 20, borTypes.MissedSprint{Block: 12, ProducerId: offlineID}))
+	suite.True(errors.Is(err, hmCommon.ErrInvalidReplacement))
+}
+
+func (suite *KeeperTestSuite) TestGetProducerHistory() {
+	initApp, ctx := suite.app, suite.ctx
+
+	producer := hmTypes.Validator{ID: hmTypes.NewValidatorID(7), VotingPower: 3}
+	other := hmTypes.Validator{ID: hmTypes.NewValidatorID(8), VotingPower: 1}
+	for id := uint64(0); id < 12; id++ {
+		producers := []hmTypes.Validator{other}
+		if id%5 == 0 {
+			producers = append(producers, producer)
+		}
+		suite.NoError(initApp.BorKeeper.AddNewSpan(ctx, hmTypes.Span{ID: id, StartBlock: id * 10, EndBlock: id*10 + 9, SelectedProducers: producers}))
+	}
+
+	history, err := initApp.BorKeeper.GetProducerHistory(ctx, 7)
+	suite.NoError(err)
+	suite.Equal([]borTypes.ProducerSpan{
+		{SpanId: 0, StartBlock: 0, EndBlock: 9, Slots: 3},
+		{SpanId: 5, StartBlock: 50, EndBlock: 59, Slots: 3},
+		{SpanId: 10, StartBlock: 100, EndBlock: 109, Slots: 3},
+	}, history)
+
+	history, err = initApp.BorKeeper.GetProducerHistory(ctx, 9)
+	suite.NoError(err)
+	suite.Empty(history)
+
+	span, err := initApp.BorKeeper.GetSpanByBlock(ctx, 105)
+	suite.NoError(err)
+	suite.Equal(uint64(10), span.ID)
+	_, err = initApp.BorKeeper.GetSpanByBlock(ctx, 120)
+	suite.Error(err)
+}
+
 func (suite *KeeperTestSuite) TestGetAllSpans() {
 	initApp, ctx := suite.app, suite.ctx
 
@@ -85,3 +85,45 @@ func (m msgServer) PostSendProposeSpanTx(goCtx context.Context, msg *types.MsgPr
 
 	return &types.MsgProposeSpanResponse{}, nil
 }
+
+func (m msgServer) PostSendReplaceSpanTx(goCtx context.Context, msg *types.MsgReplaceSpan) (*types.MsgReplaceSpanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	m.Keeper.Logger(ctx).Debug("✅ Validating replace span msg",
+		"spanId", msg.SpanId,
+		"startBlock", msg.StartBlock,
+		"endBlock", msg.EndBlock,
+		"seed", msg.Seed,
+		"missedSprints", len(msg.MissedSprints),
+	)
+
+	// chainManager params
+	params := m.Keeper.chainKeeper.GetParams(ctx)
+	chainParams := params.ChainParams
+
+	// check chain id
+	if chainParams.BorChainID != msg.BorChainId {
+		m.Keeper.Logger(ctx).Error("Invalid Bor chain id", "msgChainID", msg.BorChainId)
+		return nil, hmCommon.ErrInvalidBorChainID
+	}
+
+	replacedSpan, offlineIDs, err := m.Keeper.ValidateReplacementSpan(ctx, *msg)
+	if err != nil {
+		m.Keeper.Logger(ctx).Error("Invalid replacement span", "spanId", msg.SpanId, "error", err)
+		return nil, err
+	}
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReplaceSpan,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySpanID, strconv.FormatUint(msg.SpanId, 10)),
+			sdk.NewAttribute(types.AttributeKeySpanStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeySpanEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyReplacedSpanID, strconv.FormatUint(replacedSpan.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyOfflineIDs, types.FormatProducerIDs(offlineIDs)),
+		),
+	})
+
+	return &types.MsgReplaceSpanResponse{}, nil
+}
@@ -2,14 +2,51 @@ package keeper
 
 import (
 	"encoding/binary"
+	"fmt"
 	"math"
 	"math/rand"
 
 	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/crypto"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/bor/types"
 )
 
+// randomSource is the deterministic PRNG producers are drawn with
+type randomSource interface {
+	Uint64() uint64
+}
+
+// newWeightedSource returns the PRNG of the weighted selection, a math/rand
+// source of its own seeded with the first 8 bytes of the seed. It draws the
+// same values as the process-global source once seeded, keeping the outcomes of
+// past spans, without being perturbed by other users of math/rand.
+func newWeightedSource(seed common.Hash) randomSource {
+	seedBytes := helper.ToBytes32(seed.Bytes()[:32])
+	return rand.New(rand.NewSource(int64(binary.BigEndian.Uint64(seedBytes[:]))))
+}
+
+// hashSource is a PRNG returning the first 8 bytes of keccak256(seed, counter),
+// counter being the number of values drawn before. It doesn't depend on the
+// math/rand implementation and uses the whole seed.
+type hashSource struct {
+	seed    common.Hash
+	counter uint64
+}
+
+func newHashSource(seed common.Hash) randomSource {
+	return &hashSource{seed: seed}
+}
+
+func (s *hashSource) Uint64() uint64 {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], s.counter)
+	s.counter++
+
+	return binary.BigEndian.Uint64(crypto.Keccak256(s.seed.Bytes(), counter[:])[:8])
+}
+
 func binarySearch(array []uint64, search uint64) int {
 	if len(array) == 0 {
 		return -1
@@ -27,26 +64,27 @@ func binarySearch(array []uint64, search uint64) int {
 	return l
 }
 
-// randomRangeInclusive produces unbiased pseudo random in the range [min, max]. Uses rand.Uint64() and can be seeded beforehand.
-func randomRangeInclusive(min uint64, max uint64) uint64 {
+// randomRangeInclusive produces unbiased pseudo random in the range [min, max] from the source
+func randomRangeInclusive(source randomSource, min uint64, max uint64) uint64 {
 	if max <= min {
 		return max
 	}
 
 	rangeLength := max - min + 1
 	maxAllowedValue := math.MaxUint64 - math.MaxUint64%rangeLength - 1
-	randomValue := rand.Uint64()
+	randomValue := source.Uint64()
 
 	// reject anything that is beyond the reminder to avoid bias
 	for randomValue >= maxAllowedValue {
-		randomValue = rand.Uint64()
+		randomValue = source.Uint64()
 	}
 
 	return min + randomValue%rangeLength
 }
 
-// SelectNextProducers selects producers for next span by converting power to tickets
-func SelectNextProducers(blkHash common.Hash, spanEligibleValidators []hmTypes.Validator, producerCount uint64) ([]uint64, error) {
+// SelectNextProducers selects producers for next span with the selection algorithm of
+// the version, using the seed as source of randomness
+func SelectNextProducers(version uint64, blkHash common.Hash, spanEligibleValidators []hmTypes.Validator, producerCount uint64) ([]uint64, error) {
 	selectedProducers := make([]uint64, 0)
 
 	if len(spanEligibleValidators) <= int(producerCount) {
@@ -57,32 +95,88 @@ func SelectNextProducers(blkHash common.Hash, spanEligibleValidators []hmTypes.V
 		return selectedProducers, nil
 	}
 
-	// extract seed from hash
-	seedBytes := helper.ToBytes32(blkHash.Bytes()[:32])
-	seed := int64(binary.BigEndian.Uint64(seedBytes[:]))
-	rand.Seed(seed)
-
 	// weighted range from validators' voting power
 	votingPower := make([]uint64, len(spanEligibleValidators))
 	for idx, validator := range spanEligibleValidators {
 		votingPower[idx] = uint64(validator.VotingPower)
 	}
 
-	weightedRanges, totalVotingPower := createWeightedRanges(votingPower)
-	// select producers, with replacement
-	for i := uint64(0); i < producerCount; i++ {
+	var indexes []int
+	switch version {
+	case types.SelectionVersionWeighted:
+		indexes = selectWeighted(newWeightedSource(blkHash), votingPower, producerCount)
+	case types.SelectionVersionWeightedWithoutReplacement:
+		indexes = selectWeightedWithoutReplacement(newHashSource(blkHash), votingPower, producerCount)
+	case types.SelectionVersionStakeCapped:
+		indexes = selectWeighted(newHashSource(blkHash), capWeights(votingPower, producerCount), producerCount)
+	default:
+		return nil, fmt.Errorf("unknown producer selection version %d", version)
+	}
+
+	for _, index := range indexes {
+		selectedProducers = append(selectedProducers, spanEligibleValidators[index].ID.Uint64())
+	}
+
+	return selectedProducers, nil
+}
+
+// selectWeighted selects count indexes weighted by their weight, with replacement
+func selectWeighted(source randomSource, weights []uint64, count uint64) []int {
+	weightedRanges, totalWeight := createWeightedRanges(weights)
+
+	indexes := make([]int, 0, count)
+	for i := uint64(0); i < count; i++ {
 		/*
-			random must be in [1, totalVotingPower] to avoid situation such as
+			random must be in [1, totalWeight] to avoid situation such as
 			2 validators with 1 staking power each.
 			Weighted range will look like (1, 2)
 			Rolling inclusive will have a range of 0 - 2, making validator with staking power 1 chance of selection = 66%
 		*/
-		targetWeight := randomRangeInclusive(1, totalVotingPower)
-		index := binarySearch(weightedRanges, targetWeight)
-		selectedProducers = append(selectedProducers, spanEligibleValidators[index].ID.Uint64())
+		targetWeight := randomRangeInclusive(source, 1, totalWeight)
+		indexes = append(indexes, binarySearch(weightedRanges, targetWeight))
+	}
+
+	return indexes
+}
+
+// selectWeightedWithoutReplacement selects count distinct indexes weighted by their
+// weight, indexes without weight are never selected
+func selectWeightedWithoutReplacement(source randomSource, weights []uint64, count uint64) []int {
+	remaining := append([]uint64{}, weights...)
+
+	indexes := make([]int, 0, count)
+	for i := uint64(0); i < count; i++ {
+		weightedRanges, totalWeight := createWeightedRanges(remaining)
+		if totalWeight == 0 {
+			break
+		}
+
+		index := binarySearch(weightedRanges, randomRangeInclusive(source, 1, totalWeight))
+		indexes = append(indexes, index)
+		remaining[index] = 0
+	}
+
+	return indexes
+}
+
+// capWeights caps the weights to the total weight divided by the count, rounded up
+func capWeights(weights []uint64, count uint64) []uint64 {
+	if count == 0 {
+		return weights
+	}
+
+	_, totalWeight := createWeightedRanges(weights)
+	maxWeight := (totalWeight + count - 1) / count
+
+	capped := make([]uint64, len(weights))
+	for i, weight := range weights {
+		capped[i] = weight
+		if weight > maxWeight {
+			capped[i] = maxWeight
+		}
 	}
 
-	return selectedProducers[:producerCount], nil
+	return capped
 }
 
 // createWeightedRanges converts array [1, 2, 3] into cumulative form [1, 3, 6]
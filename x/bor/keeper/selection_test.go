@@ -3,11 +3,13 @@ package keeper
 import (
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"reflect"
 	"testing"
 
 	"github.com/maticnetwork/bor/common"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/bor/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -89,7 +91,7 @@ func TestSelectNextProducers(t *testing.T) {
 
 	for i, testcase := range testcases {
 		seed := common.HexToHash(testcase.seed)
-		producerIds, err := SelectNextProducers(seed, validators, testcase.producerCount)
+		producerIds, err := SelectNextProducers(types.SelectionVersionWeighted, seed, validators, testcase.producerCount)
 		fmt.Println("producerIds", producerIds)
 		require.NoError(t, err, "Error should be nil")
 		producers, slots := getSelectedValidatorsFromIDs(validators, producerIds)
@@ -98,6 +100,72 @@ func TestSelectNextProducers(t *testing.T) {
 	}
 }
 
+func TestSelectNextProducersGolden(t *testing.T) {
+	// validators with powers 10000, 2000, 500, 30000, 1, 7000, 7000 and ids from 1
+	var validators []hmTypes.Validator
+	for i, power := range []int64{10000, 2000, 500, 30000, 1, 7000, 7000} {
+		validators = append(validators, hmTypes.Validator{ID: hmTypes.NewValidatorID(uint64(i + 1)), VotingPower: power})
+	}
+
+	const (
+		seed1 = "0x8f5bab218b6bb34476f51ca588e9f4553a3a7ce5e13a66c660a5283e97e9a85a"
+		seed2 = "0xe09cc356df20c7a2dd38cb85b680a16ec29bd8b3e1ecc1b20f2e5603d5e7ee85"
+		seed3 = "0x00000000000000000000000000000000000000000000000000000000000000ff"
+	)
+
+	// outcomes of the weighted selection are the ones of the selection seeding the global math/rand
+	testcases := []struct {
+		version       uint64
+		seed          string
+		producerCount uint64
+		producerIds   []uint64
+	}{
+		{types.SelectionVersionWeighted, seed1, 4, []uint64{4, 4, 1, 1}},
+		{types.SelectionVersionWeighted, seed1, 6, []uint64{4, 4, 1, 1, 1, 6}},
+		{types.SelectionVersionWeighted, seed2, 4, []uint64{1, 4, 7, 4}},
+		{types.SelectionVersionWeighted, seed2, 6, []uint64{1, 4, 7, 4, 7, 4}},
+		{types.SelectionVersionWeighted, seed3, 4, []uint64{4, 4, 4, 4}},
+		{types.SelectionVersionWeighted, seed3, 6, []uint64{4, 4, 4, 4, 7, 6}},
+		{types.SelectionVersionWeightedWithoutReplacement, seed1, 4, []uint64{4, 1, 7, 3}},
+		{types.SelectionVersionWeightedWithoutReplacement, seed1, 6, []uint64{4, 1, 7, 3, 6, 2}},
+		{types.SelectionVersionWeightedWithoutReplacement, seed2, 4, []uint64{4, 6, 1, 7}},
+		{types.SelectionVersionWeightedWithoutReplacement, seed2, 6, []uint64{4, 6, 1, 7, 2, 3}},
+		{types.SelectionVersionWeightedWithoutReplacement, seed3, 4, []uint64{4, 6, 1, 3}},
+		{types.SelectionVersionWeightedWithoutReplacement, seed3, 6, []uint64{4, 6, 1, 3, 7, 2}},
+		{types.SelectionVersionStakeCapped, seed1, 4, []uint64{4, 2, 7, 4}},
+		{types.SelectionVersionStakeCapped, seed1, 6, []uint64{6, 1, 4, 1, 1, 7}},
+		{types.SelectionVersionStakeCapped, seed2, 4, []uint64{1, 4, 1, 6}},
+		{types.SelectionVersionStakeCapped, seed2, 6, []uint64{6, 2, 6, 7, 1, 4}},
+		{types.SelectionVersionStakeCapped, seed3, 4, []uint64{4, 1, 7, 1}},
+		{types.SelectionVersionStakeCapped, seed3, 6, []uint64{6, 1, 4, 7, 1, 7}},
+	}
+
+	for i, testcase := range testcases {
+		// global math/rand doesn't affect the selection
+		rand.Seed(int64(i))
+
+		producerIds, err := SelectNextProducers(testcase.version, common.HexToHash(testcase.seed), validators, testcase.producerCount)
+		require.NoError(t, err)
+		require.Equal(t, testcase.producerIds, producerIds, "Testcase %v", i+1)
+	}
+
+	_, err := SelectNextProducers(0, common.HexToHash(seed1), validators, 4)
+	require.Error(t, err, "Unknown version should fail")
+}
+
+func Test_selectWeightedWithoutReplacement(t *testing.T) {
+	source := newHashSource(common.HexToHash("0x01"))
+
+	// validators without power aren't selected
+	indexes := selectWeightedWithoutReplacement(source, []uint64{5, 0, 1, 0}, 4)
+	require.ElementsMatch(t, []int{0, 2}, indexes)
+}
+
+func Test_capWeights(t *testing.T) {
+	require.Equal(t, []uint64{10, 10, 5, 1}, capWeights([]uint64{30, 10, 5, 1}, 5))
+	require.Equal(t, []uint64{1, 2}, capWeights([]uint64{1, 2}, 1))
+}
+
 func getSelectedValidatorsFromIDs(validators []hmTypes.Validator, producerIds []uint64) ([]hmTypes.Validator, int64) {
 	var vals []hmTypes.Validator
 	IDToPower := make(map[uint64]uint64)
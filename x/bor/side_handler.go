@@ -3,6 +3,7 @@ package bor
 import (
 	"bytes"
 	"fmt"
+	"math/big"
 	"strconv"
 
 	tmTypes "github.com/tendermint/tendermint/types"
@@ -28,6 +29,8 @@ func NewSideTxHandler(k keeper.Keeper, contractCaller helper.IContractCaller) hm
 		switch msg := msg.(type) {
 		case *types.MsgProposeSpan:
 			return SideHandleMsgSpan(ctx, k, *msg, contractCaller)
+		case *types.MsgReplaceSpan:
+			return SideHandleMsgReplaceSpan(ctx, k, *msg, contractCaller)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			fmt.Println(errMsg)
@@ -45,6 +48,8 @@ func NewPostTxHandler(k keeper.Keeper) hmTypes.PostTxHandler {
 		switch msg := msg.(type) {
 		case *types.MsgProposeSpan:
 			return PostHandleMsgEventSpan(ctx, k, *msg, sideTxResult)
+		case *types.MsgReplaceSpan:
+			return PostHandleMsgReplaceSpan(ctx, k, *msg, sideTxResult)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -151,3 +156,131 @@ func PostHandleMsgEventSpan(ctx sdk.Context, k keeper.Keeper, msg types.MsgPropo
 		Events: ctx.EventManager().ABCIEvents(),
 	}, nil
 }
+
+// SideHandleMsgReplaceSpan validates the missed sprints of the replacement span against the bor headers
+func SideHandleMsgReplaceSpan(ctx sdk.Context, k keeper.Keeper, msg types.MsgReplaceSpan, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for replace span msg",
+		"msgSeed", msg.Seed,
+		"missedSprints", len(msg.MissedSprints),
+	)
+	// calculate next span seed locally
+	nextSpanSeed, err := k.GetNextSpanSeed(ctx, contractCaller)
+	if err != nil {
+		k.Logger(ctx).Error("Error fetching next span seed from mainchain")
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	// check if span seed matches or not.
+	if !bytes.Equal(common.HexToHeimdallHash(msg.Seed).Bytes(), nextSpanSeed.Bytes()) {
+		k.Logger(ctx).Error(
+			"Span Seed does not match",
+			"msgSeed", msg.Seed,
+			"mainchainSeed", nextSpanSeed.String(),
+		)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	replacedSpan, _, err := k.ValidateReplacementSpan(ctx, msg)
+	if err != nil {
+		k.Logger(ctx).Error("Invalid replacement span", "error", err)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidReplacement)
+	}
+
+	// fetch current child block
+	childBlock, err := contractCaller.GetMaticChainBlock(nil)
+	if err != nil {
+		k.Logger(ctx).Error("Error fetching current child block", "error", err)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	// replacement can't start at a block bor produced already
+	if currentBlock := childBlock.Number.Uint64(); currentBlock >= msg.StartBlock {
+		k.Logger(ctx).Error(
+			"Replacement span starts in the past",
+			"currentChildBlock", currentBlock,
+			"msgStartBlock", msg.StartBlock,
+		)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidReplacement)
+	}
+
+	// the producer in turn must have missed the first block of each sprint
+	for _, sprint := range msg.MissedSprints {
+		header, err := contractCaller.GetMaticChainBlock(new(big.Int).SetUint64(sprint.Block))
+		if err != nil {
+			k.Logger(ctx).Error("Error fetching child block", "block", sprint.Block, "error", err)
+			return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+		}
+
+		producerID, signed, err := types.InTurnProducer(replacedSpan, header)
+		if err != nil || signed || producerID != sprint.ProducerId {
+			k.Logger(ctx).Error(
+				"Missed sprint does not match child block",
+				"block", sprint.Block,
+				"msgProducerId", sprint.ProducerId,
+				"producerId", producerID,
+				"signed", signed,
+				"error", err,
+			)
+			return hmCommon.ErrorSideTx(hmCommon.ErrInvalidReplacement)
+		}
+	}
+
+	k.Logger(ctx).Debug("✅ Successfully validated External call for replace span msg")
+	result.Result = tmprototypes.SideTxResultType_YES
+	return
+}
+
+// PostHandleMsgReplaceSpan handles state persisting replace span msg
+func PostHandleMsgReplaceSpan(ctx sdk.Context, k keeper.Keeper, msg types.MsgReplaceSpan, sideTxResult tmprototypes.SideTxResultType) (*sdk.Result, error) {
+	// Skip handler if span is not approved
+	if sideTxResult != tmprototypes.SideTxResultType_YES {
+		k.Logger(ctx).Debug("Skipping replace span since side-tx didn't get yes votes")
+		return nil, hmCommon.ErrSideTxValidation
+	}
+
+	// check for replay
+	if found := k.HasSpan(ctx, msg.SpanId); found {
+		k.Logger(ctx).Debug("Skipping replacement span as it's already processed")
+		return nil, hmCommon.ErrOldTx
+	}
+
+	replacedSpan, offlineIDs, err := k.ValidateReplacementSpan(ctx, msg)
+	if err != nil {
+		k.Logger(ctx).Error("Invalid replacement span", "error", err)
+		return nil, err
+	}
+
+	k.Logger(ctx).Debug("Persisting replacement span state", "sideTxResult", sideTxResult, "replacedSpanId", replacedSpan.ID, "offlineProducerIds", offlineIDs)
+
+	// freeze for replacement span, without the offline producers
+	err = k.FreezeReplacementSet(ctx, msg.SpanId, msg.StartBlock, msg.EndBlock, msg.BorChainId, common.HexToHeimdallHash(msg.Seed).EthHash(), offlineIDs)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to freeze validator set for replacement span", "Error", err)
+		return nil, hmCommon.ErrUnableToFreezeValSet
+	}
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReplaceSpan,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                 // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),               // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, common.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),            // result
+			sdk.NewAttribute(types.AttributeKeySpanID, strconv.FormatUint(msg.SpanId, 10)),
+			sdk.NewAttribute(types.AttributeKeySpanStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeySpanEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyReplacedSpanID, strconv.FormatUint(replacedSpan.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyOfflineIDs, types.FormatProducerIDs(offlineIDs)),
+		),
+	})
+
+	// draft result with events
+	return &sdk.Result{
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
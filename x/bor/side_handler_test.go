@@ -1,6 +1,7 @@
 package bor_test
 
 import (
+	"crypto/ecdsa"
 	"encoding/json"
 	"math/big"
 	"math/rand"
@@ -16,6 +17,8 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/bor/rlp"
 
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	"github.com/maticnetwork/heimdall/x/bor"
@@ -227,6 +230,124 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgProposeSpan() {
 	}
 }
 
+// signBorHeader seals the bor header with the key
+func signBorHeader(t *testing.T, header *ethTypes.Header, key *ecdsa.PrivateKey) *ethTypes.Header {
+	header.Extra = make([]byte, 32+65)
+	sealBytes, err := rlp.EncodeToBytes([]interface{}{
+		header.ParentHash,
+		header.UncleHash,
+		header.Coinbase,
+		header.Root,
+		header.TxHash,
+		header.ReceiptHash,
+		header.Bloom,
+		header.Difficulty,
+		header.Number,
+		header.GasLimit,
+		header.GasUsed,
+		header.Time,
+		header.Extra[:32],
+		header.MixDigest,
+		header.Nonce,
+	})
+	require.NoError(t, err)
+
+	sig, err := crypto.Sign(crypto.Keccak256(sealBytes), key)
+	require.NoError(t, err)
+	copy(header.Extra[32:], sig)
+	return header
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgReplaceSpan() {
+	t, ctx := suite.T(), suite.ctx
+	var bi *big.Int
+
+	params := borTypes.DefaultParams()
+	params.SprintDuration = 4
+	params.SpanDuration = 16
+	params.ProducerCount = 2
+	params.ReplacementMissedSprints = 2
+	suite.app.BorKeeper.SetParams(ctx, &params)
+
+	keys := make(map[uint64]*ecdsa.PrivateKey)
+	var producers []hmTypes.Validator
+	for id := uint64(1); id <= 2; id++ {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[id] = key
+		producers = append(producers, hmTypes.Validator{
+			ID:          hmTypes.NewValidatorID(id),
+			VotingPower: 1,
+			Signer:      crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		})
+	}
+	producers = hmTypes.SortValidatorByAddress(producers)
+	require.NoError(t, suite.app.BorKeeper.AddNewSpan(ctx, hmTypes.Span{ID: 0, StartBlock: 0, EndBlock: 15, SelectedProducers: producers, BorChainId: "15001"}))
+
+	// with two producers, the first one is in turn when the second one signs with difficulty 1
+	offline, online := producers[0].ID.Uint64(), producers[1].ID.Uint64()
+	missedHeader := func(number int64) *ethTypes.Header {
+		return signBorHeader(t, &ethTypes.Header{Number: big.NewInt(number), Difficulty: big.NewInt(1)}, keys[online])
+	}
+	inTurnHeader := func(number int64) *ethTypes.Header {
+		return signBorHeader(t, &ethTypes.Header{Number: big.NewInt(number), Difficulty: big.NewInt(2)}, keys[offline])
+	}
+
+	seedHeader := &ethTypes.Header{}
+	msg := borTypes.NewMsgReplaceSpan(1, "", 12, 27, "15001", seedHeader.Hash().String(), []borTypes.MissedSprint{
+		{Block: 0, ProducerId: offline},
+		{Block: 4, ProducerId: offline},
+	})
+
+	tc := []struct {
+		msg    string
+		cm     []callerMethod
+		result tmprototypes.SideTxResultType
+		code   uint32
+	}{
+		{
+			msg: "success",
+			cm: []callerMethod{
+				{name: "GetMaticChainBlock", args: []interface{}{bi}, ret: []interface{}{&ethTypes.Header{Number: big.NewInt(8)}, nil}},
+				{name: "GetMaticChainBlock", args: []interface{}{big.NewInt(0)}, ret: []interface{}{missedHeader(0), nil}},
+				{name: "GetMaticChainBlock", args: []interface{}{big.NewInt(4)}, ret: []interface{}{missedHeader(4), nil}},
+			},
+			result: tmprototypes.SideTxResultType_YES,
+		},
+		{
+			msg: "sprint signed by the producer in turn",
+			cm: []callerMethod{
+				{name: "GetMaticChainBlock", args: []interface{}{bi}, ret: []interface{}{&ethTypes.Header{Number: big.NewInt(8)}, nil}},
+				{name: "GetMaticChainBlock", args: []interface{}{big.NewInt(0)}, ret: []interface{}{missedHeader(0), nil}},
+				{name: "GetMaticChainBlock", args: []interface{}{big.NewInt(4)}, ret: []interface{}{inTurnHeader(4), nil}},
+			},
+			result: tmprototypes.SideTxResultType_SKIP,
+			code:   hmCommon.ErrInvalidReplacement.ABCICode(),
+		},
+		{
+			msg: "replacement starting at a produced block",
+			cm: []callerMethod{
+				{name: "GetMaticChainBlock", args: []interface{}{bi}, ret: []interface{}{&ethTypes.Header{Number: big.NewInt(12)}, nil}},
+			},
+			result: tmprototypes.SideTxResultType_SKIP,
+			code:   hmCommon.ErrInvalidReplacement.ABCICode(),
+		},
+	}
+
+	for _, c := range tc {
+		suite.contractCaller = mocks.IContractCaller{}
+		suite.sideHandler = bor.NewSideTxHandler(suite.app.BorKeeper, &suite.contractCaller)
+		suite.contractCaller.On("GetMainChainBlock", big.NewInt(1)).Return(seedHeader, nil)
+		for _, m := range c.cm {
+			suite.contractCaller.On(m.name, m.args...).Return(m.ret...)
+		}
+
+		result := suite.sideHandler(ctx, &msg)
+		require.Equal(t, c.code, result.Code, c.msg)
+		require.Equal(t, c.result, result.Result, c.msg)
+	}
+}
+
 // NewPostTxHandler
 
 func (suite *SideHandlerTestSuite) TestPostTxHandler() {
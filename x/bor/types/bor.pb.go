@@ -27,6 +27,12 @@ type Params struct {
 	SprintDuration uint64 `protobuf:"varint,1,opt,name=sprint_duration,json=sprintDuration,proto3" json:"sprint_duration" yaml:"sprint_duration"`
 	SpanDuration   uint64 `protobuf:"varint,2,opt,name=span_duration,json=spanDuration,proto3" json:"span_duration" yaml:"span_duration"`
 	ProducerCount  uint64 `protobuf:"varint,3,opt,name=producer_count,json=producerCount,proto3" json:"producer_count" yaml:"producer_count"`
+	// selection_algorithms activates producer selection algorithms from a span on,
+	// spans before the first one use the weighted selection
+	SelectionAlgorithms []SelectionAlgorithm `protobuf:"bytes,4,rep,name=selection_algorithms,json=selectionAlgorithms,proto3" json:"selection_algorithms" yaml:"selection_algorithms"`
+	// replacement_missed_sprints is the number of sprints a producer of the current
+	// span must miss before a replacement span can be proposed, 0 disables replacements
+	ReplacementMissedSprints uint64 `protobuf:"varint,5,opt,name=replacement_missed_sprints,json=replacementMissedSprints,proto3" json:"replacement_missed_sprints" yaml:"replacement_missed_sprints"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -83,32 +89,245 @@ func (m *Params) GetProducerCount() uint64 {
 	return 0
 }
 
+func (m *Params) GetSelectionAlgorithms() []SelectionAlgorithm {
+	if m != nil {
+		return m.SelectionAlgorithms
+	}
+	return nil
+}
+
+func (m *Params) GetReplacementMissedSprints() uint64 {
+	if m != nil {
+		return m.ReplacementMissedSprints
+	}
+	return 0
+}
+
+// SelectionAlgorithm is the producer selection algorithm version used from a span on
+type SelectionAlgorithm struct {
+	Version     uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version" yaml:"version"`
+	StartSpanId uint64 `protobuf:"varint,2,opt,name=start_span_id,json=startSpanId,proto3" json:"start_span_id" yaml:"start_span_id"`
+}
+
+func (m *SelectionAlgorithm) Reset()         { *m = SelectionAlgorithm{} }
+func (m *SelectionAlgorithm) String() string { return proto.CompactTextString(m) }
+func (*SelectionAlgorithm) ProtoMessage()    {}
+func (*SelectionAlgorithm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_955064f0a1ce7923, []int{1}
+}
+func (m *SelectionAlgorithm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SelectionAlgorithm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SelectionAlgorithm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SelectionAlgorithm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectionAlgorithm.Merge(m, src)
+}
+func (m *SelectionAlgorithm) XXX_Size() int {
+	return m.Size()
+}
+func (m *SelectionAlgorithm) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectionAlgorithm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectionAlgorithm proto.InternalMessageInfo
+
+func (m *SelectionAlgorithm) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SelectionAlgorithm) GetStartSpanId() uint64 {
+	if m != nil {
+		return m.StartSpanId
+	}
+	return 0
+}
+
+// MissedSprint is a sprint of a span whose first block wasn't signed by the producer in turn
+type MissedSprint struct {
+	Block      uint64 `protobuf:"varint,1,opt,name=block,proto3" json:"block" yaml:"block"`
+	ProducerId uint64 `protobuf:"varint,2,opt,name=producer_id,json=producerId,proto3" json:"producer_id" yaml:"producer_id"`
+}
+
+func (m *MissedSprint) Reset()         { *m = MissedSprint{} }
+func (m *MissedSprint) String() string { return proto.CompactTextString(m) }
+func (*MissedSprint) ProtoMessage()    {}
+func (*MissedSprint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_955064f0a1ce7923, []int{2}
+}
+func (m *MissedSprint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MissedSprint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MissedSprint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MissedSprint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissedSprint.Merge(m, src)
+}
+func (m *MissedSprint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MissedSprint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissedSprint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissedSprint proto.InternalMessageInfo
+
+func (m *MissedSprint) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *MissedSprint) GetProducerId() uint64 {
+	if m != nil {
+		return m.ProducerId
+	}
+	return 0
+}
+
+// ProducerSpan is a span a validator was selected as producer of
+type ProducerSpan struct {
+	SpanId     uint64 `protobuf:"varint,1,opt,name=span_id,json=spanId,proto3" json:"span_id" yaml:"span_id"`
+	StartBlock uint64 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block" yaml:"start_block"`
+	EndBlock   uint64 `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block" yaml:"end_block"`
+	Slots      uint64 `protobuf:"varint,4,opt,name=slots,proto3" json:"slots" yaml:"slots"`
+}
+
+func (m *ProducerSpan) Reset()         { *m = ProducerSpan{} }
+func (m *ProducerSpan) String() string { return proto.CompactTextString(m) }
+func (*ProducerSpan) ProtoMessage()    {}
+func (*ProducerSpan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_955064f0a1ce7923, []int{3}
+}
+func (m *ProducerSpan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProducerSpan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProducerSpan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProducerSpan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProducerSpan.Merge(m, src)
+}
+func (m *ProducerSpan) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProducerSpan) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProducerSpan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProducerSpan proto.InternalMessageInfo
+
+func (m *ProducerSpan) GetSpanId() uint64 {
+	if m != nil {
+		return m.SpanId
+	}
+	return 0
+}
+
+func (m *ProducerSpan) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *ProducerSpan) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+func (m *ProducerSpan) GetSlots() uint64 {
+	if m != nil {
+		return m.Slots
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "heimdall.bor.v1beta1.Params")
+	proto.RegisterType((*SelectionAlgorithm)(nil), "heimdall.bor.v1beta1.SelectionAlgorithm")
+	proto.RegisterType((*MissedSprint)(nil), "heimdall.bor.v1beta1.MissedSprint")
+	proto.RegisterType((*ProducerSpan)(nil), "heimdall.bor.v1beta1.ProducerSpan")
 }
 
 func init() { proto.RegisterFile("heimdall/bor/v1beta1/bor.proto", fileDescriptor_955064f0a1ce7923) }
 
 var fileDescriptor_955064f0a1ce7923 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x48, 0xcd, 0xcc,
-	0x4d, 0x49, 0xcc, 0xc9, 0xd1, 0x4f, 0xca, 0x2f, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0x04, 0xb1, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0x60, 0xf2, 0x7a, 0x20, 0x31, 0xa8,
-	0xbc, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0x81, 0x3e, 0x88, 0x05, 0x51, 0xab, 0xd4, 0xc2,
-	0xc4, 0xc5, 0x16, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0x14, 0xc6, 0xc5, 0x5f, 0x5c, 0x50, 0x94,
-	0x99, 0x57, 0x12, 0x9f, 0x52, 0x5a, 0x94, 0x58, 0x92, 0x99, 0x9f, 0x27, 0xc1, 0xa8, 0xc0, 0xa8,
-	0xc1, 0xe2, 0xa4, 0xfb, 0xea, 0x9e, 0x3c, 0xba, 0xd4, 0xa7, 0x7b, 0xf2, 0x62, 0x95, 0x89, 0xb9,
-	0x39, 0x56, 0x4a, 0x68, 0x12, 0x4a, 0x41, 0x7c, 0x10, 0x11, 0x17, 0xa8, 0x80, 0x90, 0x1f, 0x17,
-	0x6f, 0x71, 0x41, 0x62, 0x1e, 0xc2, 0x54, 0x26, 0xb0, 0xa9, 0x9a, 0xaf, 0xee, 0xc9, 0xa3, 0x4a,
-	0x7c, 0xba, 0x27, 0x2f, 0x02, 0x33, 0x13, 0x49, 0x58, 0x29, 0x88, 0x07, 0xc4, 0x87, 0x9b, 0x17,
-	0xc4, 0xc5, 0x57, 0x50, 0x94, 0x9f, 0x52, 0x9a, 0x9c, 0x5a, 0x14, 0x9f, 0x9c, 0x5f, 0x9a, 0x57,
-	0x22, 0xc1, 0x0c, 0x36, 0x50, 0xfb, 0xd5, 0x3d, 0x79, 0x34, 0x99, 0x4f, 0xf7, 0xe4, 0x45, 0x21,
-	0x26, 0xa2, 0x8a, 0x2b, 0x05, 0xf1, 0xc2, 0x04, 0x9c, 0x41, 0x7c, 0x27, 0xb7, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0xd5, 0xcf, 0x4d, 0x2c, 0xc9, 0x4c, 0xce, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6,
-	0x87, 0x47, 0x42, 0x05, 0x38, 0x1a, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xa1, 0x6a,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x05, 0xb2, 0x09, 0x0a, 0xa3, 0x01, 0x00, 0x00,
+	// 610 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xbf, 0x6f, 0xd4, 0x30,
+	0x14, 0xc7, 0x2f, 0xf4, 0x17, 0xb8, 0xd7, 0x03, 0x85, 0x03, 0x1d, 0x45, 0xc4, 0xad, 0x25, 0xa4,
+	0x22, 0xe0, 0xa2, 0x82, 0x04, 0x12, 0x48, 0x48, 0x5c, 0x51, 0xa5, 0x0e, 0x45, 0x95, 0x2b, 0x31,
+	0xb0, 0x9c, 0x7c, 0x89, 0x75, 0x8d, 0x9a, 0xc4, 0x91, 0xed, 0x2b, 0x74, 0x64, 0x62, 0x65, 0x64,
+	0x60, 0xe3, 0x9f, 0xe9, 0xd8, 0x91, 0xc9, 0x42, 0xed, 0x96, 0x31, 0xff, 0x00, 0x28, 0x76, 0x72,
+	0x71, 0xaf, 0x85, 0x2d, 0xef, 0xf3, 0xb5, 0xbf, 0x7e, 0xef, 0xe5, 0xd9, 0xc0, 0x3b, 0xa0, 0x51,
+	0x12, 0x92, 0x38, 0xf6, 0x47, 0x8c, 0xfb, 0x47, 0x9b, 0x23, 0x2a, 0xc9, 0x66, 0xf9, 0xdd, 0xcf,
+	0x38, 0x93, 0xcc, 0xed, 0xd6, 0x7a, 0xbf, 0x64, 0x95, 0xbe, 0xda, 0x1d, 0xb3, 0x31, 0xd3, 0x0b,
+	0xfc, 0xf2, 0xcb, 0xac, 0x45, 0x3f, 0xe7, 0xc1, 0xe2, 0x1e, 0xe1, 0x24, 0x11, 0xee, 0x07, 0x70,
+	0x53, 0x64, 0x3c, 0x4a, 0xe5, 0x30, 0x9c, 0x70, 0x22, 0x23, 0x96, 0xf6, 0x9c, 0x35, 0x67, 0x63,
+	0x7e, 0xf0, 0x34, 0x57, 0x70, 0x56, 0x2a, 0x14, 0xbc, 0x7b, 0x4c, 0x92, 0xf8, 0x15, 0x9a, 0x11,
+	0x10, 0xee, 0x18, 0xf2, 0xae, 0x02, 0xee, 0x7b, 0xb0, 0x22, 0x32, 0x92, 0x36, 0xae, 0xd7, 0xb4,
+	0xeb, 0xa3, 0x5c, 0xc1, 0x8b, 0x42, 0xa1, 0x60, 0xb7, 0xf6, 0xb4, 0x30, 0xc2, 0xed, 0x32, 0x9e,
+	0xfa, 0x61, 0xd0, 0xc9, 0x38, 0x0b, 0x27, 0x01, 0xe5, 0xc3, 0x80, 0x4d, 0x52, 0xd9, 0x9b, 0xd3,
+	0x86, 0x8f, 0x73, 0x05, 0x67, 0x94, 0x42, 0xc1, 0x3b, 0xc6, 0xf1, 0x22, 0x47, 0x78, 0xa5, 0x06,
+	0x5b, 0x65, 0xec, 0x7e, 0x77, 0x40, 0x57, 0xd0, 0x98, 0x06, 0xe5, 0x09, 0x43, 0x12, 0x8f, 0x19,
+	0x8f, 0xe4, 0x41, 0x22, 0x7a, 0xf3, 0x6b, 0x73, 0x1b, 0xcb, 0xcf, 0x36, 0xfa, 0x57, 0xb5, 0xb4,
+	0xbf, 0x5f, 0xef, 0x78, 0x5b, 0x6f, 0x18, 0xbc, 0x3e, 0x51, 0xb0, 0x95, 0x2b, 0x78, 0xa5, 0x5b,
+	0xa1, 0xe0, 0xfd, 0xaa, 0xc0, 0x2b, 0x54, 0x84, 0x6f, 0x8b, 0x4b, 0x86, 0xc2, 0xfd, 0xe2, 0x80,
+	0x55, 0x4e, 0xb3, 0x98, 0x04, 0x34, 0xa1, 0xa9, 0x1c, 0x26, 0x91, 0x10, 0x34, 0x1c, 0x9a, 0x26,
+	0x8b, 0xde, 0x82, 0xae, 0x7d, 0x2b, 0x57, 0xf0, 0x3f, 0xab, 0x0a, 0x05, 0xd7, 0xcd, 0xc1, 0xff,
+	0x5e, 0x83, 0x70, 0xcf, 0x12, 0x77, 0xb5, 0xb6, 0x5f, 0x49, 0x3f, 0x1c, 0xe0, 0x5e, 0x2e, 0xd6,
+	0x7d, 0x09, 0x96, 0x8e, 0x28, 0x17, 0xcd, 0xa4, 0x3c, 0xc8, 0x15, 0xac, 0x51, 0xa1, 0x60, 0xc7,
+	0x9c, 0x59, 0x01, 0x84, 0x6b, 0xc9, 0xdd, 0x05, 0x2b, 0x42, 0x12, 0x2e, 0x87, 0xfa, 0x47, 0x47,
+	0xe1, 0x85, 0x91, 0xb0, 0x05, 0x6b, 0x24, 0x6c, 0x8c, 0xf0, 0xb2, 0x8e, 0xf7, 0x33, 0x92, 0xee,
+	0x84, 0xe8, 0xab, 0x03, 0xda, 0x76, 0xc2, 0xae, 0x0f, 0x16, 0x46, 0x31, 0x0b, 0x0e, 0xab, 0xb4,
+	0xee, 0xe5, 0x0a, 0x1a, 0x50, 0x28, 0xd8, 0x36, 0x7e, 0x3a, 0x44, 0xd8, 0x60, 0x77, 0x1b, 0x2c,
+	0x4f, 0x27, 0x64, 0x9a, 0xce, 0xc3, 0x5c, 0x41, 0x1b, 0x17, 0x0a, 0xba, 0x33, 0xd3, 0x54, 0xa6,
+	0x02, 0xea, 0x68, 0x27, 0x44, 0x7f, 0x1c, 0xd0, 0xde, 0xab, 0xc2, 0x32, 0x39, 0xf7, 0x05, 0x58,
+	0xaa, 0x6b, 0xb4, 0x5a, 0xd4, 0x54, 0xd7, 0xb1, 0x06, 0xbe, 0x34, 0x5b, 0x14, 0xba, 0xa4, 0x32,
+	0x21, 0x53, 0xb1, 0xa9, 0xc3, 0x4a, 0xc8, 0xc2, 0x4d, 0x42, 0x16, 0x44, 0x18, 0xe8, 0x68, 0xa0,
+	0x0b, 0x7b, 0x03, 0x6e, 0xd0, 0x34, 0xac, 0x5c, 0xcc, 0x3d, 0x59, 0xcf, 0x15, 0x6c, 0x60, 0xa1,
+	0xe0, 0x2d, 0xe3, 0x31, 0x45, 0x08, 0x5f, 0xa7, 0x69, 0x68, 0xf6, 0xfb, 0x60, 0x41, 0xc4, 0x4c,
+	0x96, 0x17, 0x61, 0xda, 0x49, 0x0d, 0x9a, 0x4e, 0xea, 0x10, 0x61, 0x83, 0x07, 0xdb, 0x27, 0x67,
+	0x9e, 0x73, 0x7a, 0xe6, 0x39, 0xbf, 0xcf, 0x3c, 0xe7, 0xdb, 0xb9, 0xd7, 0x3a, 0x3d, 0xf7, 0x5a,
+	0xbf, 0xce, 0xbd, 0xd6, 0xc7, 0x27, 0xe3, 0x48, 0x1e, 0x4c, 0x46, 0xfd, 0x80, 0x25, 0x7e, 0x42,
+	0x64, 0x14, 0xa4, 0x54, 0x7e, 0x62, 0xfc, 0xd0, 0x9f, 0x3e, 0x67, 0x9f, 0xf5, 0x83, 0x26, 0x8f,
+	0x33, 0x2a, 0x46, 0x8b, 0xfa, 0x7d, 0x7a, 0xfe, 0x77, 0x00, 0x00, 0x81, 0x3a, 0xd8, 0xed, 0x04,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -131,6 +350,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReplacementMissedSprints != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.ReplacementMissedSprints))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SelectionAlgorithms) > 0 {
+		for iNdEx := len(m.SelectionAlgorithms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SelectionAlgorithms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.ProducerCount != 0 {
 		i = encodeVarintBor(dAtA, i, uint64(m.ProducerCount))
 		i--
@@ -149,6 +387,115 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SelectionAlgorithm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SelectionAlgorithm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SelectionAlgorithm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartSpanId != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.StartSpanId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MissedSprint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MissedSprint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MissedSprint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProducerId != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.ProducerId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Block != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProducerSpan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProducerSpan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProducerSpan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Slots != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.Slots))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndBlock != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpanId != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.SpanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBor(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBor(v)
 	base := offset
@@ -175,6 +522,66 @@ func (m *Params) Size() (n int) {
 	if m.ProducerCount != 0 {
 		n += 1 + sovBor(uint64(m.ProducerCount))
 	}
+	if len(m.SelectionAlgorithms) > 0 {
+		for _, e := range m.SelectionAlgorithms {
+			l = e.Size()
+			n += 1 + l + sovBor(uint64(l))
+		}
+	}
+	if m.ReplacementMissedSprints != 0 {
+		n += 1 + sovBor(uint64(m.ReplacementMissedSprints))
+	}
+	return n
+}
+
+func (m *SelectionAlgorithm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovBor(uint64(m.Version))
+	}
+	if m.StartSpanId != 0 {
+		n += 1 + sovBor(uint64(m.StartSpanId))
+	}
+	return n
+}
+
+func (m *MissedSprint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != 0 {
+		n += 1 + sovBor(uint64(m.Block))
+	}
+	if m.ProducerId != 0 {
+		n += 1 + sovBor(uint64(m.ProducerId))
+	}
+	return n
+}
+
+func (m *ProducerSpan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpanId != 0 {
+		n += 1 + sovBor(uint64(m.SpanId))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovBor(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovBor(uint64(m.EndBlock))
+	}
+	if m.Slots != 0 {
+		n += 1 + sovBor(uint64(m.Slots))
+	}
 	return n
 }
 
@@ -270,6 +677,370 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectionAlgorithms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelectionAlgorithms = append(m.SelectionAlgorithms, SelectionAlgorithm{})
+			if err := m.SelectionAlgorithms[len(m.SelectionAlgorithms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacementMissedSprints", wireType)
+			}
+			m.ReplacementMissedSprints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplacementMissedSprints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBor
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SelectionAlgorithm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SelectionAlgorithm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SelectionAlgorithm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSpanId", wireType)
+			}
+			m.StartSpanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSpanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBor
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MissedSprint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MissedSprint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MissedSprint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerId", wireType)
+			}
+			m.ProducerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProducerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBor
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProducerSpan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProducerSpan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProducerSpan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+			}
+			m.SpanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
+			}
+			m.Slots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slots |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBor(dAtA[iNdEx:])
@@ -3,11 +3,14 @@ package types
 // staking module event types
 const (
 	EventTypeProposeSpan = "propose-span"
+	EventTypeReplaceSpan = "replace-span"
 
 	AttributeKeySuccess        = "success"
 	AttributeKeySpanID         = "span-id"
 	AttributeKeySpanStartBlock = "start-block"
 	AttributeKeySpanEndBlock   = "end-block"
+	AttributeKeyReplacedSpanID = "replaced-span-id"
+	AttributeKeyOfflineIDs     = "offline-producer-ids"
 
 	AttributeValueCategory = ModuleName
 )
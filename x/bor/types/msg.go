@@ -61,3 +61,59 @@ func (m *MsgProposeSpan) GetSigners() []sdk.AccAddress {
 func (m MsgProposeSpan) GetSideSignBytes() []byte {
 	return nil
 }
+
+var _ sdk.Msg = &MsgReplaceSpan{}
+
+// NewMsgReplaceSpan creates new replace span message
+func NewMsgReplaceSpan(
+	spanId uint64,
+	proposer string,
+	startBlock uint64,
+	endBlock uint64,
+	borChainId string,
+	seed string,
+	missedSprints []MissedSprint,
+) MsgReplaceSpan {
+	return MsgReplaceSpan{
+		SpanId:        spanId,
+		Proposer:      proposer,
+		StartBlock:    startBlock,
+		EndBlock:      endBlock,
+		BorChainId:    borChainId,
+		Seed:          seed,
+		MissedSprints: missedSprints,
+	}
+}
+
+func (m MsgReplaceSpan) Route() string {
+	return RouterKey
+}
+
+func (m MsgReplaceSpan) Type() string {
+	return "replace-span"
+}
+
+func (m MsgReplaceSpan) ValidateBasic() error {
+	if len(m.Proposer) == 0 || len(m.MissedSprints) == 0 || m.EndBlock < m.StartBlock {
+		return common.ErrInvalidMsg
+	}
+	return nil
+}
+
+func (m MsgReplaceSpan) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (m *MsgReplaceSpan) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromHex(m.Proposer)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSideSignBytes returns side sign bytes
+func (m MsgReplaceSpan) GetSideSignBytes() []byte {
+	return nil
+}
@@ -12,6 +12,20 @@ const (
 	DefaultSpanDuration             = 100 * DefaultSprintDuration
 	DefaultFirstSpanDuration uint64 = 256
 	DefaultProducerCount     uint64 = 4
+
+	// span replacement is disabled until enabled by governance
+	DefaultReplacementMissedSprints uint64 = 0
+)
+
+// Producer selection algorithm versions
+const (
+	// SelectionVersionWeighted selects producers weighted by power, with replacement
+	SelectionVersionWeighted uint64 = 1
+	// SelectionVersionWeightedWithoutReplacement selects distinct producers weighted by power
+	SelectionVersionWeightedWithoutReplacement uint64 = 2
+	// SelectionVersionStakeCapped selects producers weighted by power, with replacement,
+	// capping the power of each validator to its share of the producer count
+	SelectionVersionStakeCapped uint64 = 3
 )
 
 // Parameter keys
@@ -19,6 +33,10 @@ var (
 	KeySprintDuration = []byte("SprintDuration")
 	KeySpanDuration   = []byte("SpanDuration")
 	KeyProducerCount  = []byte("ProducerCount")
+
+	KeySelectionAlgorithms = []byte("SelectionAlgorithms")
+
+	KeyReplacementMissedSprints = []byte("ReplacementMissedSprints")
 )
 
 // DefaultParams returns a default set of parameters.
@@ -27,6 +45,8 @@ func DefaultParams() Params {
 		SprintDuration: DefaultSprintDuration,
 		SpanDuration:   DefaultSpanDuration,
 		ProducerCount:  DefaultProducerCount,
+
+		ReplacementMissedSprints: DefaultReplacementMissedSprints,
 	}
 }
 
@@ -40,6 +60,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySprintDuration, &p.SprintDuration, validateSprintDuration),
 		paramtypes.NewParamSetPair(KeySpanDuration, &p.SpanDuration, validateSpanDuration),
 		paramtypes.NewParamSetPair(KeyProducerCount, &p.ProducerCount, validateProducerCount),
+		paramtypes.NewParamSetPair(KeySelectionAlgorithms, &p.SelectionAlgorithms, validateSelectionAlgorithms),
+		paramtypes.NewParamSetPair(KeyReplacementMissedSprints, &p.ReplacementMissedSprints, validateReplacementMissedSprints),
 	}
 }
 
@@ -57,7 +79,24 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return nil
+	if err := validateSelectionAlgorithms(p.SelectionAlgorithms); err != nil {
+		return err
+	}
+
+	return validateReplacementMissedSprints(p.ReplacementMissedSprints)
+}
+
+// SelectionVersion returns the producer selection algorithm version of the span
+func (p Params) SelectionVersion(spanID uint64) uint64 {
+	version := SelectionVersionWeighted
+	startSpanID := uint64(0)
+	for _, algorithm := range p.SelectionAlgorithms {
+		if algorithm.StartSpanId <= spanID && algorithm.StartSpanId >= startSpanID {
+			version, startSpanID = algorithm.Version, algorithm.StartSpanId
+		}
+	}
+
+	return version
 }
 
 func validateSprintDuration(i interface{}) error {
@@ -98,3 +137,32 @@ func validateProducerCount(i interface{}) error {
 
 	return nil
 }
+
+func validateSelectionAlgorithms(i interface{}) error {
+	v, ok := i.([]SelectionAlgorithm)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	startSpanIDs := make(map[uint64]bool)
+	for _, algorithm := range v {
+		if algorithm.Version < SelectionVersionWeighted || algorithm.Version > SelectionVersionStakeCapped {
+			return fmt.Errorf("invalid selection algorithm version: %d", algorithm.Version)
+		}
+
+		if startSpanIDs[algorithm.StartSpanId] {
+			return fmt.Errorf("duplicate selection algorithm for start span %d", algorithm.StartSpanId)
+		}
+		startSpanIDs[algorithm.StartSpanId] = true
+	}
+
+	return nil
+}
+
+func validateReplacementMissedSprints(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -68,10 +68,11 @@ func (m *QueryParamsRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
 type QueryParamsResponse struct {
-	SpanDuration   uint64 `protobuf:"varint,1,opt,name=span_duration,json=spanDuration,proto3" json:"span_duration,omitempty"`
-	LatestEthBlock uint64 `protobuf:"varint,2,opt,name=latest_eth_block,json=latestEthBlock,proto3" json:"latest_eth_block,omitempty"`
-	ProducerCount  uint64 `protobuf:"varint,3,opt,name=producer_count,json=producerCount,proto3" json:"producer_count,omitempty"`
-	Sprint         uint64 `protobuf:"varint,4,opt,name=sprint,proto3" json:"sprint,omitempty"`
+	SpanDuration             uint64 `protobuf:"varint,1,opt,name=span_duration,json=spanDuration,proto3" json:"span_duration,omitempty"`
+	LatestEthBlock           uint64 `protobuf:"varint,2,opt,name=latest_eth_block,json=latestEthBlock,proto3" json:"latest_eth_block,omitempty"`
+	ProducerCount            uint64 `protobuf:"varint,3,opt,name=producer_count,json=producerCount,proto3" json:"producer_count,omitempty"`
+	Sprint                   uint64 `protobuf:"varint,4,opt,name=sprint,proto3" json:"sprint,omitempty"`
+	ReplacementMissedSprints uint64 `protobuf:"varint,5,opt,name=replacement_missed_sprints,json=replacementMissedSprints,proto3" json:"replacement_missed_sprints,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -135,6 +136,13 @@ func (m *QueryParamsResponse) GetSprint() uint64 {
 	return 0
 }
 
+func (m *QueryParamsResponse) GetReplacementMissedSprints() uint64 {
+	if m != nil {
+		return m.ReplacementMissedSprints
+	}
+	return 0
+}
+
 // get param info
 type QueryParamRequest struct {
 	ParamsType string `protobuf:"bytes,1,opt,name=params_type,json=paramsType,proto3" json:"params_type,omitempty"`
@@ -744,6 +752,184 @@ func (m *QueryNextSpanSeedResponse) GetNextSpanSeed() string {
 	return ""
 }
 
+// QuerySpanByBlock
+type QuerySpanByBlockRequest struct {
+	Block uint64 `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (m *QuerySpanByBlockRequest) Reset()         { *m = QuerySpanByBlockRequest{} }
+func (m *QuerySpanByBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpanByBlockRequest) ProtoMessage()    {}
+func (*QuerySpanByBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8643ca7cfaca281, []int{14}
+}
+func (m *QuerySpanByBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpanByBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpanByBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpanByBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpanByBlockRequest.Merge(m, src)
+}
+func (m *QuerySpanByBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpanByBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpanByBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpanByBlockRequest proto.InternalMessageInfo
+
+func (m *QuerySpanByBlockRequest) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+type QuerySpanByBlockResponse struct {
+	Span *types.Span `protobuf:"bytes,1,opt,name=Span,proto3" json:"Span,omitempty"`
+}
+
+func (m *QuerySpanByBlockResponse) Reset()         { *m = QuerySpanByBlockResponse{} }
+func (m *QuerySpanByBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpanByBlockResponse) ProtoMessage()    {}
+func (*QuerySpanByBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8643ca7cfaca281, []int{15}
+}
+func (m *QuerySpanByBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpanByBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpanByBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpanByBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpanByBlockResponse.Merge(m, src)
+}
+func (m *QuerySpanByBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpanByBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpanByBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpanByBlockResponse proto.InternalMessageInfo
+
+func (m *QuerySpanByBlockResponse) GetSpan() *types.Span {
+	if m != nil {
+		return m.Span
+	}
+	return nil
+}
+
+// QueryProducerHistory
+type QueryProducerHistoryRequest struct {
+	ValidatorId uint64 `protobuf:"varint,1,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`
+}
+
+func (m *QueryProducerHistoryRequest) Reset()         { *m = QueryProducerHistoryRequest{} }
+func (m *QueryProducerHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProducerHistoryRequest) ProtoMessage()    {}
+func (*QueryProducerHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8643ca7cfaca281, []int{16}
+}
+func (m *QueryProducerHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProducerHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProducerHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProducerHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProducerHistoryRequest.Merge(m, src)
+}
+func (m *QueryProducerHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProducerHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProducerHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProducerHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryProducerHistoryRequest) GetValidatorId() uint64 {
+	if m != nil {
+		return m.ValidatorId
+	}
+	return 0
+}
+
+type QueryProducerHistoryResponse struct {
+	Spans []ProducerSpan `protobuf:"bytes,1,rep,name=spans,proto3" json:"spans"`
+}
+
+func (m *QueryProducerHistoryResponse) Reset()         { *m = QueryProducerHistoryResponse{} }
+func (m *QueryProducerHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProducerHistoryResponse) ProtoMessage()    {}
+func (*QueryProducerHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8643ca7cfaca281, []int{17}
+}
+func (m *QueryProducerHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProducerHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProducerHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProducerHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProducerHistoryResponse.Merge(m, src)
+}
+func (m *QueryProducerHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProducerHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProducerHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProducerHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryProducerHistoryResponse) GetSpans() []ProducerSpan {
+	if m != nil {
+		return m.Spans
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "heimdall.bor.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "heimdall.bor.v1beta1.QueryParamsResponse")
@@ -759,67 +945,83 @@ func init() {
 	proto.RegisterType((*PrepareNextSpanResponse)(nil), "heimdall.bor.v1beta1.PrepareNextSpanResponse")
 	proto.RegisterType((*QueryNextSpanSeedRequest)(nil), "heimdall.bor.v1beta1.QueryNextSpanSeedRequest")
 	proto.RegisterType((*QueryNextSpanSeedResponse)(nil), "heimdall.bor.v1beta1.QueryNextSpanSeedResponse")
+	proto.RegisterType((*QuerySpanByBlockRequest)(nil), "heimdall.bor.v1beta1.QuerySpanByBlockRequest")
+	proto.RegisterType((*QuerySpanByBlockResponse)(nil), "heimdall.bor.v1beta1.QuerySpanByBlockResponse")
+	proto.RegisterType((*QueryProducerHistoryRequest)(nil), "heimdall.bor.v1beta1.QueryProducerHistoryRequest")
+	proto.RegisterType((*QueryProducerHistoryResponse)(nil), "heimdall.bor.v1beta1.QueryProducerHistoryResponse")
 }
 
 func init() { proto.RegisterFile("heimdall/bor/v1beta1/query.proto", fileDescriptor_e8643ca7cfaca281) }
 
 var fileDescriptor_e8643ca7cfaca281 = []byte{
-	// 876 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0x4d, 0x8f, 0xdb, 0x44,
-	0x18, 0xc7, 0xe3, 0x7d, 0x63, 0x79, 0xba, 0x0d, 0x65, 0x08, 0x5b, 0x63, 0x55, 0xd9, 0xac, 0xbb,
-	0xdb, 0x64, 0xd3, 0xc4, 0x56, 0x17, 0x4e, 0x48, 0x08, 0x48, 0x00, 0xa5, 0x52, 0x85, 0xca, 0x96,
-	0x13, 0x97, 0x68, 0x1c, 0x8f, 0x12, 0xab, 0x8e, 0xc7, 0x1d, 0x4f, 0xca, 0x46, 0x55, 0x2f, 0x20,
-	0x6e, 0xa8, 0x42, 0x82, 0x2b, 0xe2, 0xc0, 0x57, 0xe0, 0x43, 0x70, 0xac, 0xc4, 0x85, 0x13, 0x42,
-	0xbb, 0x7c, 0x02, 0x3e, 0x41, 0x35, 0x2f, 0x4e, 0xdc, 0xc4, 0x79, 0xd9, 0x5b, 0xfc, 0xcc, 0x7f,
-	0x9e, 0xf9, 0xfd, 0x67, 0x9e, 0xe7, 0x51, 0xa0, 0x32, 0x20, 0xc1, 0xd0, 0xc7, 0x61, 0xe8, 0x7a,
-	0x94, 0xb9, 0x4f, 0xef, 0x79, 0x84, 0xe3, 0x7b, 0xee, 0x93, 0x11, 0x61, 0x63, 0x27, 0x66, 0x94,
-	0x53, 0x54, 0x4a, 0x15, 0x8e, 0x47, 0x99, 0xa3, 0x15, 0x56, 0xa9, 0x4f, 0xfb, 0x54, 0x0a, 0x5c,
-	0xf1, 0x4b, 0x69, 0xad, 0x4c, 0x36, 0x9c, 0x90, 0x49, 0xba, 0x24, 0xc6, 0x91, 0x56, 0x1c, 0xe6,
-	0x2b, 0x32, 0x07, 0x5a, 0xc7, 0xf9, 0x92, 0xa7, 0x38, 0x0c, 0x7c, 0xcc, 0x29, 0xd3, 0xb2, 0x5b,
-	0x7d, 0x4a, 0xfb, 0x21, 0x71, 0x71, 0x1c, 0xb8, 0x38, 0x8a, 0x28, 0xc7, 0x3c, 0xa0, 0x51, 0xa2,
-	0x56, 0xed, 0x12, 0xa0, 0xaf, 0x44, 0xce, 0x87, 0x98, 0xe1, 0x61, 0x72, 0x46, 0x9e, 0x8c, 0x48,
-	0xc2, 0xed, 0xdf, 0x0d, 0x78, 0xe7, 0xb5, 0x70, 0x12, 0xd3, 0x28, 0x21, 0xe8, 0x36, 0x5c, 0x17,
-	0x8c, 0x5d, 0x7f, 0xc4, 0x64, 0x16, 0xd3, 0xa8, 0x18, 0xb5, 0xad, 0xb3, 0x3d, 0x11, 0xfc, 0x4c,
-	0xc7, 0x50, 0x0d, 0x6e, 0x84, 0x98, 0x93, 0x84, 0x77, 0x09, 0x1f, 0x74, 0xbd, 0x90, 0xf6, 0x1e,
-	0x9b, 0x1b, 0x52, 0x57, 0x54, 0xf1, 0xcf, 0xf9, 0xa0, 0x25, 0xa2, 0xe8, 0x18, 0x8a, 0x31, 0xa3,
-	0xfe, 0xa8, 0x47, 0x58, 0xb7, 0x47, 0x47, 0x11, 0x37, 0x37, 0xa5, 0xee, 0x7a, 0x1a, 0x6d, 0x8b,
-	0x20, 0xda, 0x87, 0x9d, 0x24, 0x66, 0x41, 0xc4, 0xcd, 0x2d, 0xb9, 0xac, 0xbf, 0xec, 0x0f, 0xe0,
-	0xed, 0x29, 0xa4, 0x46, 0x47, 0x07, 0x70, 0x2d, 0x96, 0xd0, 0x5d, 0x3e, 0x8e, 0x89, 0x04, 0x7c,
-	0xf3, 0x0c, 0x54, 0xe8, 0xeb, 0x71, 0x4c, 0xec, 0x3f, 0x8c, 0xac, 0xe5, 0x89, 0xb5, 0xe3, 0x5c,
-	0x6b, 0x9d, 0xc2, 0x8c, 0xb9, 0xfa, 0x22, 0x73, 0x9d, 0xc2, 0x9c, 0xbd, 0x6a, 0xbe, 0xbd, 0x4e,
-	0x61, 0xd6, 0xa0, 0xf9, 0xba, 0xc1, 0x4e, 0x21, 0xb5, 0xd8, 0xda, 0x85, 0x1d, 0x85, 0x6e, 0x7f,
-	0x0c, 0x37, 0x24, 0xf5, 0xa3, 0x18, 0x47, 0xa9, 0xd7, 0xbb, 0xf0, 0x86, 0x64, 0x0e, 0x7c, 0x45,
-	0xdb, 0x42, 0xff, 0xff, 0x73, 0x50, 0x1c, 0xe3, 0x61, 0xf8, 0xa1, 0xad, 0x17, 0x6c, 0x91, 0x0a,
-	0x47, 0xf7, 0x7d, 0xfb, 0x23, 0x7d, 0x5b, 0x2a, 0x81, 0x76, 0x5d, 0x83, 0x2d, 0xf1, 0x2d, 0xb7,
-	0x5f, 0x3b, 0x2d, 0x39, 0x93, 0x1a, 0x16, 0x97, 0x97, 0x38, 0x52, 0x2b, 0x15, 0xf6, 0x27, 0x50,
-	0x9a, 0x6c, 0x7f, 0x10, 0x24, 0x3c, 0x65, 0x40, 0xb0, 0x15, 0xe3, 0x3e, 0xd1, 0x95, 0x20, 0x7f,
-	0xa3, 0x12, 0x6c, 0x87, 0xc1, 0x30, 0xe0, 0xfa, 0xd9, 0xd5, 0x87, 0xdd, 0x86, 0x77, 0x67, 0x32,
-	0x68, 0x88, 0x3a, 0x6c, 0x8b, 0x58, 0x62, 0x1a, 0x95, 0xcd, 0x85, 0x14, 0x4a, 0x62, 0x9b, 0xb0,
-	0x2f, 0x93, 0x3c, 0x90, 0x57, 0x9d, 0xb9, 0x0c, 0xbb, 0x0d, 0x37, 0xe7, 0x56, 0xae, 0xec, 0x92,
-	0xc3, 0xfe, 0x43, 0x46, 0x62, 0xcc, 0xc8, 0x97, 0xe4, 0x3c, 0x9b, 0x5e, 0xd4, 0x55, 0xc2, 0x31,
-	0xe3, 0xfa, 0xcd, 0x95, 0x5d, 0x90, 0x21, 0xf5, 0xda, 0x37, 0xa7, 0x8f, 0xb1, 0x91, 0x96, 0xa9,
-	0xb8, 0x78, 0x54, 0x81, 0x3d, 0x8f, 0xb2, 0x6e, 0x6f, 0x80, 0x03, 0xb9, 0xba, 0xa9, 0x4a, 0xd2,
-	0xa3, 0xac, 0x2d, 0x42, 0xf7, 0x7d, 0x81, 0x3e, 0x77, 0xea, 0x95, 0xd1, 0x2d, 0x30, 0xa5, 0xff,
-	0x34, 0xc5, 0x23, 0x42, 0xfc, 0xf4, 0x6e, 0x3e, 0x85, 0xf7, 0x72, 0xd6, 0xf4, 0x11, 0x47, 0x50,
-	0x8c, 0xc8, 0x39, 0xef, 0x4a, 0xfa, 0x84, 0x10, 0x5f, 0x37, 0xcd, 0x5e, 0x94, 0x51, 0x9f, 0xbe,
-	0xd8, 0x85, 0x6d, 0x99, 0x03, 0x7d, 0x6f, 0xc0, 0x8e, 0x9a, 0x0b, 0xa8, 0xe6, 0xe4, 0x0d, 0x3d,
-	0x67, 0x7e, 0xa2, 0x58, 0x27, 0x6b, 0x28, 0x15, 0x8f, 0x7d, 0xf4, 0xdd, 0x5f, 0xff, 0xfd, 0xbc,
-	0x51, 0x46, 0xb7, 0xdc, 0xdc, 0x99, 0xab, 0xfa, 0x01, 0xbd, 0x30, 0x60, 0x5b, 0x6e, 0x44, 0xd5,
-	0x55, 0xa9, 0x53, 0x86, 0xda, 0x6a, 0xa1, 0x46, 0x38, 0x95, 0x08, 0x0d, 0x54, 0x5f, 0x86, 0xe0,
-	0x3e, 0xcb, 0x0c, 0x9a, 0xe7, 0xe8, 0x47, 0x03, 0x76, 0xd3, 0xd2, 0x46, 0xf5, 0x25, 0x47, 0xcd,
-	0x74, 0x90, 0x75, 0x77, 0x2d, 0xad, 0x26, 0xab, 0x4a, 0xb2, 0x43, 0x74, 0x90, 0x4f, 0x26, 0xde,
-	0xb0, 0x19, 0x0a, 0x82, 0x1f, 0x0c, 0x55, 0x39, 0xe8, 0xce, 0x8a, 0xf4, 0x29, 0x46, 0x75, 0xa5,
-	0x4e, 0x23, 0x34, 0x24, 0xc2, 0x1d, 0x74, 0xb4, 0x18, 0xc1, 0x7d, 0xa6, 0x5b, 0xe1, 0x39, 0xfa,
-	0xc5, 0x00, 0x98, 0xb6, 0x24, 0x6a, 0x2c, 0x39, 0x65, 0xae, 0xa7, 0xad, 0xe6, 0x9a, 0x6a, 0x4d,
-	0x76, 0x22, 0xc9, 0x6e, 0xa3, 0xc3, 0x7c, 0x32, 0x35, 0x9e, 0x9b, 0x02, 0x0d, 0xfd, 0x66, 0xc0,
-	0x5b, 0x33, 0x3d, 0xb7, 0x88, 0x2d, 0x7f, 0x20, 0x2c, 0x62, 0x5b, 0xd0, 0xc8, 0xb6, 0x2b, 0xd9,
-	0x4e, 0x50, 0x75, 0x41, 0x49, 0xa9, 0x6d, 0x4d, 0xd1, 0x73, 0x8a, 0xf0, 0x57, 0x03, 0xf6, 0xb2,
-	0xfd, 0x8a, 0x9c, 0x25, 0x97, 0x91, 0xd3, 0xf4, 0x96, 0xbb, 0xb6, 0x7e, 0xbd, 0x87, 0x9d, 0xa0,
-	0x35, 0xc5, 0x90, 0x68, 0x7d, 0xf1, 0xe7, 0x45, 0xd9, 0x78, 0x79, 0x51, 0x36, 0xfe, 0xbd, 0x28,
-	0x1b, 0x3f, 0x5d, 0x96, 0x0b, 0x2f, 0x2f, 0xcb, 0x85, 0xbf, 0x2f, 0xcb, 0x85, 0x6f, 0x1a, 0xfd,
-	0x80, 0x0f, 0x46, 0x9e, 0xd3, 0xa3, 0x43, 0x77, 0x88, 0x79, 0xd0, 0x8b, 0x08, 0xff, 0x96, 0xb2,
-	0xc7, 0xd3, 0xb4, 0xe7, 0x32, 0xb1, 0x1c, 0x61, 0xde, 0x8e, 0xfc, 0x23, 0xf2, 0xfe, 0xab, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xe6, 0x4b, 0xe2, 0x67, 0x62, 0x09, 0x00, 0x00,
+	// 1070 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0x26, 0x71, 0x28, 0x6f, 0xd2, 0xd0, 0x0e, 0x26, 0x5d, 0x96, 0xc8, 0x49, 0xb6, 0x49,
+	0xed, 0x7c, 0xec, 0xae, 0x92, 0x82, 0x90, 0x10, 0xe5, 0xc3, 0x29, 0xc8, 0x91, 0x0a, 0x2a, 0x0e,
+	0x27, 0x0e, 0x58, 0x63, 0xef, 0xc8, 0x5e, 0x75, 0xbd, 0xbb, 0xdd, 0x19, 0x97, 0x58, 0x51, 0x2e,
+	0x20, 0x6e, 0x08, 0x21, 0xc1, 0x15, 0x21, 0xfe, 0x03, 0x12, 0x7f, 0xa1, 0xc7, 0x4a, 0x5c, 0x38,
+	0x55, 0x90, 0x70, 0xe4, 0xc4, 0x2f, 0x40, 0xf3, 0xb1, 0xce, 0xc6, 0x5e, 0x7f, 0xa4, 0xa7, 0x78,
+	0xdf, 0x79, 0xde, 0x77, 0x9e, 0xf7, 0x99, 0x77, 0x9e, 0x09, 0xac, 0xb5, 0x89, 0xd7, 0x71, 0xb1,
+	0xef, 0x3b, 0x8d, 0x30, 0x76, 0x9e, 0xec, 0x35, 0x08, 0xc3, 0x7b, 0xce, 0xe3, 0x2e, 0x89, 0x7b,
+	0x76, 0x14, 0x87, 0x2c, 0x44, 0x85, 0x04, 0x61, 0x37, 0xc2, 0xd8, 0x56, 0x08, 0xa3, 0xd0, 0x0a,
+	0x5b, 0xa1, 0x00, 0x38, 0xfc, 0x97, 0xc4, 0x1a, 0xa9, 0x6a, 0x98, 0x92, 0x7e, 0x39, 0x1a, 0xe1,
+	0x40, 0x21, 0x8a, 0x99, 0xfb, 0xf1, 0xca, 0x72, 0x7d, 0x3d, 0xbb, 0x42, 0x8a, 0x90, 0xb1, 0x99,
+	0x0d, 0x79, 0x82, 0x7d, 0xcf, 0xc5, 0xac, 0x5f, 0x69, 0xa5, 0x15, 0x86, 0x2d, 0x9f, 0x38, 0x38,
+	0xf2, 0x1c, 0x1c, 0x04, 0x21, 0xc3, 0xcc, 0x0b, 0x03, 0x2a, 0x57, 0xcd, 0x02, 0xa0, 0xcf, 0x78,
+	0xcd, 0x87, 0x38, 0xc6, 0x1d, 0x5a, 0x23, 0x8f, 0xbb, 0x84, 0x32, 0xf3, 0x6f, 0x0d, 0x5e, 0xbd,
+	0x14, 0xa6, 0x51, 0x18, 0x50, 0x82, 0x6e, 0xc3, 0x75, 0xde, 0x43, 0xdd, 0xed, 0xc6, 0xa2, 0x8a,
+	0xae, 0xad, 0x69, 0xe5, 0xb9, 0xda, 0x22, 0x0f, 0xde, 0x57, 0x31, 0x54, 0x86, 0x1b, 0x3e, 0x66,
+	0x84, 0xb2, 0x3a, 0x61, 0xed, 0x7a, 0xc3, 0x0f, 0x9b, 0x8f, 0xf4, 0x19, 0x81, 0x5b, 0x92, 0xf1,
+	0x8f, 0x58, 0xbb, 0xc2, 0xa3, 0x68, 0x13, 0x96, 0xa2, 0x38, 0x74, 0xbb, 0x4d, 0x12, 0xd7, 0x9b,
+	0x61, 0x37, 0x60, 0xfa, 0xac, 0xc0, 0x5d, 0x4f, 0xa2, 0x07, 0x3c, 0x88, 0x96, 0x61, 0x9e, 0x46,
+	0xb1, 0x17, 0x30, 0x7d, 0x4e, 0x2c, 0xab, 0x2f, 0xf4, 0x2e, 0x18, 0x31, 0x89, 0x7c, 0xdc, 0x24,
+	0x1d, 0x12, 0xb0, 0x7a, 0xc7, 0xa3, 0x94, 0xb8, 0x75, 0xb9, 0x48, 0xf5, 0xbc, 0xc0, 0xea, 0x29,
+	0xc4, 0x27, 0x02, 0x70, 0x24, 0xd7, 0xcd, 0x37, 0xe1, 0xe6, 0x45, 0x8b, 0xaa, 0x71, 0xb4, 0x0a,
+	0x0b, 0x91, 0x68, 0xb9, 0xce, 0x7a, 0x11, 0x11, 0xed, 0xbd, 0x5c, 0x03, 0x19, 0xfa, 0xbc, 0x17,
+	0x11, 0xf3, 0x37, 0x2d, 0x2d, 0x58, 0x5f, 0x98, 0xcd, 0x4c, 0x61, 0xaa, 0xb9, 0x01, 0x69, 0xb6,
+	0x47, 0x49, 0x53, 0xcd, 0x0d, 0x89, 0x53, 0xca, 0x16, 0xa7, 0x9a, 0x1b, 0x94, 0x47, 0xbf, 0x2c,
+	0x4f, 0x35, 0x97, 0x08, 0x54, 0xb9, 0x06, 0xf3, 0x92, 0xba, 0xf9, 0x3e, 0xdc, 0x10, 0xac, 0x8f,
+	0x22, 0x1c, 0x24, 0xbd, 0xee, 0xc0, 0x4b, 0x82, 0xb3, 0xe7, 0x4a, 0xb6, 0x15, 0xf4, 0xdf, 0xf3,
+	0xd5, 0xa5, 0x1e, 0xee, 0xf8, 0xef, 0x98, 0x6a, 0xc1, 0xe4, 0xa5, 0x70, 0x70, 0xe8, 0x9a, 0xf7,
+	0xe0, 0x66, 0xaa, 0x80, 0xea, 0xba, 0x0c, 0x73, 0xfc, 0x5b, 0xa4, 0x2f, 0xec, 0x17, 0xec, 0xfe,
+	0x0d, 0xe1, 0xe2, 0x51, 0x5b, 0x60, 0x05, 0xc2, 0xfc, 0x00, 0x0a, 0xfd, 0xf4, 0x07, 0x1e, 0x65,
+	0x09, 0x07, 0x04, 0x73, 0x11, 0x6e, 0x11, 0x35, 0x47, 0xe2, 0x37, 0x2a, 0x40, 0xde, 0xf7, 0x3a,
+	0x1e, 0x53, 0x43, 0x23, 0x3f, 0xcc, 0x03, 0x78, 0x6d, 0xa0, 0x82, 0x22, 0xb1, 0x0d, 0x79, 0x1e,
+	0xa3, 0xba, 0xb6, 0x36, 0x3b, 0x92, 0x85, 0x84, 0x98, 0x3a, 0x2c, 0x8b, 0x22, 0x0f, 0x84, 0xd4,
+	0x29, 0x31, 0xcc, 0x03, 0xb8, 0x35, 0xb4, 0x72, 0xe5, 0x2e, 0x19, 0x2c, 0x3f, 0x8c, 0x49, 0x84,
+	0x63, 0xf2, 0x29, 0x39, 0x4e, 0x97, 0xe7, 0x73, 0x45, 0x19, 0x8e, 0x99, 0x3a, 0x73, 0xd9, 0x2e,
+	0x88, 0x90, 0x3c, 0xed, 0x5b, 0x17, 0x87, 0x31, 0x93, 0x0c, 0x39, 0x17, 0x1e, 0xad, 0xc1, 0x62,
+	0x23, 0x8c, 0xeb, 0xcd, 0x36, 0xf6, 0xc4, 0xea, 0xac, 0x1c, 0xc9, 0x46, 0x18, 0x1f, 0xf0, 0xd0,
+	0xa1, 0xcb, 0xa9, 0x0f, 0xed, 0x7a, 0x65, 0xea, 0x06, 0xe8, 0xa2, 0xff, 0xa4, 0xc4, 0x11, 0x21,
+	0x6e, 0xa2, 0xcd, 0x87, 0xf0, 0x7a, 0xc6, 0x9a, 0xda, 0x62, 0x03, 0x96, 0x02, 0x72, 0xcc, 0xea,
+	0x82, 0x3d, 0x25, 0xc4, 0x55, 0x97, 0x66, 0x31, 0x48, 0xa1, 0x4d, 0x47, 0xc9, 0xcb, 0x03, 0x95,
+	0x9e, 0x68, 0x39, 0x91, 0xa6, 0x00, 0xf9, 0xb4, 0x28, 0xf2, 0xc3, 0xbc, 0x0f, 0xfa, 0x70, 0xc2,
+	0x0b, 0x8c, 0xdd, 0x1b, 0xf2, 0xb2, 0xaa, 0x0b, 0x53, 0xf5, 0x28, 0x0b, 0xe3, 0x5e, 0xb2, 0xf5,
+	0x3a, 0x2c, 0xf6, 0xdd, 0xb2, 0x7f, 0x0d, 0x6a, 0x0b, 0xfd, 0xd8, 0xa1, 0x6b, 0x7e, 0x09, 0x2b,
+	0xd9, 0x15, 0x14, 0x97, 0xf7, 0x20, 0x4f, 0x53, 0xd3, 0x67, 0xda, 0x59, 0xaf, 0x84, 0x9d, 0x64,
+	0x8b, 0x6e, 0xe6, 0x9e, 0x3e, 0x5f, 0xcd, 0xd5, 0x64, 0xda, 0xfe, 0xbf, 0x00, 0x79, 0xb1, 0x01,
+	0xfa, 0x46, 0x83, 0x79, 0x69, 0xb7, 0xa8, 0x9c, 0x5d, 0x65, 0xd8, 0xa8, 0x8d, 0xad, 0x29, 0x90,
+	0x92, 0xa9, 0xb9, 0xf1, 0xf5, 0x1f, 0xff, 0xfc, 0x38, 0x53, 0x44, 0x2b, 0x4e, 0xe6, 0xd3, 0x23,
+	0x8d, 0x02, 0x7d, 0xaf, 0x41, 0x5e, 0x24, 0xa2, 0xd2, 0xa4, 0xd2, 0x09, 0x87, 0xf2, 0x64, 0xa0,
+	0xa2, 0xb0, 0x2f, 0x28, 0xec, 0xa2, 0xed, 0x71, 0x14, 0x9c, 0x93, 0x94, 0x03, 0x9f, 0xa2, 0xef,
+	0x34, 0xb8, 0x96, 0xdc, 0x79, 0xb4, 0x3d, 0x66, 0xab, 0x01, 0x6b, 0x31, 0x76, 0xa6, 0xc2, 0x2a,
+	0x66, 0x25, 0xc1, 0x6c, 0x1d, 0xad, 0x66, 0x33, 0xe3, 0x67, 0x65, 0xf9, 0x9c, 0xc1, 0xb7, 0x9a,
+	0x1c, 0x3e, 0x74, 0x67, 0x42, 0xf9, 0x84, 0x46, 0x69, 0x22, 0x4e, 0x51, 0xd8, 0x15, 0x14, 0xee,
+	0xa0, 0x8d, 0xd1, 0x14, 0x9c, 0x13, 0xe5, 0x11, 0xa7, 0xe8, 0x27, 0x0d, 0xe0, 0xc2, 0xab, 0xd0,
+	0xee, 0x98, 0x5d, 0x86, 0xcc, 0xce, 0xb0, 0xa6, 0x44, 0x2b, 0x66, 0x5b, 0x82, 0xd9, 0x6d, 0xb4,
+	0x9e, 0xcd, 0x4c, 0xbe, 0x5b, 0x16, 0xa7, 0x86, 0x7e, 0xd1, 0xe0, 0x95, 0x01, 0x33, 0x1a, 0xc5,
+	0x2d, 0xdb, 0x29, 0x0d, 0x6b, 0x4a, 0xb4, 0xe2, 0xe6, 0x08, 0x6e, 0x5b, 0xa8, 0x34, 0x62, 0xa4,
+	0x64, 0x9a, 0xc5, 0xcd, 0x48, 0x32, 0xfc, 0x59, 0x83, 0xc5, 0xb4, 0x91, 0x21, 0x7b, 0x8c, 0x18,
+	0x19, 0x6e, 0x68, 0x38, 0x53, 0xe3, 0xa7, 0x3b, 0xd8, 0x3e, 0x35, 0x8b, 0xbb, 0x27, 0xfa, 0x55,
+	0x83, 0x85, 0x94, 0xe9, 0x21, 0x6b, 0xc2, 0xfc, 0x5c, 0x76, 0x53, 0xc3, 0x9e, 0x16, 0xae, 0xc8,
+	0xdd, 0x15, 0xe4, 0x2c, 0xb4, 0x33, 0x66, 0xf0, 0x1b, 0x3d, 0x4b, 0x98, 0xb2, 0x73, 0x22, 0xfe,
+	0x9c, 0xa2, 0xdf, 0xc5, 0x29, 0x5f, 0x32, 0x44, 0xb4, 0x37, 0xce, 0x05, 0x32, 0xed, 0xd7, 0xd8,
+	0xbf, 0x4a, 0x8a, 0xe2, 0x7b, 0x4f, 0xf0, 0x7d, 0x1b, 0xbd, 0x35, 0xea, 0xbc, 0x65, 0x9a, 0xd5,
+	0x96, 0x79, 0xce, 0x49, 0xda, 0xe0, 0x4f, 0x2b, 0x1f, 0x3f, 0x3d, 0x2b, 0x6a, 0xcf, 0xce, 0x8a,
+	0xda, 0x5f, 0x67, 0x45, 0xed, 0x87, 0xf3, 0x62, 0xee, 0xd9, 0x79, 0x31, 0xf7, 0xe7, 0x79, 0x31,
+	0xf7, 0xc5, 0x6e, 0xcb, 0x63, 0xed, 0x6e, 0xc3, 0x6e, 0x86, 0x1d, 0xa7, 0x83, 0x99, 0xd7, 0x0c,
+	0x08, 0xfb, 0x2a, 0x8c, 0x1f, 0x5d, 0xec, 0x73, 0x2c, 0x76, 0x12, 0x6f, 0x4c, 0x63, 0x5e, 0xfc,
+	0xf7, 0x7c, 0xf7, 0xff, 0x01, 0x00, 0xe5, 0x17, 0xd7, 0xaa, 0x37, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -841,6 +1043,8 @@ type QueryClient interface {
 	LatestSpan(ctx context.Context, in *QueryLatestSpanRequest, opts ...grpc.CallOption) (*QueryLatestSpanResponse, error)
 	PrepareNextSpan(ctx context.Context, in *PrepareNextSpanRequest, opts ...grpc.CallOption) (*PrepareNextSpanResponse, error)
 	NextSpanSeed(ctx context.Context, in *QueryNextSpanSeedRequest, opts ...grpc.CallOption) (*QueryNextSpanSeedResponse, error)
+	SpanByBlock(ctx context.Context, in *QuerySpanByBlockRequest, opts ...grpc.CallOption) (*QuerySpanByBlockResponse, error)
+	ProducerHistory(ctx context.Context, in *QueryProducerHistoryRequest, opts ...grpc.CallOption) (*QueryProducerHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -914,6 +1118,24 @@ func (c *queryClient) NextSpanSeed(ctx context.Context, in *QueryNextSpanSeedReq
 	return out, nil
 }
 
+func (c *queryClient) SpanByBlock(ctx context.Context, in *QuerySpanByBlockRequest, opts ...grpc.CallOption) (*QuerySpanByBlockResponse, error) {
+	out := new(QuerySpanByBlockResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/SpanByBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProducerHistory(ctx context.Context, in *QueryProducerHistoryRequest, opts ...grpc.CallOption) (*QueryProducerHistoryResponse, error) {
+	out := new(QueryProducerHistoryResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/ProducerHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
@@ -923,6 +1145,8 @@ type QueryServer interface {
 	LatestSpan(context.Context, *QueryLatestSpanRequest) (*QueryLatestSpanResponse, error)
 	PrepareNextSpan(context.Context, *PrepareNextSpanRequest) (*PrepareNextSpanResponse, error)
 	NextSpanSeed(context.Context, *QueryNextSpanSeedRequest) (*QueryNextSpanSeedResponse, error)
+	SpanByBlock(context.Context, *QuerySpanByBlockRequest) (*QuerySpanByBlockResponse, error)
+	ProducerHistory(context.Context, *QueryProducerHistoryRequest) (*QueryProducerHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -950,6 +1174,12 @@ func (*UnimplementedQueryServer) PrepareNextSpan(ctx context.Context, req *Prepa
 func (*UnimplementedQueryServer) NextSpanSeed(ctx context.Context, req *QueryNextSpanSeedRequest) (*QueryNextSpanSeedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextSpanSeed not implemented")
 }
+func (*UnimplementedQueryServer) SpanByBlock(ctx context.Context, req *QuerySpanByBlockRequest) (*QuerySpanByBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpanByBlock not implemented")
+}
+func (*UnimplementedQueryServer) ProducerHistory(ctx context.Context, req *QueryProducerHistoryRequest) (*QueryProducerHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProducerHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1081,6 +1311,42 @@ func _Query_NextSpanSeed_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SpanByBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpanByBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpanByBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.bor.v1beta1.Query/SpanByBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpanByBlock(ctx, req.(*QuerySpanByBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProducerHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProducerHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProducerHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.bor.v1beta1.Query/ProducerHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProducerHistory(ctx, req.(*QueryProducerHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "heimdall.bor.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1113,6 +1379,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextSpanSeed",
 			Handler:    _Query_NextSpanSeed_Handler,
 		},
+		{
+			MethodName: "SpanByBlock",
+			Handler:    _Query_SpanByBlock_Handler,
+		},
+		{
+			MethodName: "ProducerHistory",
+			Handler:    _Query_ProducerHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdall/bor/v1beta1/query.proto",
@@ -1161,6 +1435,11 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReplacementMissedSprints != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReplacementMissedSprints))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Sprint != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Sprint))
 		i--
@@ -1613,79 +1892,210 @@ func (m *QueryNextSpanSeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySpanByBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QuerySpanByBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySpanByBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SpanDuration != 0 {
-		n += 1 + sovQuery(uint64(m.SpanDuration))
-	}
-	if m.LatestEthBlock != 0 {
-		n += 1 + sovQuery(uint64(m.LatestEthBlock))
-	}
-	if m.ProducerCount != 0 {
-		n += 1 + sovQuery(uint64(m.ProducerCount))
-	}
-	if m.Sprint != 0 {
-		n += 1 + sovQuery(uint64(m.Sprint))
+	if m.Block != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ParamsType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySpanByBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySpanByBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpanByBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		n += m.Params.Size()
+	if m.Span != nil {
+		{
+			size, err := m.Span.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamResponse_SpanDuration) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProducerHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	n += 1 + sovQuery(uint64(m.SpanDuration))
+	return dAtA[:n], nil
+}
+
+func (m *QueryProducerHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProducerHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProducerHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProducerHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProducerHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spans) > 0 {
+		for iNdEx := len(m.Spans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpanDuration != 0 {
+		n += 1 + sovQuery(uint64(m.SpanDuration))
+	}
+	if m.LatestEthBlock != 0 {
+		n += 1 + sovQuery(uint64(m.LatestEthBlock))
+	}
+	if m.ProducerCount != 0 {
+		n += 1 + sovQuery(uint64(m.ProducerCount))
+	}
+	if m.Sprint != 0 {
+		n += 1 + sovQuery(uint64(m.Sprint))
+	}
+	if m.ReplacementMissedSprints != 0 {
+		n += 1 + sovQuery(uint64(m.ReplacementMissedSprints))
+	}
+	return n
+}
+
+func (m *QueryParamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParamsType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		n += m.Params.Size()
+	}
+	return n
+}
+
+func (m *QueryParamResponse_SpanDuration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovQuery(uint64(m.SpanDuration))
 	return n
 }
 func (m *QueryParamResponse_LatestEthBlock) Size() (n int) {
@@ -1846,6 +2256,58 @@ func (m *QueryNextSpanSeedResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySpanByBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != 0 {
+		n += 1 + sovQuery(uint64(m.Block))
+	}
+	return n
+}
+
+func (m *QuerySpanByBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Span != nil {
+		l = m.Span.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProducerHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorId != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorId))
+	}
+	return n
+}
+
+func (m *QueryProducerHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Spans) > 0 {
+		for _, e := range m.Spans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2010,6 +2472,25 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacementMissedSprints", wireType)
+			}
+			m.ReplacementMissedSprints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplacementMissedSprints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3083,6 +3564,326 @@ func (m *QueryNextSpanSeedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySpanByBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpanByBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpanByBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpanByBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpanByBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpanByBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Span == nil {
+				m.Span = &types.Span{}
+			}
+			if err := m.Span.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProducerHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProducerHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProducerHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
+			}
+			m.ValidatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProducerHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProducerHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProducerHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spans = append(m.Spans, ProducerSpan{})
+			if err := m.Spans[len(m.Spans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/bor/rlp"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// extraSeal is the number of bytes of the signature at the end of the bor header extra data
+const extraSeal = 65
+
+// BorBlockSigner recovers the producer that signed the bor block header
+func BorBlockSigner(header *ethTypes.Header) (common.Address, error) {
+	if len(header.Extra) < extraSeal {
+		return common.Address{}, errors.New("missing signature in header extra data")
+	}
+
+	// seal hash is the hash of the header without the signature
+	sealHash, err := rlp.EncodeToBytes([]interface{}{
+		header.ParentHash,
+		header.UncleHash,
+		header.Coinbase,
+		header.Root,
+		header.TxHash,
+		header.ReceiptHash,
+		header.Bloom,
+		header.Difficulty,
+		header.Number,
+		header.GasLimit,
+		header.GasUsed,
+		header.Time,
+		header.Extra[:len(header.Extra)-extraSeal],
+		header.MixDigest,
+		header.Nonce,
+	})
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	pubkey, err := crypto.Ecrecover(crypto.Keccak256(sealHash), header.Extra[len(header.Extra)-extraSeal:])
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	var signer common.Address
+	copy(signer[:], crypto.Keccak256(pubkey[1:])[12:])
+	return signer, nil
+}
+
+// InTurnProducer returns the id of the producer of the span in turn at the bor block,
+// and if it signed the block. Bor sets the difficulty of a block to the number of
+// producers minus the distance from the producer in turn to the signer, in the
+// producers sorted by address.
+func InTurnProducer(span *hmTypes.Span, header *ethTypes.Header) (uint64, bool, error) {
+	signer, err := BorBlockSigner(header)
+	if err != nil {
+		return 0, false, err
+	}
+
+	producers := hmTypes.SortValidatorByAddress(append([]hmTypes.Validator{}, span.SelectedProducers...))
+	signerIndex := -1
+	for i, producer := range producers {
+		if bytes.Equal(producer.GetSigner(), signer.Bytes()) {
+			signerIndex = i
+			break
+		}
+	}
+	if signerIndex == -1 {
+		return 0, false, errors.New("block signer is not a producer of the span")
+	}
+
+	count := uint64(len(producers))
+	difficulty := header.Difficulty.Uint64()
+	if difficulty == 0 || difficulty > count {
+		return 0, false, errors.New("invalid block difficulty")
+	}
+
+	inTurnIndex := (uint64(signerIndex) + difficulty) % count
+	return producers[inTurnIndex].ID.Uint64(), difficulty == count, nil
+}
+
+// OfflineProducers returns the ids of the producers that missed at least threshold of the sprints, sorted
+func OfflineProducers(missedSprints []MissedSprint, threshold uint64) []uint64 {
+	missed := make(map[uint64]uint64)
+	for _, sprint := range missedSprints {
+		missed[sprint.ProducerId]++
+	}
+
+	offline := make([]uint64, 0)
+	for id, count := range missed {
+		if threshold > 0 && count >= threshold {
+			offline = append(offline, id)
+		}
+	}
+	sort.Slice(offline, func(i, j int) bool { return offline[i] < offline[j] })
+
+	return offline
+}
+
+// FormatProducerIDs formats the producer ids as a comma separated list
+func FormatProducerIDs(ids []uint64) string {
+	strs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		strs = append(strs, strconv.FormatUint(id, 10))
+	}
+	return strings.Join(strs, ",")
+}
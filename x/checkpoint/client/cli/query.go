@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/version"
@@ -32,6 +33,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdQueryLastNoACK(),
 		GetCmdQueryHeaderFromIndex(),
 		GetCmdQueryCheckpointCount(),
+		GetCmdQueryBlockInclusionProof(),
 	)
 
 	return checkpointQueryCmd
@@ -190,3 +192,59 @@ func GetCmdQueryCheckpointCount() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryBlockInclusionProof implements the block inclusion proof query command.
+func GetCmdQueryBlockInclusionProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-inclusion-proof [block-number]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "show proof of a bor block against the checkpoint covering it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the merkle proof of a bor block, given by its number or the hash of one
+of its transactions, against the root hash of the checkpoint covering it.
+
+Example:
+$ %s query checkpoint block-inclusion-proof 1000
+$ %s query checkpoint block-inclusion-proof --tx-hash=<transaction-hash>
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			txHash, err := cmd.Flags().GetString(FlagBorTxHash)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBlockInclusionProofRequest{TxHash: txHash}
+			switch {
+			case len(args) == 1:
+				if req.BlockNumber, err = strconv.ParseUint(args[0], 10, 64); err != nil {
+					return fmt.Errorf("invalid block number: %w", err)
+				}
+			case txHash == "":
+				return fmt.Errorf("block number or transaction hash required")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockInclusionProof(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagBorTxHash, "", "--tx-hash=<transaction-hash>")
+
+	return cmd
+}
@@ -1,13 +1,16 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
 	"github.com/maticnetwork/heimdall/x/checkpoint/types"
 )
@@ -200,3 +203,59 @@ func (k Querier) LatestCheckpoint(c context.Context, req *types.QueryLatestCheck
 		LatestCheckpoint: &res,
 	}, nil
 }
+
+// BlockInclusionProof queries the proof of a bor block against the checkpoint covering it
+func (k Querier) BlockInclusionProof(c context.Context, req *types.QueryBlockInclusionProofRequest) (*types.QueryBlockInclusionProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	blockNumber := req.BlockNumber
+	if req.TxHash != "" {
+		number, err := k.contractCaller.GetBlockNumberFromTxHash(hmCommonTypes.HexToHeimdallHash(req.TxHash).EthHash())
+		if err != nil || number == nil {
+			return nil, status.Error(codes.NotFound, "transaction not found on bor chain")
+		}
+		blockNumber = number.Uint64()
+	}
+
+	checkpointNumber, checkpoint, err := k.GetCheckpointByBlockNumber(ctx, blockNumber)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "block is not checkpointed yet")
+	}
+
+	// a stored checkpoint is never longer than the max checkpoint length, the
+	// check only bounds the header range fetched from bor
+	if checkpoint.EndBlock-checkpoint.StartBlock+1 > k.GetParams(ctx).MaxCheckpointLength {
+		return nil, status.Error(codes.FailedPrecondition, "checkpoint exceeds max checkpoint length")
+	}
+
+	// rebuild the checkpoint tree from the bor block headers
+	headers, err := k.contractCaller.GetMaticChainBlocks(checkpoint.StartBlock, checkpoint.EndBlock)
+	if err != nil || len(headers) != int(checkpoint.EndBlock-checkpoint.StartBlock+1) {
+		return nil, status.Errorf(codes.Unavailable, "could not fetch bor blocks %d-%d", checkpoint.StartBlock, checkpoint.EndBlock)
+	}
+
+	index := blockNumber - checkpoint.StartBlock
+	proof, rootHash, err := types.GetBlockInclusionProof(headers, index)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !bytes.Equal(rootHash, common.FromHex(checkpoint.RootHash)) {
+		return nil, status.Error(codes.Internal, "bor block headers do not match checkpoint root hash")
+	}
+
+	return &types.QueryBlockInclusionProofResponse{
+		CheckpointNumber:  checkpointNumber,
+		HeaderBlockNumber: checkpointNumber * k.GetParams(ctx).ChildBlockInterval,
+		Checkpoint:        &checkpoint,
+		BlockNumber:       blockNumber,
+		BlockHash:         headers[index].Hash().String(),
+		Index:             index,
+		Leaf:              hmTypes.HexBytes(types.GetBlockHeaderLeaf(headers[index])).String(),
+		Proof:             hmTypes.HexBytes(proof).String(),
+	}, nil
+}
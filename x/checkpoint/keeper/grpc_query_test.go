@@ -9,6 +9,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethCommon "github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -235,3 +238,84 @@ func (suite *GrpcQueryTestSuite) TestQueryNextCheckpoint() {
 	require.Equal(t, checkpointBlock.RootHash, result.NextCheckpoint.RootHash)
 	require.Equal(t, checkpointBlock.BorChainID, result.NextCheckpoint.BorChainID)
 }
+
+func (suite *GrpcQueryTestSuite) TestQueryBlockInclusionProof() {
+	t, initApp, ctx, grpcQuery := suite.T(), suite.app, suite.ctx, suite.grpcQuery
+
+	// two checkpoints, the second one not a power of two long
+	ranges := [][2]uint64{{0, 3}, {4, 9}}
+	headers := make(map[uint64]*ethTypes.Header)
+	for i, r := range ranges {
+		var checkpointHeaders []*ethTypes.Header
+		for number := r[0]; number <= r[1]; number++ {
+			header := &ethTypes.Header{
+				Number:      new(big.Int).SetUint64(number),
+				Time:        1600000000 + number,
+				TxHash:      ethCommon.BytesToHash([]byte{byte(number), 1}),
+				ReceiptHash: ethCommon.BytesToHash([]byte{byte(number), 2}),
+			}
+			headers[number] = header
+			checkpointHeaders = append(checkpointHeaders, header)
+		}
+		suite.contractCaller.On("GetMaticChainBlocks", r[0], r[1]).Return(checkpointHeaders, nil)
+
+		_, rootHash, err := types.GetBlockInclusionProof(checkpointHeaders, 0)
+		require.NoError(t, err)
+
+		checkpoint := hmTypes.CreateBlock(r[0], r[1], hmCommonTypes.BytesToHeimdallHash(rootHash), hmCommonTypes.HexToHeimdallAddress("123"), "1234", uint64(time.Now().Unix()))
+		require.NoError(t, initApp.CheckpointKeeper.AddCheckpoint(ctx, uint64(i+1), checkpoint))
+	}
+	initApp.CheckpointKeeper.UpdateACKCountWithValue(ctx, uint64(len(ranges)))
+
+	for number, header := range headers {
+		result, err := grpcQuery.BlockInclusionProof(sdk.WrapSDKContext(ctx), &types.QueryBlockInclusionProofRequest{BlockNumber: number})
+		require.NoError(t, err)
+
+		checkpointNumber := uint64(1)
+		if number >= ranges[1][0] {
+			checkpointNumber = 2
+		}
+		require.Equal(t, checkpointNumber, result.CheckpointNumber)
+		require.Equal(t, checkpointNumber*types.DefaultChildBlockInterval, result.HeaderBlockNumber)
+		require.Equal(t, header.Hash().String(), result.BlockHash)
+		require.Equal(t, number-result.Checkpoint.StartBlock, result.Index)
+
+		ok, err := types.VerifyBlockInclusionProof(ethCommon.FromHex(result.Checkpoint.RootHash), header, result.Index, ethCommon.FromHex(result.Proof))
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+
+	_, err := grpcQuery.BlockInclusionProof(sdk.WrapSDKContext(ctx), &types.QueryBlockInclusionProofRequest{BlockNumber: 10})
+	require.Error(t, err)
+}
+
+func (suite *GrpcQueryTestSuite) TestBlockInclusionProofRootHash() {
+	t := suite.T()
+
+	headers := []*ethTypes.Header{
+		{Number: big.NewInt(1), Time: 1, TxHash: ethCommon.HexToHash("0x01"), ReceiptHash: ethCommon.HexToHash("0x02")},
+		{Number: big.NewInt(2), Time: 2, TxHash: ethCommon.HexToHash("0x03"), ReceiptHash: ethCommon.HexToHash("0x04")},
+		{Number: big.NewInt(3), Time: 3, TxHash: ethCommon.HexToHash("0x05"), ReceiptHash: ethCommon.HexToHash("0x06")},
+	}
+
+	leaves := make([][]byte, 4)
+	for i, header := range headers {
+		leaves[i] = crypto.Keccak256(
+			ethCommon.LeftPadBytes(header.Number.Bytes(), 32),
+			ethCommon.LeftPadBytes(new(big.Int).SetUint64(header.Time).Bytes(), 32),
+			header.TxHash.Bytes(),
+			header.ReceiptHash.Bytes(),
+		)
+	}
+	leaves[3] = make([]byte, 32)
+
+	expectedRoot := crypto.Keccak256(crypto.Keccak256(leaves[0], leaves[1]), crypto.Keccak256(leaves[2], leaves[3]))
+
+	proof, rootHash, err := types.GetBlockInclusionProof(headers, 2)
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot, rootHash)
+	require.Equal(t, append(leaves[3], crypto.Keccak256(leaves[0], leaves[1])...), proof)
+
+	_, _, err = types.GetBlockInclusionProof(headers, 3)
+	require.Error(t, err)
+}
@@ -31,6 +31,7 @@ var (
 // ModuleCommunicator manages different module interaction
 type ModuleCommunicator interface {
 	GetAllDividendAccounts(ctx sdk.Context) []*hmTypes.DividendAccount
+	GetDividendAccountRootHash(ctx sdk.Context) ([]byte, error)
 }
 
 type (
@@ -177,6 +178,31 @@ func (k *Keeper) GetLastCheckpoint(ctx sdk.Context) (hmTypes.Checkpoint, error)
 	return _checkpoint, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "Checkpoint Not Found")
 }
 
+// GetCheckpointByBlockNumber returns the acknowledged checkpoint covering the
+// given bor block along with its number
+func (k *Keeper) GetCheckpointByBlockNumber(ctx sdk.Context, blockNumber uint64) (uint64, hmTypes.Checkpoint, error) {
+	// checkpoints cover contiguous ascending block ranges
+	low, high := uint64(1), k.GetACKCount(ctx)
+	for low <= high {
+		number := low + (high-low)/2
+		checkpoint, err := k.GetCheckpointByNumber(ctx, number)
+		if err != nil {
+			return 0, checkpoint, err
+		}
+
+		switch {
+		case blockNumber < checkpoint.StartBlock:
+			high = number - 1
+		case blockNumber > checkpoint.EndBlock:
+			low = number + 1
+		default:
+			return number, checkpoint, nil
+		}
+	}
+
+	return 0, hmTypes.Checkpoint{}, errors.New("no checkpoint found for block")
+}
+
 // GetCheckpointKey appends prefix to checkpointNumber
 func GetCheckpointKey(checkpointNumber uint64) []byte {
 	checkpointNumberBytes := []byte(strconv.FormatUint(checkpointNumber, 10))
@@ -83,12 +83,8 @@ func (k msgServer) Checkpoint(goCtx context.Context, msg *types.MsgCheckpoint) (
 	//
 
 	// Make sure latest AccountRootHash matches
-	// Calculate new account root hash
-	dividendAccounts := k.moduleCommunicator.GetAllDividendAccounts(ctx)
-	logger.Debug("DividendAccounts of all validators", "dividendAccountsLength", len(dividendAccounts))
-
 	// Get account root has from dividend accounts
-	accountRoot, err := types.GetAccountRootHash(dividendAccounts)
+	accountRoot, err := k.moduleCommunicator.GetDividendAccountRootHash(ctx)
 	if err != nil {
 		logger.Error("Error while fetching account root hash", "error", err)
 		return nil, types.ErrBadBlockDetails
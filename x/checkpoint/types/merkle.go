@@ -3,10 +3,11 @@ package types
 import (
 	"bytes"
 	"errors"
+	"math/big"
 
 	"github.com/cbergoon/merkletree"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
 	"github.com/tendermint/crypto/sha3"
 
 	"github.com/maticnetwork/heimdall/helper"
@@ -44,6 +45,25 @@ func GetAccountRootHash(dividendAccounts []*hmTypes.DividendAccount) ([]byte, er
 	return tree.Root.Hash, nil
 }
 
+// GetLegacyAccountRootHash returns roothash of Validator Account State Tree with the
+// leaves hashed as before they matched the root chain claimFee encoding
+func GetLegacyAccountRootHash(dividendAccounts []*hmTypes.DividendAccount) ([]byte, error) {
+	// Sort the dividendAccounts by ID
+	dividendAccounts = hmTypes.SortDividendAccountByAddress(dividendAccounts)
+	var list []merkletree.Content
+
+	for i := 0; i < len(dividendAccounts); i++ {
+		list = append(list, &hmTypes.LegacyDividendAccount{DividendAccount: *dividendAccounts[i]})
+	}
+
+	tree, err := merkletree.NewTreeWithHashStrategy(list, sha3.NewLegacyKeccak256)
+	if err != nil {
+		return nil, err
+	}
+
+	return tree.Root.Hash, nil
+}
+
 // GetAccountTree returns roothash of Validator Account State Tree
 func GetAccountTree(dividendAccounts []*hmTypes.DividendAccount) (*merkletree.MerkleTree, error) {
 	// Sort the dividendAccounts by ID
@@ -62,7 +82,7 @@ func GetAccountTree(dividendAccounts []*hmTypes.DividendAccount) (*merkletree.Me
 	return tree, nil
 }
 
-// GetAccountProof returns proof of dividend Account
+// GetAccountProof returns proof of dividend Account and its index in the account tree
 func GetAccountProof(dividendAccounts []*hmTypes.DividendAccount, userAddr sdk.AccAddress) ([]byte, uint64, error) {
 	// Sort the dividendAccounts by user address
 	dividendAccounts = hmTypes.SortDividendAccountByAddress(dividendAccounts)
@@ -72,37 +92,121 @@ func GetAccountProof(dividendAccounts []*hmTypes.DividendAccount, userAddr sdk.A
 	for i := 0; i < len(dividendAccounts); i++ {
 		list = append(list, dividendAccounts[i])
 		accAddr, _ := sdk.AccAddressFromHex(dividendAccounts[i].User)
-		if accAddr.Equals(userAddr) {
+		if account == nil && accAddr.Equals(userAddr) {
 			account = dividendAccounts[i]
 			index = uint64(i)
 		}
 	}
 
+	if account == nil {
+		return nil, 0, errors.New("dividend account not found")
+	}
+
 	tree, err := merkletree.NewTreeWithHashStrategy(list, sha3.NewLegacyKeccak256)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	branchArray, _, err := tree.GetMerklePath(account)
+	if err != nil {
+		return nil, 0, err
+	}
 
 	// concatenate branch array
 	proof := appendBytes32(branchArray...)
-	return proof, index, err
+	return proof, index, nil
 }
 
-// VerifyAccountProof returns proof of dividend Account
-func VerifyAccountProof(dividendAccounts []*hmTypes.DividendAccount, userAddr sdk.AccAddress, proofToVerify string) (bool, error) {
-	proof, _, err := GetAccountProof(dividendAccounts, userAddr)
+// VerifyAccountProof verifies the proof of a dividend account against the account
+// root hash the same way the root chain does on claimFee
+func VerifyAccountProof(accountRootHash []byte, dividendAccount hmTypes.DividendAccount, index uint64, proof []byte) (bool, error) {
+	leaf, err := dividendAccount.CalculateHash()
 	if err != nil {
-		return false, nil
+		return false, err
 	}
 
-	// check proof bytes
-	if bytes.Equal(common.FromHex(proofToVerify), proof) {
-		return true, nil
+	return checkMembership(leaf, index, accountRootHash, proof)
+}
+
+// GetBlockHeaderLeaf returns the leaf of a bor block header in the checkpoint root hash tree
+func GetBlockHeaderLeaf(header *ethTypes.Header) []byte {
+	return keccak256(appendBytes32(
+		header.Number.Bytes(),
+		new(big.Int).SetUint64(header.Time).Bytes(),
+		header.TxHash.Bytes(),
+		header.ReceiptHash.Bytes(),
+	))
+}
+
+// GetBlockInclusionProof returns the proof of the block at given index in the
+// checkpoint root hash tree built from the bor block headers of the checkpoint
+func GetBlockInclusionProof(headers []*ethTypes.Header, index uint64) (proof []byte, rootHash []byte, err error) {
+	if index >= uint64(len(headers)) {
+		return nil, nil, errors.New("block index out of checkpoint range")
 	}
 
-	return false, nil
+	// leaves are padded with empty hashes up to the next power of two
+	layer := make([][]byte, nextPowerOfTwo(uint64(len(headers))))
+	for i := range layer {
+		if i < len(headers) {
+			layer[i] = GetBlockHeaderLeaf(headers[i])
+		} else {
+			layer[i] = make([]byte, 32)
+		}
+	}
+
+	for len(layer) > 1 {
+		proof = append(proof, layer[index^1]...)
+
+		parents := make([][]byte, len(layer)/2)
+		for i := range parents {
+			parents[i] = keccak256(layer[2*i], layer[2*i+1])
+		}
+
+		layer = parents
+		index /= 2
+	}
+
+	return proof, layer[0], nil
+}
+
+// VerifyBlockInclusionProof verifies the proof of a bor block header against the
+// checkpoint root hash the same way the root chain does on exits
+func VerifyBlockInclusionProof(rootHash []byte, header *ethTypes.Header, index uint64, proof []byte) (bool, error) {
+	return checkMembership(GetBlockHeaderLeaf(header), index, rootHash, proof)
+}
+
+// checkMembership verifies the merkle proof of a leaf at given index against the root hash
+func checkMembership(leaf []byte, index uint64, rootHash []byte, proof []byte) (bool, error) {
+	if len(proof)%32 != 0 {
+		return false, errors.New("invalid proof length")
+	}
+
+	depth := len(proof) / 32
+	if depth < 64 && index >= uint64(1)<<uint(depth) {
+		return false, errors.New("leaf index is too big")
+	}
+
+	computedHash := leaf
+	for i := 0; i < len(proof); i += 32 {
+		sibling := proof[i : i+32]
+		if index%2 == 0 {
+			computedHash = keccak256(computedHash, sibling)
+		} else {
+			computedHash = keccak256(sibling, computedHash)
+		}
+		index /= 2
+	}
+
+	return bytes.Equal(computedHash, rootHash), nil
+}
+
+func keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d) //nolint
+	}
+	return h.Sum(nil)
 }
 
 //
@@ -136,22 +240,22 @@ func appendBytes32(data ...[]byte) []byte {
 	return result
 }
 
-//
-//func nextPowerOfTwo(n uint64) uint64 {
-//	if n == 0 {
-//		return 1
-//	}
-//	// http://graphics.stanford.edu/~seander/bithacks.html#RoundUpPowerOf2
-//	n--
-//	n |= n >> 1
-//	n |= n >> 2
-//	n |= n >> 4
-//	n |= n >> 8
-//	n |= n >> 16
-//	n |= n >> 32
-//	n++
-//	return n
-//}
+func nextPowerOfTwo(n uint64) uint64 {
+	if n == 0 {
+		return 1
+	}
+	// http://graphics.stanford.edu/~seander/bithacks.html#RoundUpPowerOf2
+	n--
+	n |= n >> 1
+	n |= n >> 2
+	n |= n >> 4
+	n |= n >> 8
+	n |= n >> 16
+	n |= n >> 32
+	n++
+	return n
+}
+
 //
 //// spins go-routines to fetch batch elements to allow creation of large merkle trees
 //func fetchBatchElements(rpcClient *rpc.Client, elements []rpc.BatchElem, checkpointLength uint64) (err error) {
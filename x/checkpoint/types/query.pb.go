@@ -738,6 +738,165 @@ func (m *QueryLatestCheckpointResponse) GetLatestCheckpoint() *types.Checkpoint
 	return nil
 }
 
+// QueryBlockInclusionProofRequest is request for the proof of a bor block,
+// given by its number or the hash of one of its transactions
+type QueryBlockInclusionProofRequest struct {
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty" yaml:"block_number"`
+	TxHash      string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
+}
+
+func (m *QueryBlockInclusionProofRequest) Reset()         { *m = QueryBlockInclusionProofRequest{} }
+func (m *QueryBlockInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockInclusionProofRequest) ProtoMessage()    {}
+func (*QueryBlockInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67796a25ee620ee, []int{17}
+}
+func (m *QueryBlockInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockInclusionProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockInclusionProofRequest.Merge(m, src)
+}
+func (m *QueryBlockInclusionProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockInclusionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockInclusionProofRequest proto.InternalMessageInfo
+
+func (m *QueryBlockInclusionProofRequest) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QueryBlockInclusionProofRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// QueryBlockInclusionProofResponse is response for the proof of a bor block
+type QueryBlockInclusionProofResponse struct {
+	CheckpointNumber uint64 `protobuf:"varint,1,opt,name=checkpoint_number,json=checkpointNumber,proto3" json:"checkpoint_number,omitempty" yaml:"checkpoint_number"`
+	// header block number of the checkpoint on the root chain
+	HeaderBlockNumber uint64            `protobuf:"varint,2,opt,name=header_block_number,json=headerBlockNumber,proto3" json:"header_block_number,omitempty" yaml:"header_block_number"`
+	Checkpoint        *types.Checkpoint `protobuf:"bytes,3,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	BlockNumber       uint64            `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty" yaml:"block_number"`
+	BlockHash         string            `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty" yaml:"block_hash"`
+	// index of the block leaf in the checkpoint tree
+	Index uint64 `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	Leaf  string `protobuf:"bytes,7,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	// hex encoded concatenation of the sibling hashes from the leaf up to
+	// the root
+	Proof string `protobuf:"bytes,8,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryBlockInclusionProofResponse) Reset()         { *m = QueryBlockInclusionProofResponse{} }
+func (m *QueryBlockInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockInclusionProofResponse) ProtoMessage()    {}
+func (*QueryBlockInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67796a25ee620ee, []int{18}
+}
+func (m *QueryBlockInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockInclusionProofResponse.Merge(m, src)
+}
+func (m *QueryBlockInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockInclusionProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockInclusionProofResponse proto.InternalMessageInfo
+
+func (m *QueryBlockInclusionProofResponse) GetCheckpointNumber() uint64 {
+	if m != nil {
+		return m.CheckpointNumber
+	}
+	return 0
+}
+
+func (m *QueryBlockInclusionProofResponse) GetHeaderBlockNumber() uint64 {
+	if m != nil {
+		return m.HeaderBlockNumber
+	}
+	return 0
+}
+
+func (m *QueryBlockInclusionProofResponse) GetCheckpoint() *types.Checkpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+func (m *QueryBlockInclusionProofResponse) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QueryBlockInclusionProofResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *QueryBlockInclusionProofResponse) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *QueryBlockInclusionProofResponse) GetLeaf() string {
+	if m != nil {
+		return m.Leaf
+	}
+	return ""
+}
+
+func (m *QueryBlockInclusionProofResponse) GetProof() string {
+	if m != nil {
+		return m.Proof
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "heimdall.checkpoint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "heimdall.checkpoint.v1beta1.QueryParamsResponse")
@@ -756,6 +915,8 @@ func init() {
 	proto.RegisterType((*QueryBorChainID)(nil), "heimdall.checkpoint.v1beta1.QueryBorChainID")
 	proto.RegisterType((*QueryLatestCheckpointRequest)(nil), "heimdall.checkpoint.v1beta1.QueryLatestCheckpointRequest")
 	proto.RegisterType((*QueryLatestCheckpointResponse)(nil), "heimdall.checkpoint.v1beta1.QueryLatestCheckpointResponse")
+	proto.RegisterType((*QueryBlockInclusionProofRequest)(nil), "heimdall.checkpoint.v1beta1.QueryBlockInclusionProofRequest")
+	proto.RegisterType((*QueryBlockInclusionProofResponse)(nil), "heimdall.checkpoint.v1beta1.QueryBlockInclusionProofResponse")
 }
 
 func init() {
@@ -763,62 +924,77 @@ func init() {
 }
 
 var fileDescriptor_e67796a25ee620ee = []byte{
-	// 867 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x97, 0x25, 0x6a, 0x5e, 0xa4, 0x76, 0x19, 0x4a, 0x77, 0xe5, 0x2e, 0x0e, 0xb8, 0x2a,
-	0x94, 0x85, 0xd8, 0x69, 0x52, 0x89, 0x52, 0x71, 0x69, 0x02, 0x5a, 0x21, 0x75, 0x57, 0x10, 0x2e,
-	0x88, 0x4b, 0x34, 0x76, 0xa7, 0x8e, 0x65, 0xc7, 0x93, 0x7a, 0x26, 0xd0, 0x0a, 0x71, 0xe1, 0x04,
-	0x37, 0x24, 0x2e, 0x1c, 0xcb, 0x85, 0x3b, 0x07, 0x90, 0xf8, 0x07, 0x3d, 0x56, 0xe2, 0xc2, 0x09,
-	0xa1, 0x96, 0x03, 0x3f, 0x03, 0x65, 0x3c, 0x8e, 0x1d, 0x27, 0x75, 0x6d, 0x6e, 0xee, 0x9b, 0xf7,
-	0xbe, 0xf7, 0xbd, 0xf7, 0xbe, 0x7e, 0x0a, 0xbc, 0x39, 0x24, 0xee, 0xe8, 0x18, 0xfb, 0xbe, 0x69,
-	0x0f, 0x89, 0xed, 0x8d, 0xa9, 0x1b, 0x70, 0xf3, 0x8b, 0x5d, 0x8b, 0x70, 0xbc, 0x6b, 0x9e, 0x4e,
-	0x48, 0x78, 0x6e, 0x8c, 0x43, 0xca, 0x29, 0xda, 0x8c, 0x13, 0x8d, 0x24, 0xd1, 0x90, 0x89, 0xea,
-	0x5b, 0x79, 0x28, 0x0e, 0x09, 0x08, 0x73, 0x59, 0x84, 0xa3, 0x6e, 0xe7, 0xa5, 0x8e, 0x98, 0x23,
-	0xd3, 0x1e, 0x3b, 0x94, 0x3a, 0x3e, 0x31, 0xf1, 0xd8, 0x35, 0x71, 0x10, 0x50, 0x8e, 0xb9, 0x4b,
-	0x83, 0x18, 0x64, 0x6b, 0x06, 0x62, 0x61, 0x46, 0x66, 0xe5, 0x43, 0x82, 0x8f, 0x49, 0x18, 0x27,
-	0xbd, 0xbe, 0x3c, 0x29, 0x35, 0x94, 0xba, 0xee, 0x50, 0x87, 0x8a, 0x4f, 0x73, 0xfa, 0x15, 0x45,
-	0xf5, 0x75, 0x40, 0x9f, 0x4c, 0x93, 0x3e, 0xc6, 0x21, 0x1e, 0xb1, 0x3e, 0x39, 0x9d, 0x10, 0xc6,
-	0xf5, 0xcf, 0xe0, 0xe5, 0xb9, 0x28, 0x1b, 0xd3, 0x80, 0x11, 0x74, 0x08, 0xd5, 0xb1, 0x88, 0x3c,
-	0x52, 0x5e, 0x53, 0x76, 0xea, 0xed, 0x2d, 0x23, 0x67, 0x51, 0x46, 0x54, 0xdc, 0xbd, 0x7f, 0xf9,
-	0x57, 0xa3, 0xd2, 0x97, 0x85, 0xfa, 0x06, 0xac, 0x0b, 0xe4, 0x43, 0xdb, 0xeb, 0xd1, 0x49, 0xc0,
-	0xe3, 0x8e, 0x7b, 0xf0, 0x4a, 0x26, 0x2e, 0x7b, 0x6e, 0x42, 0x0d, 0xdb, 0xde, 0xc0, 0x9e, 0x06,
-	0x45, 0xdb, 0xfb, 0xfd, 0x15, 0x2c, 0x93, 0xf4, 0x16, 0x6c, 0x88, 0xaa, 0xde, 0xac, 0xbb, 0xc4,
-	0x43, 0x1b, 0x50, 0x0d, 0x26, 0x23, 0x8b, 0x84, 0xb2, 0x46, 0xfe, 0xa5, 0x87, 0xf0, 0x70, 0xa1,
-	0xa2, 0x40, 0x27, 0x74, 0x00, 0x90, 0x8c, 0xf8, 0xe8, 0x9e, 0x18, 0x5f, 0x4d, 0xc6, 0xe7, 0xe7,
-	0x63, 0xc2, 0x8c, 0x14, 0x68, 0x2a, 0x5b, 0xd7, 0xe0, 0x71, 0xa6, 0x67, 0x77, 0x72, 0x72, 0x42,
-	0xc2, 0x78, 0xf6, 0x21, 0xbc, 0x7a, 0xcb, 0xbb, 0x64, 0xf6, 0x14, 0x5e, 0x4a, 0xe0, 0x06, 0x96,
-	0x78, 0x94, 0x27, 0xc8, 0xe3, 0xf0, 0xc0, 0xce, 0x00, 0xea, 0x0f, 0xe5, 0x96, 0x8f, 0x30, 0xe3,
-	0xcf, 0xe9, 0xa1, 0xed, 0xc5, 0x14, 0xf6, 0xe5, 0x22, 0x53, 0x0f, 0xb2, 0xb7, 0x06, 0x75, 0x1f,
-	0x33, 0x3e, 0x08, 0xe8, 0x00, 0xdb, 0x9e, 0xdc, 0x4b, 0xcd, 0x8f, 0xf3, 0x74, 0x1b, 0xd4, 0x0c,
-	0xf9, 0x23, 0x97, 0xcd, 0xce, 0xf0, 0x21, 0xc0, 0x18, 0x3b, 0x6e, 0x20, 0x14, 0x2d, 0x29, 0x6f,
-	0x67, 0x29, 0x4b, 0xa9, 0xc5, 0x69, 0x52, 0x74, 0xa9, 0x42, 0xdd, 0x82, 0xcd, 0xa5, 0x4d, 0x24,
-	0xc7, 0x1e, 0xac, 0xa5, 0xf6, 0xe3, 0xbb, 0x6c, 0x7a, 0xbf, 0x17, 0xee, 0xd8, 0xce, 0xaa, 0x3d,
-	0x07, 0xa6, 0x3f, 0x95, 0x83, 0x3c, 0x27, 0x67, 0x7c, 0x51, 0x4f, 0x0d, 0xa8, 0x5b, 0x34, 0x1c,
-	0xd8, 0x43, 0xec, 0x06, 0x1f, 0x7d, 0x20, 0x26, 0xa9, 0xf5, 0xc1, 0xa2, 0x61, 0x2f, 0x8a, 0x1c,
-	0xac, 0x7c, 0x7b, 0xd1, 0xa8, 0xfc, 0x7b, 0xd1, 0xa8, 0xe8, 0xa1, 0x24, 0x9b, 0x05, 0x92, 0x64,
-	0x3f, 0x85, 0xb5, 0x80, 0x9c, 0xf1, 0x41, 0x4a, 0x4e, 0xd1, 0x5e, 0x9e, 0xe4, 0xfe, 0x37, 0x3d,
-	0x63, 0x4e, 0x9a, 0x7c, 0x30, 0x07, 0xae, 0xbf, 0x0f, 0x6b, 0xa2, 0x67, 0x77, 0x46, 0xa8, 0x0c,
-	0xe3, 0x58, 0xa0, 0x47, 0x98, 0x13, 0xb6, 0x38, 0xfc, 0x4c, 0xa0, 0x8b, 0xef, 0x89, 0x40, 0x7d,
-	0xf1, 0xb6, 0x38, 0x55, 0xae, 0x40, 0xfd, 0x0c, 0x60, 0xfb, 0xbb, 0x3a, 0xbc, 0x28, 0x5a, 0xa1,
-	0x1f, 0x15, 0xa8, 0x46, 0x4a, 0x40, 0x66, 0xee, 0x62, 0x16, 0xed, 0x4b, 0x6d, 0x15, 0x2f, 0x88,
-	0x06, 0xd0, 0xdf, 0xfe, 0xe6, 0x8f, 0x7f, 0x7e, 0xb8, 0xb7, 0x8d, 0xb6, 0xcc, 0x3c, 0xcb, 0x8e,
-	0x3c, 0x0c, 0xfd, 0xa4, 0xc0, 0x4a, 0xec, 0x53, 0x68, 0xf7, 0xee, 0x5e, 0x19, 0xaf, 0x53, 0xdb,
-	0x65, 0x4a, 0x24, 0x41, 0x43, 0x10, 0xdc, 0x41, 0x6f, 0xe4, 0x12, 0xc4, 0xb6, 0xd7, 0x14, 0xfe,
-	0x85, 0x7e, 0x51, 0x00, 0x92, 0xbd, 0xa2, 0xce, 0xdd, 0x2d, 0x17, 0xce, 0xae, 0xee, 0x95, 0x2b,
-	0x92, 0x4c, 0xf7, 0x05, 0xd3, 0x36, 0x6a, 0xe5, 0x32, 0x4d, 0x85, 0xbe, 0x8a, 0xac, 0xf9, 0x6b,
-	0xf4, 0x9b, 0x02, 0x0f, 0xb2, 0x1e, 0x88, 0xde, 0x2b, 0x43, 0x62, 0xce, 0x57, 0xd5, 0x83, 0xff,
-	0x53, 0x5a, 0x4a, 0x10, 0x91, 0x15, 0xa3, 0x9f, 0x15, 0xa8, 0xcd, 0x9c, 0x13, 0x15, 0x38, 0x6f,
-	0xd6, 0x7f, 0xd5, 0x4e, 0xa9, 0x1a, 0xc9, 0xb1, 0x25, 0x38, 0x3e, 0x41, 0x3b, 0xb9, 0x1c, 0xa7,
-	0x56, 0xdd, 0x0c, 0x68, 0x13, 0xdb, 0x1e, 0xfa, 0x55, 0x81, 0xd5, 0x79, 0x0f, 0x45, 0xef, 0x96,
-	0x59, 0x52, 0xca, 0xda, 0xd5, 0xfd, 0xf2, 0x85, 0xa5, 0x78, 0x27, 0x21, 0x86, 0x7e, 0x57, 0x60,
-	0x75, 0xde, 0x4e, 0x8b, 0xf0, 0x5e, 0xea, 0xe4, 0x45, 0x78, 0x2f, 0x77, 0x6e, 0x7d, 0x4f, 0xf0,
-	0x36, 0xd0, 0x3b, 0xb9, 0xbc, 0xa7, 0xce, 0xdc, 0x4c, 0xe2, 0x42, 0xd5, 0x59, 0xe3, 0x2c, 0xa2,
-	0xea, 0x5b, 0xcc, 0xb8, 0x88, 0xaa, 0x6f, 0xf3, 0xe9, 0x82, 0xaa, 0x8e, 0x5c, 0xb9, 0xfb, 0xec,
-	0xf2, 0x5a, 0x53, 0xae, 0xae, 0x35, 0xe5, 0xef, 0x6b, 0x4d, 0xf9, 0xfe, 0x46, 0xab, 0x5c, 0xdd,
-	0x68, 0x95, 0x3f, 0x6f, 0xb4, 0xca, 0xe7, 0x1d, 0xc7, 0xe5, 0xc3, 0x89, 0x65, 0xd8, 0x74, 0x64,
-	0x8e, 0x30, 0x77, 0xed, 0x80, 0xf0, 0x2f, 0x69, 0xe8, 0x25, 0xa8, 0x67, 0x69, 0x5c, 0xe1, 0xfb,
-	0x56, 0x55, 0xfc, 0xe0, 0xec, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xb0, 0x1e, 0x1e, 0x86,
-	0x0b, 0x00, 0x00,
+	// 1110 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xb7, 0x6d, 0xb6, 0x79, 0x5d, 0xb5, 0xdb, 0x69, 0xb7, 0x8d, 0xdc, 0x12, 0x2f, 0xae,
+	0x0a, 0x65, 0x97, 0x24, 0xfd, 0x11, 0x89, 0x12, 0x2d, 0x87, 0xa6, 0xa0, 0xa5, 0x52, 0xb7, 0x82,
+	0x70, 0x41, 0x5c, 0xa2, 0xb1, 0x3b, 0x4d, 0xac, 0x38, 0x9e, 0xac, 0xed, 0x40, 0x2a, 0xc4, 0x85,
+	0x13, 0xe2, 0x84, 0xc4, 0x85, 0xe3, 0x72, 0xe1, 0xc2, 0x89, 0x03, 0x48, 0xfc, 0x07, 0x7b, 0x42,
+	0x2b, 0x71, 0x41, 0x1c, 0x22, 0xd4, 0x72, 0xe0, 0x9c, 0xbf, 0x00, 0x79, 0x66, 0x9c, 0xd8, 0x4e,
+	0xea, 0xc4, 0xbd, 0x79, 0xde, 0xbc, 0x1f, 0xdf, 0x7b, 0xf3, 0xcd, 0x7c, 0x09, 0xbc, 0xd9, 0x20,
+	0x46, 0xeb, 0x1c, 0x9b, 0x66, 0x51, 0x6f, 0x10, 0xbd, 0xd9, 0xa6, 0x86, 0xe5, 0x16, 0x3f, 0xdf,
+	0xd3, 0x88, 0x8b, 0xf7, 0x8a, 0xcf, 0x3b, 0xc4, 0xbe, 0x2c, 0xb4, 0x6d, 0xea, 0x52, 0xb4, 0xe1,
+	0x3b, 0x16, 0x86, 0x8e, 0x05, 0xe1, 0x28, 0xbf, 0x15, 0x97, 0xa5, 0x4e, 0x2c, 0xe2, 0x18, 0x0e,
+	0xcf, 0x23, 0x6f, 0xc7, 0xb9, 0xb6, 0x9c, 0xba, 0x70, 0xdb, 0xac, 0x53, 0x5a, 0x37, 0x49, 0x11,
+	0xb7, 0x8d, 0x22, 0xb6, 0x2c, 0xea, 0x62, 0xd7, 0xa0, 0x96, 0x9f, 0x64, 0x6b, 0x90, 0x44, 0xc3,
+	0x0e, 0x19, 0x84, 0x37, 0x08, 0x3e, 0x27, 0xb6, 0xef, 0xf4, 0xfa, 0x78, 0xa7, 0x40, 0x53, 0xf2,
+	0x6a, 0x9d, 0xd6, 0x29, 0xfb, 0x2c, 0x7a, 0x5f, 0xdc, 0xaa, 0xae, 0x02, 0xfa, 0xd8, 0x73, 0xfa,
+	0x08, 0xdb, 0xb8, 0xe5, 0x54, 0xc9, 0xf3, 0x0e, 0x71, 0x5c, 0xf5, 0x53, 0x58, 0x09, 0x59, 0x9d,
+	0x36, 0xb5, 0x1c, 0x82, 0x8e, 0x20, 0xdd, 0x66, 0x96, 0xac, 0xf4, 0x50, 0xda, 0x59, 0xd8, 0xdf,
+	0x2a, 0xc4, 0x0c, 0xaa, 0xc0, 0x83, 0x2b, 0xb3, 0x2f, 0x7b, 0x4a, 0xaa, 0x2a, 0x02, 0xd5, 0x35,
+	0x58, 0x65, 0x99, 0x8f, 0xf4, 0xe6, 0x31, 0xed, 0x58, 0xae, 0x5f, 0xb1, 0x04, 0x0f, 0x22, 0x76,
+	0x51, 0x73, 0x03, 0x32, 0x58, 0x6f, 0xd6, 0x74, 0xcf, 0xc8, 0xca, 0xce, 0x56, 0xe7, 0xb1, 0x70,
+	0x52, 0x77, 0x61, 0x8d, 0x45, 0x1d, 0x0f, 0xaa, 0x8b, 0x7c, 0x68, 0x0d, 0xd2, 0x56, 0xa7, 0xa5,
+	0x11, 0x5b, 0xc4, 0x88, 0x95, 0x6a, 0xc3, 0xfa, 0x48, 0xc4, 0x14, 0x95, 0x50, 0x19, 0x60, 0xd8,
+	0x62, 0xf6, 0x0e, 0x6b, 0x5f, 0x1e, 0xb6, 0xef, 0x5e, 0xb6, 0x89, 0x53, 0x08, 0x24, 0x0d, 0x78,
+	0xab, 0x39, 0xd8, 0x8c, 0xd4, 0xac, 0x74, 0x2e, 0x2e, 0x88, 0xed, 0xf7, 0xde, 0x80, 0xd7, 0x6e,
+	0xd8, 0x17, 0xc8, 0x9e, 0xc2, 0xf2, 0x30, 0x5d, 0x4d, 0x63, 0x9b, 0x59, 0x69, 0x22, 0x86, 0xfb,
+	0x7a, 0x24, 0xa1, 0xba, 0x2e, 0xa6, 0x7c, 0x8a, 0x1d, 0xf7, 0x8c, 0x1e, 0xe9, 0x4d, 0x1f, 0xc2,
+	0x21, 0xac, 0x45, 0x37, 0x44, 0xed, 0x1c, 0x2c, 0x98, 0xd8, 0x71, 0x6b, 0x16, 0xad, 0x61, 0xbd,
+	0x29, 0xe6, 0x92, 0x31, 0x7d, 0x3f, 0x55, 0x07, 0x39, 0x02, 0xfe, 0xd4, 0x70, 0x06, 0xc7, 0xf0,
+	0x01, 0x40, 0x1b, 0xd7, 0x0d, 0x8b, 0x31, 0x5a, 0x40, 0xde, 0x8e, 0x42, 0x16, 0x54, 0xf3, 0xdd,
+	0x04, 0xe9, 0x02, 0x81, 0xaa, 0x06, 0x1b, 0x63, 0x8b, 0x08, 0x8c, 0xc7, 0xb0, 0x14, 0x98, 0x8f,
+	0x69, 0x38, 0xde, 0xf9, 0xcd, 0x4c, 0x98, 0xce, 0xa2, 0x1e, 0x4a, 0xa6, 0x3e, 0x15, 0x8d, 0x9c,
+	0x91, 0xae, 0x3b, 0xca, 0x27, 0x05, 0x16, 0x34, 0x6a, 0xd7, 0xf4, 0x06, 0x36, 0xac, 0x93, 0xf7,
+	0x59, 0x27, 0x99, 0x2a, 0x68, 0xd4, 0x3e, 0xe6, 0x96, 0xf2, 0xfc, 0x37, 0x2f, 0x94, 0xd4, 0x7f,
+	0x2f, 0x94, 0x94, 0x6a, 0xc3, 0xc6, 0xd8, 0x44, 0x02, 0xec, 0x27, 0xb0, 0x64, 0x91, 0xae, 0x5b,
+	0x0b, 0xd0, 0x89, 0xcf, 0xe5, 0x51, 0xec, 0x6d, 0x7a, 0xe6, 0xd4, 0x83, 0xe0, 0xad, 0x50, 0x72,
+	0xf5, 0x09, 0x2c, 0xb1, 0x9a, 0x95, 0x01, 0xa0, 0x24, 0x88, 0x7d, 0x82, 0x9e, 0x62, 0x97, 0x38,
+	0xa3, 0xcd, 0x0f, 0x08, 0x3a, 0xba, 0x3f, 0x24, 0xa8, 0xc9, 0xf6, 0x46, 0xbb, 0x8a, 0x25, 0xa8,
+	0x19, 0x49, 0xa8, 0x7e, 0x2b, 0x81, 0xc2, 0x1b, 0x31, 0xa9, 0xde, 0x3c, 0xb1, 0x74, 0xb3, 0xe3,
+	0x78, 0x94, 0xb0, 0x29, 0xbd, 0xf0, 0x8f, 0xa2, 0x0c, 0xf7, 0x34, 0x6f, 0xb7, 0x16, 0xbc, 0xe0,
+	0x95, 0xf5, 0x7e, 0x4f, 0x59, 0xb9, 0xc4, 0x2d, 0xb3, 0xac, 0x06, 0x77, 0xd5, 0xea, 0x02, 0x5b,
+	0x9e, 0xb1, 0x15, 0x7a, 0x0c, 0x77, 0xdd, 0x6e, 0xad, 0x81, 0x9d, 0x06, 0xbb, 0xc3, 0x99, 0x0a,
+	0xea, 0xf7, 0x94, 0x45, 0x1e, 0x26, 0x36, 0xd4, 0x6a, 0xda, 0xed, 0x7e, 0xe8, 0x7d, 0xfc, 0x3c,
+	0x03, 0x0f, 0x6f, 0x06, 0x23, 0x5a, 0x3f, 0x09, 0xdd, 0xcd, 0x10, 0xa4, 0xcd, 0x7e, 0x4f, 0xc9,
+	0xf2, 0xdc, 0x23, 0x2e, 0x6a, 0xf0, 0x76, 0x0a, 0x70, 0x67, 0xb0, 0xc2, 0x5f, 0xf5, 0x5a, 0xa8,
+	0xbf, 0x3b, 0x2c, 0x59, 0xae, 0xdf, 0x53, 0x64, 0x9e, 0x6c, 0x8c, 0x93, 0x5a, 0x5d, 0xe6, 0xd6,
+	0x4a, 0xa0, 0xd9, 0xf0, 0x9b, 0x35, 0x93, 0xe4, 0xcd, 0x1a, 0x19, 0xf2, 0x6c, 0x82, 0x21, 0x97,
+	0x00, 0xf8, 0x2e, 0x9b, 0xf3, 0x1c, 0x9b, 0xf3, 0x83, 0x7e, 0x4f, 0x59, 0x0e, 0x46, 0xf2, 0x51,
+	0x67, 0xd8, 0xc2, 0x9b, 0x36, 0x5a, 0x85, 0x39, 0xc3, 0x3a, 0x27, 0xdd, 0x6c, 0x9a, 0x3d, 0x31,
+	0x7c, 0x81, 0x10, 0xcc, 0x9a, 0x04, 0x5f, 0x64, 0xef, 0x32, 0xfa, 0xb2, 0x6f, 0xcf, 0xb3, 0xed,
+	0x9d, 0x41, 0x76, 0x9e, 0x19, 0xf9, 0x62, 0xff, 0xef, 0x7b, 0x30, 0xc7, 0x4e, 0x0b, 0xfd, 0x20,
+	0x41, 0x9a, 0x3f, 0x22, 0xa8, 0x18, 0x7b, 0xa7, 0x46, 0x95, 0x4f, 0xde, 0x9d, 0x3e, 0x80, 0x13,
+	0x40, 0x7d, 0xfc, 0xf5, 0x9f, 0xff, 0x7e, 0x7f, 0x67, 0x1b, 0x6d, 0x15, 0xe3, 0xd4, 0x9e, 0xcb,
+	0x1f, 0xfa, 0x51, 0x82, 0x79, 0x5f, 0xe2, 0xd0, 0xde, 0xe4, 0x5a, 0x11, 0x99, 0x94, 0xf7, 0x93,
+	0x84, 0x08, 0x80, 0x05, 0x06, 0x70, 0x07, 0xbd, 0x11, 0x0b, 0x10, 0xeb, 0xcd, 0x3c, 0x93, 0x3e,
+	0xf4, 0x8b, 0x04, 0x30, 0x64, 0x05, 0x3a, 0x98, 0x5c, 0x72, 0xe4, 0xc5, 0x90, 0x4b, 0xc9, 0x82,
+	0x04, 0xd2, 0x43, 0x86, 0x74, 0x1f, 0xed, 0xc6, 0x22, 0x0d, 0x98, 0xbe, 0xe4, 0xfc, 0xfb, 0x0a,
+	0xfd, 0x26, 0xc1, 0xfd, 0xa8, 0x7c, 0xa2, 0x77, 0x93, 0x80, 0x08, 0x49, 0xb2, 0x5c, 0xbe, 0x4d,
+	0x68, 0x22, 0x42, 0x70, 0x15, 0x47, 0x3f, 0x49, 0x90, 0x19, 0x88, 0x2e, 0x9a, 0xe2, 0x78, 0xa3,
+	0xd2, 0x2d, 0x1f, 0x24, 0x8a, 0x11, 0x18, 0x77, 0x19, 0xc6, 0x47, 0x68, 0x27, 0x16, 0xa3, 0xa7,
+	0xf2, 0x79, 0x8b, 0xe6, 0xb1, 0xde, 0x44, 0xbf, 0x4a, 0xb0, 0x18, 0x96, 0x5f, 0xf4, 0x4e, 0x92,
+	0x21, 0x05, 0x7e, 0x15, 0xc8, 0x87, 0xc9, 0x03, 0x13, 0xe1, 0x1e, 0x9a, 0x1c, 0xf4, 0xbb, 0x04,
+	0x8b, 0x61, 0x25, 0x9e, 0x06, 0xf7, 0xd8, 0x1f, 0x01, 0xf2, 0x61, 0xf2, 0x40, 0x81, 0xbb, 0xc4,
+	0x70, 0x17, 0xd0, 0xdb, 0xb1, 0xb8, 0x3d, 0x51, 0xcf, 0x0f, 0xed, 0x8c, 0xd5, 0x51, 0xcd, 0x9d,
+	0x86, 0xd5, 0x37, 0xe8, 0xb8, 0x5c, 0xbe, 0x4d, 0x68, 0x22, 0x56, 0x73, 0x41, 0x47, 0x7f, 0x48,
+	0xb0, 0x32, 0x46, 0x34, 0xd1, 0x93, 0xc9, 0x00, 0x6e, 0x16, 0x7e, 0xf9, 0xbd, 0x5b, 0x46, 0x8b,
+	0x0e, 0xca, 0xac, 0x83, 0x12, 0xda, 0x8f, 0xbf, 0x97, 0x5e, 0x86, 0xbc, 0xe1, 0xa7, 0xc8, 0x33,
+	0x71, 0xa9, 0x3c, 0x7b, 0x79, 0x95, 0x93, 0x5e, 0x5d, 0xe5, 0xa4, 0x7f, 0xae, 0x72, 0xd2, 0x77,
+	0xd7, 0xb9, 0xd4, 0xab, 0xeb, 0x5c, 0xea, 0xaf, 0xeb, 0x5c, 0xea, 0xb3, 0x83, 0xba, 0xe1, 0x36,
+	0x3a, 0x5a, 0x41, 0xa7, 0xad, 0x62, 0x0b, 0xbb, 0x86, 0x6e, 0x11, 0xf7, 0x0b, 0x6a, 0x37, 0x87,
+	0x45, 0xba, 0xc1, 0x32, 0x4c, 0x74, 0xb5, 0x34, 0xfb, 0xf3, 0x75, 0xf0, 0xff, 0x00, 0xa9, 0x4a,
+	0x78, 0x0e, 0x92, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -849,6 +1025,9 @@ type QueryClient interface {
 	NextCheckpoint(ctx context.Context, in *QueryNextCheckpointRequest, opts ...grpc.CallOption) (*QueryNextCheckpointResponse, error)
 	// NextCheckpoint queries the next checkpoint.
 	LatestCheckpoint(ctx context.Context, in *QueryLatestCheckpointRequest, opts ...grpc.CallOption) (*QueryLatestCheckpointResponse, error)
+	// BlockInclusionProof queries the proof of a bor block against the root
+	// hash of the checkpoint covering it.
+	BlockInclusionProof(ctx context.Context, in *QueryBlockInclusionProofRequest, opts ...grpc.CallOption) (*QueryBlockInclusionProofResponse, error)
 }
 
 type queryClient struct {
@@ -931,6 +1110,15 @@ func (c *queryClient) LatestCheckpoint(ctx context.Context, in *QueryLatestCheck
 	return out, nil
 }
 
+func (c *queryClient) BlockInclusionProof(ctx context.Context, in *QueryBlockInclusionProofRequest, opts ...grpc.CallOption) (*QueryBlockInclusionProofResponse, error) {
+	out := new(QueryBlockInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.checkpoint.v1beta1.Query/BlockInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the staking parameters.
@@ -949,6 +1137,9 @@ type QueryServer interface {
 	NextCheckpoint(context.Context, *QueryNextCheckpointRequest) (*QueryNextCheckpointResponse, error)
 	// NextCheckpoint queries the next checkpoint.
 	LatestCheckpoint(context.Context, *QueryLatestCheckpointRequest) (*QueryLatestCheckpointResponse, error)
+	// BlockInclusionProof queries the proof of a bor block against the root
+	// hash of the checkpoint covering it.
+	BlockInclusionProof(context.Context, *QueryBlockInclusionProofRequest) (*QueryBlockInclusionProofResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -979,6 +1170,9 @@ func (*UnimplementedQueryServer) NextCheckpoint(ctx context.Context, req *QueryN
 func (*UnimplementedQueryServer) LatestCheckpoint(ctx context.Context, req *QueryLatestCheckpointRequest) (*QueryLatestCheckpointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestCheckpoint not implemented")
 }
+func (*UnimplementedQueryServer) BlockInclusionProof(ctx context.Context, req *QueryBlockInclusionProofRequest) (*QueryBlockInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockInclusionProof not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1128,6 +1322,24 @@ func _Query_LatestCheckpoint_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlockInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.checkpoint.v1beta1.Query/BlockInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockInclusionProof(ctx, req.(*QueryBlockInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "heimdall.checkpoint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1164,6 +1376,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LatestCheckpoint",
 			Handler:    _Query_LatestCheckpoint_Handler,
 		},
+		{
+			MethodName: "BlockInclusionProof",
+			Handler:    _Query_BlockInclusionProof_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdall/checkpoint/v1beta1/query.proto",
@@ -1678,6 +1894,117 @@ func (m *QueryLatestCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBlockInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Leaf) > 0 {
+		i -= len(m.Leaf)
+		copy(dAtA[i:], m.Leaf)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Leaf)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Checkpoint != nil {
+		{
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HeaderBlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HeaderBlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CheckpointNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CheckpointNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1890,6 +2217,59 @@ func (m *QueryLatestCheckpointResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBlockInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CheckpointNumber != 0 {
+		n += 1 + sovQuery(uint64(m.CheckpointNumber))
+	}
+	if m.HeaderBlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.HeaderBlockNumber))
+	}
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	l = len(m.Leaf)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3184,6 +3564,371 @@ func (m *QueryLatestCheckpointResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBlockInclusionProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockInclusionProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockInclusionProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockInclusionProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointNumber", wireType)
+			}
+			m.CheckpointNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderBlockNumber", wireType)
+			}
+			m.HeaderBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderBlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Checkpoint == nil {
+				m.Checkpoint = &types.Checkpoint{}
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leaf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
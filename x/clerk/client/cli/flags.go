@@ -9,4 +9,9 @@ const (
 	FlagRecordID        = "id"
 	FlagData            = "data"
 	FlagBorChainId      = "bor-chain-id"
+	FlagFromTime        = "from-time"
+	FlagToTime          = "to-time"
+	FlagFromID          = "from-id"
+	FlagLimit           = "limit"
+	FlagCursor          = "cursor"
 )
@@ -3,9 +3,11 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
 	"github.com/maticnetwork/heimdall/x/clerk/types"
@@ -24,6 +26,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(
 		GetStateRecord(),
+		GetRecordsByTime(),
+		GetRecordsFromID(),
+		GetLatestRecordID(),
+		GetMissingRecords(),
+		GetQueryParams(),
 	)
 
 	return cmd
@@ -66,3 +73,275 @@ func GetStateRecord() *cobra.Command {
 
 	return cmd
 }
+
+// GetRecordsByTime get state records in a time range
+func GetRecordsByTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "records-by-time",
+		Short: "show state records in a time range",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the state records from the from time to the to time (excluded), as unix timestamps.
+A limited number of records is scanned per query, the returned cursor continues the scan.
+Example:
+$ %s query clerk records-by-time --from-time 1609459200 --to-time 1609462800 --limit 10
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			fromTime, err := cmd.Flags().GetUint64(FlagFromTime)
+			if err != nil {
+				return err
+			}
+
+			toTime, err := cmd.Flags().GetUint64(FlagToTime)
+			if err != nil {
+				return err
+			}
+
+			fromID, err := cmd.Flags().GetUint64(FlagFromID)
+			if err != nil {
+				return err
+			}
+
+			contract, err := cmd.Flags().GetString(FlagContractAddress)
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetUint64(FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			cursor, err := cmd.Flags().GetUint64(FlagCursor)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRecordsByTimeRequest{
+				FromTime: fromTime,
+				ToTime:   toTime,
+				FromId:   fromID,
+				Contract: contract,
+				Limit:    limit,
+				Cursor:   cursor,
+			}
+			res, err := queryClient.RecordsByTime(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagFromTime, 0, "--from-time=<unix timestamp>")
+	cmd.Flags().Uint64(FlagToTime, 0, "--to-time=<unix timestamp>")
+	cmd.Flags().Uint64(FlagFromID, 0, "--from-id=<first record ID>")
+	cmd.Flags().String(FlagContractAddress, "", "--contract=<receiver contract address>")
+	cmd.Flags().Uint64(FlagLimit, 50, "--limit=<maximum 50>")
+	cmd.Flags().Uint64(FlagCursor, 0, "--cursor=<cursor of the previous page>")
+
+	_ = cmd.MarkFlagRequired(FlagFromTime)
+	_ = cmd.MarkFlagRequired(FlagToTime)
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetRecordsFromID get state records from a record id on
+func GetRecordsFromID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "records",
+		Short: "show state records from a record ID on",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the state records from the record ID on, up to the records of the to time (excluded) if set.
+A limited number of records is scanned per query, the returned cursor continues the scan.
+Example:
+$ %s query clerk records --from-id 1 --to-time 1609462800 --limit 10
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			fromID, err := cmd.Flags().GetUint64(FlagFromID)
+			if err != nil {
+				return err
+			}
+
+			toTime, err := cmd.Flags().GetUint64(FlagToTime)
+			if err != nil {
+				return err
+			}
+
+			contract, err := cmd.Flags().GetString(FlagContractAddress)
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetUint64(FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			cursor, err := cmd.Flags().GetUint64(FlagCursor)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRecordsFromIDRequest{
+				FromId:   fromID,
+				ToTime:   toTime,
+				Contract: contract,
+				Limit:    limit,
+				Cursor:   cursor,
+			}
+			res, err := queryClient.RecordsFromID(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagFromID, 0, "--from-id=<first record ID>")
+	cmd.Flags().Uint64(FlagToTime, 0, "--to-time=<unix timestamp>")
+	cmd.Flags().String(FlagContractAddress, "", "--contract=<receiver contract address>")
+	cmd.Flags().Uint64(FlagLimit, 50, "--limit=<maximum 50>")
+	cmd.Flags().Uint64(FlagCursor, 0, "--cursor=<cursor of the previous page>")
+
+	_ = cmd.MarkFlagRequired(FlagFromID)
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetLatestRecordID get latest state record id
+func GetLatestRecordID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "latest-record-id",
+		Short: "show latest state record ID",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LatestRecordID(context.Background(), &types.QueryLatestRecordIDRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetMissingRecords get the ids of the missing state records
+func GetMissingRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "missing-records",
+		Short: "show the IDs of the state records missing before the latest record",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the IDs of the state records neither stored nor buffered, from the next expected record ID to the latest record ID.
+Example:
+$ %s query clerk missing-records --limit 10
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetUint64(FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MissingRecords(context.Background(), &types.QueryMissingRecordsRequest{Limit: limit})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagLimit, 50, "--limit=<maximum 50>")
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryParams implements the params query command.
+func GetQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "show the current clerk parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as clerk parameters.
+
+Example:
+$ %s query clerk params
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -9,6 +9,8 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
 	// add checkpoint headers
 	if len(genState.EventRecords) != 0 {
 		for _, record := range genState.EventRecords {
@@ -18,6 +20,17 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	for _, record := range genState.BufferedRecords {
+		if err := k.SetBufferedEventRecord(ctx, *record); err != nil {
+			k.Logger(ctx).Error("InitGenesis | SetBufferedEventRecord", "error", err)
+		}
+	}
+
+	// move the next record id past the imported records
+	if err := k.AdvanceNextRecordID(ctx); err != nil {
+		k.Logger(ctx).Error("InitGenesis | AdvanceNextRecordID", "error", err)
+	}
+
 	for _, sequence := range genState.RecordSequences {
 		k.SetRecordSequence(ctx, sequence)
 	}
@@ -25,5 +38,5 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	return types.NewGenesisState(k.GetAllEventRecords(ctx), k.GetRecordSequences(ctx))
+	return types.NewGenesisState(k.GetAllEventRecords(ctx), k.GetRecordSequences(ctx), k.GetParams(ctx), k.GetBufferedEventRecords(ctx))
 }
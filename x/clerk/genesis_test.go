@@ -58,6 +58,7 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	genesisState := types.GenesisState{
 		EventRecords:    eventRecords,
 		RecordSequences: recordSequences,
+		Params:          types.DefaultParams(),
 	}
 	clerk.InitGenesis(ctx, app.ClerkKeeper, genesisState)
 
@@ -65,4 +66,6 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 
 	require.Equal(t, len(recordSequences), len(actualParams.RecordSequences))
 	require.Equal(t, len(eventRecords), len(actualParams.EventRecords))
+	require.Equal(t, genesisState.Params.MaxRecordDataSize, actualParams.Params.MaxRecordDataSize)
+	require.Equal(t, genesisState.Params.ContractWindow, actualParams.Params.ContractWindow)
 }
@@ -136,3 +136,95 @@ func (k Querier) Records(c context.Context, req *types.QueryRecordListRequest) (
 		EventRecords: ptrRecords,
 	}, nil
 }
+
+// RecordsByTime returns the records in the time range
+func (k Querier) RecordsByTime(c context.Context, req *types.QueryRecordsByTimeRequest) (*types.QueryRecordPageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ToTime <= req.FromTime {
+		return nil, status.Error(codes.InvalidArgument, "to time must be after from time")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.Cursor != 0 && !k.HasEventRecord(ctx, req.Cursor) {
+		return nil, status.Error(codes.InvalidArgument, "cursor record not found")
+	}
+
+	records, cursor, err := k.GetEventRecordListByTime(ctx, time.Unix(int64(req.FromTime), 0), time.Unix(int64(req.ToTime), 0), req.FromId, req.Contract, req.Cursor, req.Limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecordPageResponse{EventRecords: recordPointers(records), Cursor: cursor}, nil
+}
+
+// RecordsFromID returns the records from the record id on
+func (k Querier) RecordsFromID(c context.Context, req *types.QueryRecordsFromIDRequest) (*types.QueryRecordPageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var toTime time.Time
+	if req.ToTime != 0 {
+		toTime = time.Unix(int64(req.ToTime), 0)
+	}
+
+	records, cursor, err := k.GetEventRecordListFromID(ctx, req.FromId, toTime, req.Contract, req.Cursor, req.Limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecordPageResponse{EventRecords: recordPointers(records), Cursor: cursor}, nil
+}
+
+// LatestRecordID returns the id of the latest record
+func (k Querier) LatestRecordID(c context.Context, req *types.QueryLatestRecordIDRequest) (*types.QueryLatestRecordIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	latestID, found := k.GetLatestRecordID(ctx)
+	return &types.QueryLatestRecordIDResponse{LatestRecordId: latestID, Found: found}, nil
+}
+
+// Params returns the parameters of the clerk module
+func (k Querier) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// MissingRecords returns the ids of the records missing before the latest known record
+func (k Querier) MissingRecords(c context.Context, req *types.QueryMissingRecordsRequest) (*types.QueryMissingRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	missingIDs, latestID := k.GetMissingRecordIDs(ctx, req.Limit)
+	return &types.QueryMissingRecordsResponse{
+		NextRecordId:     k.GetNextRecordID(ctx),
+		LatestRecordId:   latestID,
+		MissingRecordIds: missingIDs,
+	}, nil
+}
+
+func recordPointers(records []types.EventRecord) []*types.EventRecord {
+	ptrRecords := make([]*types.EventRecord, 0, len(records))
+	for i := range records {
+		ptrRecords = append(ptrRecords, &records[i])
+	}
+	return ptrRecords
+}
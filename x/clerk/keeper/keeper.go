@@ -4,12 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	chainKeeper "github.com/maticnetwork/heimdall/x/chainmanager/keeper"
 	"github.com/maticnetwork/heimdall/x/clerk/types"
@@ -25,20 +27,40 @@ var (
 	RecordSequencePrefixKey = []byte{0x12}
 
 	StateRecordPrefixKeyWithTime = []byte{0x13} // prefix key for when storing state with time
+
+	LatestRecordIDKey = []byte{0x14} // key to store the latest record id
+
+	ContractWindowPrefixKey = []byte{0x15} // prefix key to store the record count of a contract in its window
+
+	NextRecordIDKey = []byte{0x16} // key to store the id of the first record not stored yet
+
+	BufferedRecordPrefixKey = []byte{0x17} // prefix key to store the records waiting for records with lower ids
 )
 
+// FirstRecordID is the id of the first state synced by the state sender
+const FirstRecordID uint64 = 1
+
+// RecordListScanLimit is the max number of records scanned by a record list query
+const RecordListScanLimit uint64 = 1000
+
 type (
 	Keeper struct {
 		cdc         codec.BinaryMarshaler
 		storeKey    sdk.StoreKey
+		paramSpace  paramtypes.Subspace
 		ChainKeeper chainKeeper.Keeper
 	}
 )
 
-func NewKeeper(cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, chainKeeper chainKeeper.Keeper) Keeper {
+func NewKeeper(cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, paramSubspace paramtypes.Subspace, chainKeeper chainKeeper.Keeper) Keeper {
+	if !paramSubspace.HasKeyTable() {
+		paramSubspace = paramSubspace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		cdc:         cdc,
 		storeKey:    storeKey,
+		paramSpace:  paramSubspace,
 		ChainKeeper: chainKeeper,
 	}
 }
@@ -47,6 +69,68 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// SetParams sets the clerk module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the clerk module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
+
+// RecordDataOmitReason returns why the data of a new record of the contract can't be
+// stored with it, empty if it can
+func (k *Keeper) RecordDataOmitReason(ctx sdk.Context, contract string, dataSize uint64) string {
+	params := k.GetParams(ctx)
+
+	if !params.IsContractAllowed(contract) {
+		return types.OmitReasonContractNotAllowed
+	}
+
+	if params.MaxRecordDataSize > 0 && dataSize > params.MaxRecordDataSize {
+		return types.OmitReasonDataSize
+	}
+
+	if params.MaxContractWindowRecords > 0 && k.GetContractWindow(ctx, contract).Count >= params.MaxContractWindowRecords {
+		return types.OmitReasonContractRateLimit
+	}
+
+	return ""
+}
+
+// GetContractWindow returns the count of records stored with their data for the contract
+// in the current window, windows being aligned on multiples of the window duration
+func (k *Keeper) GetContractWindow(ctx sdk.Context, contract string) types.ContractWindow {
+	windowStart := ctx.BlockTime().Truncate(k.GetParams(ctx).ContractWindow).UTC()
+
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetContractWindowKey(contract)
+	if store.Has(key) {
+		var window types.ContractWindow
+		if err := k.cdc.UnmarshalBinaryBare(store.Get(key), &window); err == nil && window.WindowStart.Equal(windowStart) {
+			return window
+		}
+	}
+
+	return types.ContractWindow{WindowStart: windowStart}
+}
+
+// IncrementContractWindow counts a record stored with its data for the contract in the current window
+func (k *Keeper) IncrementContractWindow(ctx sdk.Context, contract string) {
+	window := k.GetContractWindow(ctx, contract)
+	window.Count++
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(k.GetContractWindowKey(contract), k.cdc.MustMarshalBinaryBare(&window))
+}
+
+// GetContractWindowKey appends prefix to the lower case contract address
+func (k *Keeper) GetContractWindowKey(contract string) []byte {
+	return append(ContractWindowPrefixKey, []byte(strings.ToLower(strings.TrimPrefix(contract, "0x")))...)
+}
+
 // SetEventRecord adds record to store
 func (k *Keeper) SetEventRecord(ctx sdk.Context, record types.EventRecord) error {
 	if err := k.SetEventRecordWithID(ctx, record); err != nil {
@@ -55,9 +139,197 @@ func (k *Keeper) SetEventRecord(ctx sdk.Context, record types.EventRecord) error
 	if err := k.SetEventRecordWithTime(ctx, record); err != nil {
 		return err
 	}
+
+	// records may be added out of order, e.g. from genesis
+	if latestID, found := k.GetLatestRecordID(ctx); !found || record.Id > latestID {
+		store := ctx.KVStore(k.storeKey)
+		store.Set(LatestRecordIDKey, []byte(strconv.FormatUint(record.Id, 10)))
+	}
+	return nil
+}
+
+// AddEventRecord stores a new record and advances the next record id past it. A record
+// with records missing before it is buffered until these are stored if buffering is
+// enabled, and is stored right away otherwise. Returns if the record was buffered.
+func (k *Keeper) AddEventRecord(ctx sdk.Context, record types.EventRecord) (bool, error) {
+	nextID := k.GetNextRecordID(ctx)
+	if record.Id > nextID && k.GetParams(ctx).BufferRecords {
+		if err := k.SetBufferedEventRecord(ctx, record); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	if err := k.SetEventRecord(ctx, record); err != nil {
+		return false, err
+	}
+
+	if record.Id == nextID {
+		if err := k.AdvanceNextRecordID(ctx); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// AdvanceNextRecordID moves the next record id past the stored records, storing the
+// buffered records it reaches with the current block time to keep record times ordered
+func (k *Keeper) AdvanceNextRecordID(ctx sdk.Context) error {
+	nextID := k.GetNextRecordID(ctx)
+	for {
+		if k.HasEventRecord(ctx, nextID) {
+			nextID++
+			continue
+		}
+
+		record, found := k.GetBufferedEventRecord(ctx, nextID)
+		if !found {
+			break
+		}
+
+		record.RecordTime = ctx.BlockTime()
+		if err := k.SetEventRecord(ctx, record); err != nil {
+			return err
+		}
+		k.DeleteBufferedEventRecord(ctx, nextID)
+		nextID++
+	}
+
+	k.SetNextRecordID(ctx, nextID)
 	return nil
 }
 
+// GetNextRecordID returns the id of the first record not stored yet
+func (k *Keeper) GetNextRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(NextRecordIDKey) {
+		return FirstRecordID
+	}
+
+	return sdk.BigEndianToUint64(store.Get(NextRecordIDKey))
+}
+
+// SetNextRecordID sets the id of the first record not stored yet
+func (k *Keeper) SetNextRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(NextRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetBufferedEventRecord buffers a record until the records before it are stored
+func (k *Keeper) SetBufferedEventRecord(ctx sdk.Context, record types.EventRecord) error {
+	value, err := k.cdc.MarshalBinaryBare(&record)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling record", "error", err)
+		return err
+	}
+
+	return k.setEventRecordStore(ctx, k.GetBufferedEventRecordKey(record.Id), value)
+}
+
+// GetBufferedEventRecord returns the buffered record with the id
+func (k *Keeper) GetBufferedEventRecord(ctx sdk.Context, stateID uint64) (types.EventRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.GetBufferedEventRecordKey(stateID)
+
+	var record types.EventRecord
+	if !store.Has(key) {
+		return record, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(store.Get(key), &record); err != nil {
+		k.Logger(ctx).Error("GetBufferedEventRecord | UnmarshalBinaryBare", "error", err)
+		return record, false
+	}
+	return record, true
+}
+
+// HasBufferedEventRecord checks if the record with the id is buffered
+func (k *Keeper) HasBufferedEventRecord(ctx sdk.Context, stateID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(k.GetBufferedEventRecordKey(stateID))
+}
+
+// DeleteBufferedEventRecord removes the buffered record with the id
+func (k *Keeper) DeleteBufferedEventRecord(ctx sdk.Context, stateID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(k.GetBufferedEventRecordKey(stateID))
+}
+
+// GetBufferedEventRecords returns the buffered records, sorted by id
+func (k *Keeper) GetBufferedEventRecords(ctx sdk.Context) (records []*types.EventRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, BufferedRecordPrefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.EventRecord
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &record); err != nil {
+			k.Logger(ctx).Error("GetBufferedEventRecords | UnmarshalBinaryBare", "error", err)
+			continue
+		}
+		records = append(records, &record)
+	}
+
+	return
+}
+
+// GetLatestBufferedRecordID returns the highest buffered record id
+func (k *Keeper) GetLatestBufferedRecordID(ctx sdk.Context) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, BufferedRecordPrefixKey)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(iterator.Key()[len(BufferedRecordPrefixKey):]), true
+}
+
+// GetBufferedEventRecordKey appends prefix to the big endian state id, to iterate records by id
+func (k *Keeper) GetBufferedEventRecordKey(stateID uint64) []byte {
+	return append(BufferedRecordPrefixKey, sdk.Uint64ToBigEndian(stateID)...)
+}
+
+// GetMissingRecordIDs returns up to limit ids of the records neither stored nor buffered
+// from the next record id to the latest stored or buffered record id, and the latter
+func (k *Keeper) GetMissingRecordIDs(ctx sdk.Context, limit uint64) ([]uint64, uint64) {
+	missingIDs := make([]uint64, 0)
+
+	// have max limit
+	if limit == 0 || limit > 50 {
+		limit = 50
+	}
+
+	latestID, _ := k.GetLatestRecordID(ctx)
+	if bufferedID, found := k.GetLatestBufferedRecordID(ctx); found && bufferedID > latestID {
+		latestID = bufferedID
+	}
+
+	for id := k.GetNextRecordID(ctx); id < latestID && uint64(len(missingIDs)) < limit; id++ {
+		if !k.HasEventRecord(ctx, id) && !k.HasBufferedEventRecord(ctx, id) {
+			missingIDs = append(missingIDs, id)
+		}
+	}
+
+	return missingIDs, latestID
+}
+
+// GetLatestRecordID returns the highest record id in store
+func (k *Keeper) GetLatestRecordID(ctx sdk.Context) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(LatestRecordIDKey) {
+		return 0, false
+	}
+
+	latestID, err := strconv.ParseUint(string(store.Get(LatestRecordIDKey)), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return latestID, true
+}
+
 // SetRecordSequence sets mapping for sequence id to bool
 func (k *Keeper) SetRecordSequence(ctx sdk.Context, sequence string) {
 	store := ctx.KVStore(k.storeKey)
@@ -285,3 +557,106 @@ func (k *Keeper) GetEventRecordListWithTime(ctx sdk.Context, fromTime, toTime ti
 
 	return records, nil
 }
+
+// GetEventRecordListByTime returns the records in the time range, toTime excluded, with
+// an id of at least fromID and of the contract if any, sorted by time. It scans at most
+// RecordListScanLimit records, continuing after the record with the cursor id if set,
+// and returns the id of the last record scanned as the next cursor, 0 once the time
+// range was scanned to the end.
+func (k *Keeper) GetEventRecordListByTime(ctx sdk.Context, fromTime, toTime time.Time, fromID uint64, contract string, cursor uint64, limit uint64) ([]types.EventRecord, uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	// create records
+	records := make([]types.EventRecord, 0)
+
+	// have max limit
+	if limit == 0 || limit > 50 {
+		limit = 50
+	}
+
+	startKey := k.GetEventRecordKeyWithTimePrefix(fromTime)
+	if cursor != 0 {
+		cursorRecord, err := k.GetEventRecord(ctx, cursor)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		// the keys following the cursor record key
+		startKey = append(k.GetEventRecordKeyWithTime(cursor, cursorRecord.RecordTime), 0)
+	}
+
+	// get range iterator
+	iterator := store.Iterator(startKey, k.GetEventRecordKeyWithTimePrefix(toTime))
+	defer iterator.Close()
+
+	// loop through records to get matching records
+	var scanned uint64
+	for ; iterator.Valid(); iterator.Next() {
+		if scanned == RecordListScanLimit || uint64(len(records)) == limit {
+			return records, cursor, nil
+		}
+
+		var record types.EventRecord
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &record); err != nil {
+			return nil, 0, err
+		}
+		scanned++
+		cursor = record.Id
+
+		if record.Id >= fromID && matchesContract(record, contract) {
+			records = append(records, record)
+		}
+	}
+
+	return records, 0, nil
+}
+
+// GetEventRecordListFromID returns the records from the id on, stopping at the first record
+// at or after toTime if set, of the contract if any, sorted by id. It scans at most
+// RecordListScanLimit record ids, continuing after the cursor id if set, and returns the
+// last id scanned as the next cursor, 0 once all the records were scanned.
+func (k *Keeper) GetEventRecordListFromID(ctx sdk.Context, fromID uint64, toTime time.Time, contract string, cursor uint64, limit uint64) ([]types.EventRecord, uint64, error) {
+	// create records
+	records := make([]types.EventRecord, 0)
+
+	// have max limit
+	if limit == 0 || limit > 50 {
+		limit = 50
+	}
+
+	latestID, found := k.GetLatestRecordID(ctx)
+	if !found {
+		return records, 0, nil
+	}
+
+	if cursor != 0 && cursor+1 > fromID {
+		fromID = cursor + 1
+	}
+
+	// record ids are stored as strings, walk the ids instead of iterating the store
+	for id := fromID; id <= latestID; id++ {
+		if id-fromID == RecordListScanLimit || uint64(len(records)) == limit {
+			return records, id - 1, nil
+		}
+
+		record, err := k.GetEventRecord(ctx, id)
+		if err != nil {
+			continue
+		}
+
+		if !toTime.IsZero() && !record.RecordTime.Before(toTime) {
+			break
+		}
+
+		if matchesContract(*record, contract) {
+			records = append(records, *record)
+		}
+	}
+
+	return records, 0, nil
+}
+
+// matchesContract checks if the record is of the contract, any contract matches an empty one
+func matchesContract(record types.EventRecord, contract string) bool {
+	return contract == "" || strings.EqualFold(strings.TrimPrefix(record.Contract, "0x"), strings.TrimPrefix(contract, "0x"))
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/maticnetwork/heimdall/app"
 	hmCommon "github.com/maticnetwork/heimdall/types/common"
+	"github.com/maticnetwork/heimdall/x/clerk/keeper"
 	"github.com/maticnetwork/heimdall/x/clerk/test_helper"
 	"github.com/maticnetwork/heimdall/x/clerk/types"
 )
@@ -125,6 +126,195 @@ func (suite *KeeperTestSuite) TestGetEventRecordListTime() {
 	require.Equal(t, int64(19), recordList[len(recordList)-1].RecordTime.Unix())
 }
 
+func (suite *KeeperTestSuite) TestGetEventRecordListFiltered() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	var i uint64
+
+	contractA, _ := sdk.AccAddressFromHex("0x1121212121219")
+	contractB, _ := sdk.AccAddressFromHex("0x2121212121219")
+	hHash := hmCommon.BytesToHeimdallHash([]byte("some-address"))
+	ck := app.ClerkKeeper
+
+	_, found := ck.GetLatestRecordID(ctx)
+	require.False(t, found)
+
+	for i = 1; i <= 30; i++ {
+		contract := contractA
+		if i%3 == 0 {
+			contract = contractB
+		}
+		testRecord := types.NewEventRecord(hHash, i, i, contract, make([]byte, 0), "1", time.Unix(int64(i), 0))
+		err := ck.SetEventRecord(ctx, testRecord)
+		require.Nil(t, err)
+	}
+
+	latestID, found := ck.GetLatestRecordID(ctx)
+	require.True(t, found)
+	require.Equal(t, uint64(30), latestID)
+
+	// by time
+	recordList, cursor, err := ck.GetEventRecordListByTime(ctx, time.Unix(1, 0), time.Unix(11, 0), 0, "", 0, 0)
+	require.NoError(t, err)
+	require.Len(t, recordList, 10)
+	require.Equal(t, uint64(0), cursor)
+
+	recordList, cursor, err = ck.GetEventRecordListByTime(ctx, time.Unix(1, 0), time.Unix(11, 0), 5, "", 0, 3)
+	require.NoError(t, err)
+	require.Len(t, recordList, 3)
+	require.Equal(t, uint64(5), recordList[0].Id)
+	require.Equal(t, uint64(7), cursor)
+
+	// the cursor continues after the last record scanned
+	recordList, cursor, err = ck.GetEventRecordListByTime(ctx, time.Unix(1, 0), time.Unix(11, 0), 5, "", cursor, 3)
+	require.NoError(t, err)
+	require.Len(t, recordList, 3)
+	require.Equal(t, uint64(8), recordList[0].Id)
+	require.Equal(t, uint64(0), cursor)
+
+	recordList, _, err = ck.GetEventRecordListByTime(ctx, time.Unix(1, 0), time.Unix(11, 0), 0, contractB.String(), 0, 0)
+	require.NoError(t, err)
+	require.Len(t, recordList, 3)
+	for _, record := range recordList {
+		require.Equal(t, contractB.String(), record.Contract)
+	}
+
+	// from id
+	recordList, cursor, err = ck.GetEventRecordListFromID(ctx, 25, time.Time{}, "", 0, 0)
+	require.NoError(t, err)
+	require.Len(t, recordList, 6)
+	require.Equal(t, uint64(25), recordList[0].Id)
+	require.Equal(t, uint64(30), recordList[5].Id)
+	require.Equal(t, uint64(0), cursor)
+
+	recordList, _, err = ck.GetEventRecordListFromID(ctx, 1, time.Unix(8, 0), "", 0, 0)
+	require.NoError(t, err)
+	require.Len(t, recordList, 7)
+
+	recordList, cursor, err = ck.GetEventRecordListFromID(ctx, 1, time.Time{}, contractB.String(), 0, 4)
+	require.NoError(t, err)
+	require.Len(t, recordList, 4)
+	require.Equal(t, uint64(12), recordList[3].Id)
+	require.Equal(t, uint64(12), cursor)
+
+	recordList, cursor, err = ck.GetEventRecordListFromID(ctx, 1, time.Time{}, contractB.String(), cursor, 4)
+	require.NoError(t, err)
+	require.Len(t, recordList, 4)
+	require.Equal(t, uint64(15), recordList[0].Id)
+	require.Equal(t, uint64(24), cursor)
+}
+
+func (suite *KeeperTestSuite) TestGetEventRecordListScanLimit() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	contractA, _ := sdk.AccAddressFromHex("0x1121212121219")
+	contractB, _ := sdk.AccAddressFromHex("0x2121212121219")
+	hHash := hmCommon.BytesToHeimdallHash([]byte("some-address"))
+	ck := app.ClerkKeeper
+
+	// a record of contract b behind more records of contract a than scanned per query
+	lastID := keeper.RecordListScanLimit + 5
+	for id := uint64(1); id <= lastID; id++ {
+		contract := contractA
+		if id == lastID {
+			contract = contractB
+		}
+		testRecord := types.NewEventRecord(hHash, id, id, contract, make([]byte, 0), "1", time.Unix(int64(id), 0))
+		require.NoError(t, ck.SetEventRecord(ctx, testRecord))
+	}
+
+	recordList, cursor, err := ck.GetEventRecordListFromID(ctx, 1, time.Time{}, contractB.String(), 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, recordList)
+	require.Equal(t, keeper.RecordListScanLimit, cursor)
+
+	recordList, cursor, err = ck.GetEventRecordListFromID(ctx, 1, time.Time{}, contractB.String(), cursor, 0)
+	require.NoError(t, err)
+	require.Len(t, recordList, 1)
+	require.Equal(t, lastID, recordList[0].Id)
+	require.Equal(t, uint64(0), cursor)
+
+	toTime := time.Unix(int64(lastID)+1, 0)
+	recordList, cursor, err = ck.GetEventRecordListByTime(ctx, time.Unix(1, 0), toTime, 0, contractB.String(), 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, recordList)
+	require.NotZero(t, cursor)
+
+	recordList, cursor, err = ck.GetEventRecordListByTime(ctx, time.Unix(1, 0), toTime, 0, contractB.String(), cursor, 0)
+	require.NoError(t, err)
+	require.Len(t, recordList, 1)
+	require.Equal(t, lastID, recordList[0].Id)
+	require.Equal(t, uint64(0), cursor)
+}
+
+func (suite *KeeperTestSuite) TestAddEventRecord() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	hAddr, _ := sdk.AccAddressFromHex("0x1121212121219")
+	hHash := hmCommon.BytesToHeimdallHash([]byte("some-address"))
+	ck := app.ClerkKeeper
+	newRecord := func(id uint64) types.EventRecord {
+		return types.NewEventRecord(hHash, id, id, hAddr, make([]byte, 0), "1", time.Unix(int64(id), 0))
+	}
+
+	require.Equal(t, keeper.FirstRecordID, ck.GetNextRecordID(ctx))
+
+	// records without gaps
+	for _, id := range []uint64{1, 2} {
+		buffered, err := ck.AddEventRecord(ctx, newRecord(id))
+		require.NoError(t, err)
+		require.False(t, buffered)
+	}
+	require.Equal(t, uint64(3), ck.GetNextRecordID(ctx))
+
+	// record after a gap is stored without buffering
+	buffered, err := ck.AddEventRecord(ctx, newRecord(5))
+	require.NoError(t, err)
+	require.False(t, buffered)
+	require.True(t, ck.HasEventRecord(ctx, 5))
+	require.Equal(t, uint64(3), ck.GetNextRecordID(ctx))
+
+	// record after a gap is buffered with buffering
+	params := ck.GetParams(ctx)
+	params.BufferRecords = true
+	ck.SetParams(ctx, params)
+
+	buffered, err = ck.AddEventRecord(ctx, newRecord(7))
+	require.NoError(t, err)
+	require.True(t, buffered)
+	require.False(t, ck.HasEventRecord(ctx, 7))
+	require.True(t, ck.HasBufferedEventRecord(ctx, 7))
+
+	missingIDs, latestID := ck.GetMissingRecordIDs(ctx, 0)
+	require.Equal(t, []uint64{3, 4, 6}, missingIDs)
+	require.Equal(t, uint64(7), latestID)
+
+	missingIDs, _ = ck.GetMissingRecordIDs(ctx, 2)
+	require.Equal(t, []uint64{3, 4}, missingIDs)
+
+	// filling the gaps stores the buffered records with the block time
+	blockTime := time.Unix(100, 0)
+	ctx = ctx.WithBlockTime(blockTime)
+	for _, id := range []uint64{3, 4} {
+		_, err = ck.AddEventRecord(ctx, newRecord(id))
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint64(6), ck.GetNextRecordID(ctx))
+
+	buffered, err = ck.AddEventRecord(ctx, newRecord(6))
+	require.NoError(t, err)
+	require.False(t, buffered)
+	require.Equal(t, uint64(8), ck.GetNextRecordID(ctx))
+	require.False(t, ck.HasBufferedEventRecord(ctx, 7))
+
+	record, err := ck.GetEventRecord(ctx, 7)
+	require.NoError(t, err)
+	require.True(t, record.RecordTime.Equal(blockTime))
+
+	missingIDs, latestID = ck.GetMissingRecordIDs(ctx, 0)
+	require.Empty(t, missingIDs)
+	require.Equal(t, uint64(7), latestID)
+}
+
 func (suite *KeeperTestSuite) TestGetEventRecordKey() {
 	t, app, _ := suite.T(), suite.app, suite.ctx
 
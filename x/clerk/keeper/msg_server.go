@@ -41,7 +41,7 @@ func (k msgServer) MsgEventRecord(goCtx context.Context, msg *types.MsgEventReco
 	)
 
 	// check if event record exists
-	if exists := k.HasEventRecord(ctx, msg.Id); exists {
+	if exists := k.HasEventRecord(ctx, msg.Id) || k.HasBufferedEventRecord(ctx, msg.Id); exists {
 		return nil, hmCommon.ErrEventRecordAlreadySynced
 	}
 
@@ -132,7 +132,7 @@ func PostHandleMsgEventRecord(
 	}
 
 	// check for replay
-	if k.HasEventRecord(ctx, msg.Id) {
+	if k.HasEventRecord(ctx, msg.Id) || k.HasBufferedEventRecord(ctx, msg.Id) {
 		k.Logger(ctx).Debug("Skipping new clerk record as it's already processed")
 		return nil, hmCommon.ErrOldTx
 	}
@@ -160,8 +160,18 @@ func PostHandleMsgEventRecord(
 		ctx.BlockTime(),
 	)
 
-	// save event into state
-	if err := k.SetEventRecord(ctx, record); err != nil {
+	// records are always stored to keep their ids contiguous, only their data is omitted
+	omitReason := k.RecordDataOmitReason(ctx, record.Contract, uint64(len(record.Data)))
+	if omitReason != "" {
+		k.Logger(ctx).Info("Omitting clerk record data", "id", msg.Id, "contract", record.Contract, "reason", omitReason)
+		record.OmitData()
+	} else {
+		k.IncrementContractWindow(ctx, record.Contract)
+	}
+
+	// save event into state, or buffer it until the records before it are stored
+	buffered, err := k.AddEventRecord(ctx, record)
+	if err != nil {
 		k.Logger(ctx).Error("Unable to update event record", "error", err, "id", msg.Id)
 		return nil, hmCommon.ErrEventUpdate
 	}
@@ -184,6 +194,8 @@ func PostHandleMsgEventRecord(
 			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()), // result
 			sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(msg.Id, 10)),
 			sdk.NewAttribute(types.AttributeKeyRecordContract, msg.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyDataOmitReason, omitReason),
+			sdk.NewAttribute(types.AttributeKeyRecordBuffered, strconv.FormatBool(buffered)),
 		),
 	})
 
@@ -15,6 +15,7 @@ import (
 
 	common "github.com/maticnetwork/bor/common"
 	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
 	"github.com/maticnetwork/heimdall/app"
 	hCommon "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/contracts/statesender"
@@ -286,3 +287,69 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgEventRecord() {
 		require.Nil(t, result, "Post handler should prevent replay attack")
 	})
 }
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgEventRecordDataLimits() {
+	t, app, ctx, r := suite.T(), suite.app, suite.ctx, suite.r
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, contract := testdata.KeyTestPubAddr()
+	_, _, deniedContract := testdata.KeyTestPubAddr()
+
+	params := types.DefaultParams()
+	params.MaxRecordDataSize = 8
+	params.MaxContractWindowRecords = 2
+	params.DeniedContracts = []string{common.BytesToAddress(deniedContract).Hex()}
+	app.ClerkKeeper.SetParams(ctx, params)
+
+	postRecord := func(contract sdk.AccAddress, data []byte) *types.EventRecord {
+		id := r.Uint64()
+		msg := types.NewMsgEventRecord(
+			addr1,
+			hmCommon.HexToHeimdallHash("limits hash"),
+			r.Uint64(),
+			r.Uint64(),
+			id,
+			contract,
+			data,
+			suite.chainID,
+		)
+
+		result, err := suite.postHandler(ctx, &msg, tmprototypes.SideTxResultType_YES)
+		require.NoError(t, err)
+		require.NotNil(t, result, "Post handler should succeed")
+
+		record, err := app.ClerkKeeper.GetEventRecord(ctx, id)
+		require.NoError(t, err)
+		return record
+	}
+
+	t.Run("DataSize", func(t *testing.T) {
+		data := []byte("oversized data")
+		record := postRecord(contract, data)
+		require.True(t, record.DataOmitted)
+		require.Empty(t, record.Data)
+		require.Equal(t, crypto.Keccak256(data), record.DataHash)
+		require.Equal(t, uint64(0), app.ClerkKeeper.GetContractWindow(ctx, contract.String()).Count)
+	})
+
+	t.Run("DeniedContract", func(t *testing.T) {
+		record := postRecord(deniedContract, []byte("data"))
+		require.True(t, record.DataOmitted)
+		require.Empty(t, record.Data)
+	})
+
+	t.Run("ContractWindow", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			record := postRecord(contract, []byte("data"))
+			require.False(t, record.DataOmitted)
+			require.Equal(t, []byte("data"), record.Data)
+		}
+
+		record := postRecord(contract, []byte("data"))
+		require.True(t, record.DataOmitted)
+
+		// a new window resets the count
+		ctx := ctx.WithBlockTime(ctx.BlockTime().Add(params.ContractWindow))
+		require.Equal(t, uint64(0), app.ClerkKeeper.GetContractWindow(ctx, contract.String()).Count)
+	})
+}
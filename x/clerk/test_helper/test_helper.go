@@ -19,7 +19,7 @@ import (
 // returns context and app with params set on clerk keeper
 func CreateTestApp(isCheckTx bool) (*app.HeimdallApp, sdk.Context, client.Context) {
 	genesisState := app.NewDefaultGenesisState()
-	clerkGenesis := types.NewGenesisState(types.DefaultGenesis().EventRecords, types.DefaultGenesis().RecordSequences)
+	clerkGenesis := types.NewGenesisState(types.DefaultGenesis().EventRecords, types.DefaultGenesis().RecordSequences, types.DefaultParams(), nil)
 
 	app := app.Setup(isCheckTx)
 	ctx := app.BaseApp.NewContext(isCheckTx, tmproto.Header{})
@@ -8,6 +8,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -35,6 +36,10 @@ type EventRecord struct {
 	LogIndex   uint64    `protobuf:"varint,5,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty" yaml:"log_index"`
 	TxHash     string    `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
 	ChainId    string    `protobuf:"bytes,7,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// data_omitted is set when the data isn't stored, data_hash being the keccak256
+	// hash of the data of the state sync
+	DataOmitted bool   `protobuf:"varint,8,opt,name=data_omitted,json=dataOmitted,proto3" json:"data_omitted,omitempty" yaml:"data_omitted"`
+	DataHash    []byte `protobuf:"bytes,9,opt,name=data_hash,json=dataHash,proto3" json:"data_hash,omitempty" yaml:"data_hash"`
 }
 
 func (m *EventRecord) Reset()         { *m = EventRecord{} }
@@ -70,8 +75,118 @@ func (m *EventRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventRecord proto.InternalMessageInfo
 
+type Params struct {
+	// max_record_data_size is the max size in bytes of the data stored with a record,
+	// 0 disables the limit
+	MaxRecordDataSize uint64 `protobuf:"varint,1,opt,name=max_record_data_size,json=maxRecordDataSize,proto3" json:"max_record_data_size,omitempty" yaml:"max_record_data_size"`
+	// contract_window is the duration of the windows records are counted in per
+	// receiver contract
+	ContractWindow time.Duration `protobuf:"bytes,2,opt,name=contract_window,json=contractWindow,proto3,stdduration" json:"contract_window" yaml:"contract_window"`
+	// max_contract_window_records is the max number of records stored with their data
+	// per receiver contract and window, 0 disables the limit
+	MaxContractWindowRecords uint64 `protobuf:"varint,3,opt,name=max_contract_window_records,json=maxContractWindowRecords,proto3" json:"max_contract_window_records,omitempty" yaml:"max_contract_window_records"`
+	// allowed_contracts are the only receiver contracts records are stored with their
+	// data for, if any
+	AllowedContracts []string `protobuf:"bytes,4,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty" yaml:"allowed_contracts"`
+	// denied_contracts are the receiver contracts records are never stored with their
+	// data for
+	DeniedContracts []string `protobuf:"bytes,5,rep,name=denied_contracts,json=deniedContracts,proto3" json:"denied_contracts,omitempty" yaml:"denied_contracts"`
+	// buffer_records holds the records arriving before records with lower ids until
+	// these are stored, instead of storing them with gaps before them
+	BufferRecords bool `protobuf:"varint,6,opt,name=buffer_records,json=bufferRecords,proto3" json:"buffer_records,omitempty" yaml:"buffer_records"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88fd2b9ce5955508, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// ContractWindow counts the records stored with their data for a receiver contract
+// in the window started at window_start
+type ContractWindow struct {
+	WindowStart time.Time `protobuf:"bytes,1,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	Count       uint64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *ContractWindow) Reset()         { *m = ContractWindow{} }
+func (m *ContractWindow) String() string { return proto.CompactTextString(m) }
+func (*ContractWindow) ProtoMessage()    {}
+func (*ContractWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88fd2b9ce5955508, []int{2}
+}
+func (m *ContractWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractWindow.Merge(m, src)
+}
+func (m *ContractWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractWindow proto.InternalMessageInfo
+
+func (m *ContractWindow) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+func (m *ContractWindow) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventRecord)(nil), "heimdall.clerk.v1beta1.EventRecord")
+	proto.RegisterType((*Params)(nil), "heimdall.clerk.v1beta1.Params")
+	proto.RegisterType((*ContractWindow)(nil), "heimdall.clerk.v1beta1.ContractWindow")
 }
 
 func init() {
@@ -79,33 +194,52 @@ func init() {
 }
 
 var fileDescriptor_88fd2b9ce5955508 = []byte{
-	// 405 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0x50, 0xfa, 0xc7, 0x45, 0x80, 0x7c, 0x27, 0x14, 0x75, 0x88, 0x2b, 0xb3, 0x54,
-	0x42, 0x72, 0x54, 0xd8, 0x6e, 0xac, 0x84, 0x74, 0xb7, 0x5a, 0x4c, 0x30, 0x54, 0x4e, 0x6c, 0x12,
-	0xeb, 0x92, 0xb8, 0x4a, 0x7c, 0x47, 0xee, 0x1b, 0x30, 0xde, 0x47, 0xe0, 0xe3, 0xdc, 0xd8, 0x05,
-	0x89, 0x29, 0xa0, 0x76, 0x63, 0xcc, 0x27, 0x40, 0xb1, 0x9b, 0x8a, 0xed, 0x79, 0xdf, 0xf7, 0xf7,
-	0xf8, 0x7d, 0xe4, 0x17, 0x92, 0x4c, 0xaa, 0x42, 0xf0, 0x3c, 0x8f, 0x92, 0x5c, 0x56, 0xb7, 0xd1,
-	0xfd, 0x3a, 0x96, 0x86, 0xaf, 0x5d, 0x45, 0x77, 0x95, 0x36, 0x1a, 0xbd, 0x19, 0x18, 0xea, 0xba,
-	0x27, 0x66, 0x71, 0x99, 0xea, 0x54, 0x5b, 0x24, 0xea, 0x95, 0xa3, 0x17, 0x38, 0xd5, 0x3a, 0xcd,
-	0x65, 0x64, 0xab, 0xf8, 0xee, 0x6b, 0x64, 0x54, 0x21, 0x6b, 0xc3, 0x8b, 0x9d, 0x03, 0xc8, 0x4f,
-	0x1f, 0xce, 0x3f, 0xde, 0xcb, 0xd2, 0x30, 0x99, 0xe8, 0x4a, 0xa0, 0xb7, 0xd0, 0x57, 0x22, 0x00,
-	0x4b, 0xb0, 0x1a, 0x6d, 0x2e, 0xfe, 0xb6, 0xd8, 0x57, 0xa2, 0x6b, 0xf1, 0xec, 0x81, 0x17, 0xf9,
-	0x15, 0x51, 0x82, 0x30, 0x5f, 0x09, 0xb4, 0x80, 0xd3, 0x44, 0x97, 0xa6, 0xe2, 0x89, 0x09, 0xfc,
-	0x25, 0x58, 0xcd, 0xd8, 0xb9, 0x46, 0x08, 0x8e, 0x04, 0x37, 0x3c, 0x78, 0xb6, 0x04, 0xab, 0x17,
-	0xcc, 0x6a, 0xf4, 0x05, 0xce, 0x2b, 0xfb, 0xfc, 0xb6, 0x5f, 0x1f, 0x8c, 0x96, 0x60, 0x35, 0x7f,
-	0xbf, 0xa0, 0x2e, 0x1b, 0x1d, 0xb2, 0xd1, 0x4f, 0x43, 0xb6, 0x4d, 0xf8, 0xd4, 0x62, 0xaf, 0x6b,
-	0x31, 0x72, 0x7b, 0xff, 0x33, 0x93, 0xc7, 0xdf, 0x18, 0x30, 0xe8, 0x3a, 0xbd, 0x01, 0xad, 0xe1,
-	0x2c, 0xd7, 0xe9, 0x56, 0x95, 0x42, 0x36, 0xc1, 0x73, 0x1b, 0xfc, 0xb2, 0x6b, 0xf1, 0x6b, 0x67,
-	0x3d, 0x8f, 0x08, 0x9b, 0xe6, 0x3a, 0xbd, 0xe9, 0x25, 0x7a, 0x07, 0x27, 0xa6, 0xd9, 0x66, 0xbc,
-	0xce, 0x82, 0x71, 0x1f, 0x7f, 0x83, 0xba, 0x16, 0xbf, 0x74, 0x86, 0xd3, 0x80, 0xb0, 0xb1, 0x69,
-	0xae, 0x79, 0x9d, 0x21, 0x0a, 0xa7, 0x49, 0xc6, 0x55, 0xb9, 0x55, 0x22, 0x98, 0x58, 0xfa, 0xa2,
-	0x6b, 0xf1, 0x2b, 0x47, 0x0f, 0x13, 0xc2, 0x26, 0x56, 0xde, 0x88, 0xab, 0xd1, 0xf7, 0x1f, 0xd8,
-	0xdb, 0x5c, 0x3f, 0x1d, 0x42, 0xb0, 0x3f, 0x84, 0xe0, 0xcf, 0x21, 0x04, 0x8f, 0xc7, 0xd0, 0xdb,
-	0x1f, 0x43, 0xef, 0xd7, 0x31, 0xf4, 0x3e, 0xd3, 0x54, 0x99, 0xec, 0x2e, 0xa6, 0x89, 0x2e, 0xa2,
-	0x82, 0x1b, 0x95, 0x94, 0xd2, 0x7c, 0xd3, 0xd5, 0x6d, 0x74, 0x3e, 0x7e, 0x73, 0x3a, 0xbf, 0x79,
-	0xd8, 0xc9, 0x3a, 0x1e, 0xdb, 0xff, 0xf9, 0xf0, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xb5, 0xfb, 0xc4,
-	0xad, 0x1d, 0x02, 0x00, 0x00,
+	// 720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x41, 0x4f, 0xdb, 0x48,
+	0x14, 0x8e, 0x83, 0x09, 0xc9, 0x84, 0x0d, 0x30, 0x64, 0xc1, 0x84, 0x95, 0x27, 0x9a, 0x95, 0x56,
+	0x91, 0x56, 0x72, 0x04, 0x7b, 0xe3, 0xb4, 0xf2, 0xb2, 0xbb, 0x70, 0x2a, 0x1a, 0x2a, 0x55, 0x6a,
+	0x0f, 0xd1, 0xc4, 0x9e, 0x24, 0x23, 0x6c, 0x0f, 0xb2, 0x27, 0x24, 0x70, 0xee, 0xa1, 0x47, 0x8e,
+	0x3d, 0xf6, 0xc7, 0xf4, 0xc0, 0x91, 0x63, 0x4f, 0x6e, 0x05, 0xb7, 0x1e, 0xfd, 0x0b, 0x2a, 0xcf,
+	0x38, 0x81, 0x40, 0xab, 0xde, 0xe6, 0xbd, 0xf7, 0x7d, 0x9f, 0xbf, 0x37, 0xef, 0x8d, 0x01, 0x1e,
+	0x31, 0x1e, 0xfa, 0x34, 0x08, 0xba, 0x5e, 0xc0, 0xe2, 0xb3, 0xee, 0xc5, 0x5e, 0x9f, 0x49, 0xba,
+	0xa7, 0x23, 0xe7, 0x3c, 0x16, 0x52, 0xc0, 0xad, 0x19, 0xc6, 0xd1, 0xd9, 0x02, 0xd3, 0x6a, 0x0e,
+	0xc5, 0x50, 0x28, 0x48, 0x37, 0x3f, 0x69, 0x74, 0xcb, 0x1e, 0x0a, 0x31, 0x0c, 0x58, 0x57, 0x45,
+	0xfd, 0xf1, 0xa0, 0xeb, 0x8f, 0x63, 0x2a, 0xb9, 0x88, 0x8a, 0x3a, 0x7a, 0x5a, 0x97, 0x3c, 0x64,
+	0x89, 0xa4, 0xe1, 0xb9, 0x06, 0xe0, 0x8f, 0x4b, 0xa0, 0xfe, 0xef, 0x05, 0x8b, 0x24, 0x61, 0x9e,
+	0x88, 0x7d, 0xf8, 0x3b, 0x28, 0x73, 0xdf, 0x32, 0xda, 0x46, 0xc7, 0x74, 0x37, 0xbf, 0xa6, 0xa8,
+	0xcc, 0xfd, 0x2c, 0x45, 0xb5, 0x4b, 0x1a, 0x06, 0x07, 0x98, 0xfb, 0x98, 0x94, 0xb9, 0x0f, 0x5b,
+	0xa0, 0xea, 0x89, 0x48, 0xc6, 0xd4, 0x93, 0x56, 0xb9, 0x6d, 0x74, 0x6a, 0x64, 0x1e, 0x43, 0x08,
+	0x4c, 0x9f, 0x4a, 0x6a, 0x2d, 0xb5, 0x8d, 0xce, 0x2a, 0x51, 0x67, 0xf8, 0x06, 0xd4, 0x63, 0x25,
+	0xdf, 0xcb, 0x3f, 0x6f, 0x99, 0x6d, 0xa3, 0x53, 0xdf, 0x6f, 0x39, 0xda, 0x9b, 0x33, 0xf3, 0xe6,
+	0xbc, 0x9c, 0x79, 0x73, 0xed, 0x9b, 0x14, 0x95, 0xb2, 0x14, 0x41, 0xfd, 0xdd, 0x47, 0x64, 0x7c,
+	0xfd, 0x19, 0x19, 0x04, 0xe8, 0x4c, 0x4e, 0x80, 0x7b, 0xa0, 0x16, 0x88, 0x61, 0x8f, 0x47, 0x3e,
+	0x9b, 0x5a, 0xcb, 0xca, 0x78, 0x33, 0x4b, 0xd1, 0xba, 0xa6, 0xce, 0x4b, 0x98, 0x54, 0x03, 0x31,
+	0x3c, 0xce, 0x8f, 0xf0, 0x4f, 0xb0, 0x22, 0xa7, 0xbd, 0x11, 0x4d, 0x46, 0x56, 0x25, 0xb7, 0xef,
+	0xc2, 0x2c, 0x45, 0x0d, 0x4d, 0x28, 0x0a, 0x98, 0x54, 0xe4, 0xf4, 0x88, 0x26, 0x23, 0xe8, 0x80,
+	0xaa, 0x37, 0xa2, 0x3c, 0xea, 0x71, 0xdf, 0x5a, 0x51, 0xe8, 0xcd, 0x2c, 0x45, 0x6b, 0x1a, 0x3d,
+	0xab, 0x60, 0xb2, 0xa2, 0x8e, 0xc7, 0x3e, 0x3c, 0x00, 0xab, 0x79, 0xd3, 0x3d, 0x11, 0x72, 0x29,
+	0x99, 0x6f, 0x55, 0xdb, 0x46, 0xa7, 0xea, 0x6e, 0x67, 0x29, 0xda, 0xd4, 0x9c, 0xc7, 0x55, 0x4c,
+	0xea, 0x79, 0xf8, 0x42, 0x47, 0x79, 0x2f, 0xaa, 0xaa, 0xac, 0xd5, 0xf2, 0x1b, 0x7c, 0xdc, 0xcb,
+	0xbc, 0x84, 0x49, 0x35, 0x3f, 0xe7, 0xf6, 0x0e, 0xcc, 0x77, 0x1f, 0x50, 0x09, 0xbf, 0x35, 0x41,
+	0xe5, 0x84, 0xc6, 0x34, 0x4c, 0xe0, 0x09, 0x68, 0x86, 0x74, 0xda, 0x2b, 0xee, 0x4c, 0x71, 0x12,
+	0x7e, 0xc5, 0x8a, 0x99, 0xa2, 0x2c, 0x45, 0xbb, 0x5a, 0xee, 0x7b, 0x28, 0x4c, 0x36, 0x42, 0x3a,
+	0xd5, 0xbb, 0x70, 0x48, 0x25, 0x3d, 0xe5, 0x57, 0x0c, 0x0e, 0xc0, 0xda, 0x6c, 0xbc, 0xbd, 0x09,
+	0x8f, 0x7c, 0x31, 0x51, 0x53, 0xaf, 0xef, 0xef, 0x3c, 0x1b, 0xe1, 0x61, 0xb1, 0x7e, 0x2e, 0x2e,
+	0x26, 0xb8, 0x55, 0xdc, 0xd3, 0x22, 0x1f, 0xbf, 0xcf, 0xa7, 0xd8, 0x98, 0x65, 0x5f, 0xa9, 0x24,
+	0x64, 0x60, 0x37, 0xf7, 0xf4, 0x04, 0x5b, 0x78, 0x4c, 0xd4, 0x46, 0x99, 0xee, 0x1f, 0x59, 0x8a,
+	0xf0, 0x43, 0x03, 0x3f, 0x00, 0x63, 0x62, 0x85, 0x74, 0xfa, 0xcf, 0x82, 0xbe, 0xee, 0x2a, 0x81,
+	0xc7, 0x60, 0x83, 0x06, 0x81, 0x98, 0x30, 0x7f, 0xce, 0x4e, 0x2c, 0xb3, 0xbd, 0xd4, 0xa9, 0xb9,
+	0xbf, 0x65, 0x29, 0xb2, 0xb4, 0xf8, 0x33, 0x08, 0x26, 0xeb, 0x45, 0x6e, 0x26, 0x9b, 0xc0, 0xff,
+	0xc0, 0xba, 0xcf, 0x22, 0xbe, 0xa0, 0xb4, 0xac, 0x94, 0x76, 0xb3, 0x14, 0x6d, 0x17, 0x63, 0x7b,
+	0x82, 0xc0, 0x64, 0x4d, 0xa7, 0x1e, 0x74, 0xfe, 0x06, 0x8d, 0xfe, 0x78, 0x30, 0x60, 0xf1, 0xbc,
+	0xd9, 0x8a, 0xda, 0x9a, 0x9d, 0x2c, 0x45, 0xbf, 0x6a, 0x95, 0xc5, 0x3a, 0x26, 0xbf, 0xe8, 0x44,
+	0xd1, 0x54, 0xb1, 0x06, 0x02, 0x34, 0x16, 0x7b, 0x86, 0xff, 0x83, 0xd5, 0xe2, 0x66, 0x12, 0x49,
+	0x63, 0x69, 0x19, 0x3f, 0x7d, 0x7b, 0xd5, 0x7c, 0x72, 0xea, 0x95, 0xd5, 0x35, 0xf3, 0x34, 0x27,
+	0xc2, 0x26, 0x58, 0xf6, 0xc4, 0x38, 0xd2, 0x0f, 0xde, 0x24, 0x3a, 0x70, 0x8f, 0x6e, 0xee, 0x6c,
+	0xe3, 0xf6, 0xce, 0x36, 0xbe, 0xdc, 0xd9, 0xc6, 0xf5, 0xbd, 0x5d, 0xba, 0xbd, 0xb7, 0x4b, 0x9f,
+	0xee, 0xed, 0xd2, 0x6b, 0x67, 0xc8, 0xe5, 0x68, 0xdc, 0x77, 0x3c, 0x11, 0x76, 0x43, 0x2a, 0xb9,
+	0x17, 0x31, 0x39, 0x11, 0xf1, 0x59, 0x77, 0xfe, 0x0f, 0x9c, 0x16, 0x7f, 0x41, 0x79, 0x79, 0xce,
+	0x92, 0x7e, 0x45, 0x59, 0xf9, 0xeb, 0xdb, 0x00, 0x9f, 0x30, 0x96, 0x7c, 0x24, 0x05, 0x00, 0x00,
 }
 
 func (m *EventRecord) Marshal() (dAtA []byte, err error) {
@@ -128,6 +262,23 @@ func (m *EventRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DataHash) > 0 {
+		i -= len(m.DataHash)
+		copy(dAtA[i:], m.DataHash)
+		i = encodeVarintClerk(dAtA, i, uint64(len(m.DataHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DataOmitted {
+		i--
+		if m.DataOmitted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
 		copy(dAtA[i:], m.ChainId)
@@ -177,6 +328,111 @@ func (m *EventRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BufferRecords {
+		i--
+		if m.BufferRecords {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DeniedContracts) > 0 {
+		for iNdEx := len(m.DeniedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedContracts[iNdEx])
+			copy(dAtA[i:], m.DeniedContracts[iNdEx])
+			i = encodeVarintClerk(dAtA, i, uint64(len(m.DeniedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintClerk(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxContractWindowRecords != 0 {
+		i = encodeVarintClerk(dAtA, i, uint64(m.MaxContractWindowRecords))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ContractWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ContractWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintClerk(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxRecordDataSize != 0 {
+		i = encodeVarintClerk(dAtA, i, uint64(m.MaxRecordDataSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintClerk(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintClerk(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClerk(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClerk(v)
 	base := offset
@@ -218,6 +474,59 @@ func (m *EventRecord) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovClerk(uint64(l))
 	}
+	if m.DataOmitted {
+		n += 2
+	}
+	l = len(m.DataHash)
+	if l > 0 {
+		n += 1 + l + sovClerk(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRecordDataSize != 0 {
+		n += 1 + sovClerk(uint64(m.MaxRecordDataSize))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ContractWindow)
+	n += 1 + l + sovClerk(uint64(l))
+	if m.MaxContractWindowRecords != 0 {
+		n += 1 + sovClerk(uint64(m.MaxContractWindowRecords))
+	}
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovClerk(uint64(l))
+		}
+	}
+	if len(m.DeniedContracts) > 0 {
+		for _, s := range m.DeniedContracts {
+			l = len(s)
+			n += 1 + l + sovClerk(uint64(l))
+		}
+	}
+	if m.BufferRecords {
+		n += 2
+	}
+	return n
+}
+
+func (m *ContractWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovClerk(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovClerk(uint64(m.Count))
+	}
 	return n
 }
 
@@ -457,6 +766,373 @@ func (m *EventRecord) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataOmitted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClerk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DataOmitted = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClerk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClerk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClerk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataHash = append(m.DataHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataHash == nil {
+				m.DataHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClerk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClerk
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClerk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClerk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordDataSize", wireType)
+			}
+			m.MaxRecordDataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClerk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordDataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClerk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClerk
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClerk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ContractWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractWindowRecords", wireType)
+			}
+			m.MaxContractWindowRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClerk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractWindowRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClerk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClerk
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClerk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClerk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClerk
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClerk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedContracts = append(m.DeniedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferRecords", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClerk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BufferRecords = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClerk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClerk
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClerk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClerk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClerk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClerk
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClerk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClerk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClerk(dAtA[iNdEx:])
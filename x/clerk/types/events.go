@@ -8,6 +8,8 @@ var (
 	AttributeKeyRecordID         = "record-id"
 	AttributeKeyRecordContract   = "record-contract"
 	AttributeKeyCreatedAt        = "created-at"
+	AttributeKeyDataOmitReason   = "data-omit-reason"
+	AttributeKeyRecordBuffered   = "record-buffered"
 
 	AttributeValueCategory = ModuleName
 )
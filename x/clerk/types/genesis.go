@@ -7,12 +7,16 @@ const DefaultIndex uint64 = 1
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
-	return NewGenesisState(make([]*EventRecord, 0), nil)
+	return NewGenesisState(make([]*EventRecord, 0), nil, DefaultParams(), nil)
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	for _, sq := range gs.RecordSequences {
 		if sq == "" {
 			return errors.New("Invalid Sequence")
@@ -22,6 +26,6 @@ func (gs GenesisState) Validate() error {
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(eventRecords []*EventRecord, recordSequences []string) *GenesisState {
-	return &GenesisState{EventRecords: eventRecords, RecordSequences: recordSequences}
+func NewGenesisState(eventRecords []*EventRecord, recordSequences []string, params Params, bufferedRecords []*EventRecord) *GenesisState {
+	return &GenesisState{EventRecords: eventRecords, RecordSequences: recordSequences, Params: params, BufferedRecords: bufferedRecords}
 }
@@ -27,6 +27,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	EventRecords    []*EventRecord `protobuf:"bytes,1,rep,name=event_records,json=eventRecords,proto3" json:"event_records,omitempty" yaml:"event_records"`
 	RecordSequences []string       `protobuf:"bytes,2,rep,name=record_sequences,json=recordSequences,proto3" json:"record_sequences,omitempty" yaml:"record_sequences"`
+	Params          Params         `protobuf:"bytes,3,opt,name=params,proto3" json:"params" yaml:"params"`
+	BufferedRecords []*EventRecord `protobuf:"bytes,4,rep,name=buffered_records,json=bufferedRecords,proto3" json:"buffered_records,omitempty" yaml:"buffered_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +78,20 @@ func (m *GenesisState) GetRecordSequences() []string {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetBufferedRecords() []*EventRecord {
+	if m != nil {
+		return m.BufferedRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "heimdall.clerk.v1beta1.GenesisState")
 }
@@ -85,25 +101,29 @@ func init() {
 }
 
 var fileDescriptor_331329a74f4741a3 = []byte{
-	// 273 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x48, 0xcd, 0xcc,
-	0x4d, 0x49, 0xcc, 0xc9, 0xd1, 0x4f, 0xce, 0x49, 0x2d, 0xca, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xa9, 0xd2, 0x03, 0xab, 0xd2, 0x83, 0xaa, 0x92, 0x52, 0xc2, 0xa1, 0x1b, 0xa2,
-	0x0a, 0xac, 0x57, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2, 0x4a,
-	0xbb, 0x18, 0xb9, 0x78, 0xdc, 0x21, 0x76, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x25, 0x71, 0xf1,
-	0xa6, 0x96, 0xa5, 0xe6, 0x95, 0xc4, 0x17, 0xa5, 0x26, 0xe7, 0x17, 0xa5, 0x14, 0x4b, 0x30, 0x2a,
-	0x30, 0x6b, 0x70, 0x1b, 0x29, 0xeb, 0x61, 0xb7, 0x5a, 0xcf, 0x15, 0xa4, 0x38, 0x08, 0xac, 0xd6,
-	0x49, 0xe2, 0xd3, 0x3d, 0x79, 0x91, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0x14, 0x33, 0x94, 0x82,
-	0x78, 0x52, 0x11, 0xca, 0x8a, 0x85, 0xdc, 0xb8, 0x04, 0x20, 0x32, 0xf1, 0xc5, 0xa9, 0x85, 0xa5,
-	0xa9, 0x79, 0xc9, 0xa9, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0xd2, 0x9f, 0xee, 0xc9,
-	0x8b, 0x43, 0x4c, 0x40, 0x57, 0xa1, 0x14, 0xc4, 0x0f, 0x11, 0x0a, 0x86, 0x89, 0x38, 0x79, 0x9c,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x5e, 0x7a, 0x66, 0x49, 0x46, 0x69,
-	0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x6e, 0x62, 0x49, 0x66, 0x72, 0x5e, 0x6a, 0x49, 0x79, 0x7e,
-	0x51, 0xb6, 0x3e, 0x3c, 0xa0, 0x2a, 0xa0, 0x41, 0x55, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06,
-	0x0e, 0x0d, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x24, 0x3a, 0xcf, 0x87, 0x01, 0x00,
-	0x00,
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcf, 0x6a, 0xea, 0x40,
+	0x14, 0xc6, 0x13, 0xbd, 0x08, 0x37, 0x2a, 0x4a, 0xf0, 0xde, 0x06, 0x0b, 0x89, 0x4c, 0xbb, 0x70,
+	0x35, 0x41, 0xbb, 0xeb, 0x32, 0xd0, 0x3f, 0x9b, 0x42, 0x89, 0xbb, 0x6e, 0x64, 0x12, 0x8f, 0x31,
+	0x98, 0x64, 0xec, 0xcc, 0x68, 0xeb, 0x5b, 0xf4, 0xb1, 0x5c, 0xba, 0xec, 0x2a, 0x14, 0x7d, 0x03,
+	0x17, 0x5d, 0x17, 0x33, 0xd1, 0xb6, 0x52, 0xa1, 0xbb, 0xc3, 0x37, 0xbf, 0xf3, 0x9d, 0x73, 0xe6,
+	0xd3, 0xce, 0x47, 0x10, 0xc6, 0x03, 0x12, 0x45, 0xb6, 0x1f, 0x01, 0x1b, 0xdb, 0xb3, 0x8e, 0x07,
+	0x82, 0x74, 0xec, 0x00, 0x12, 0xe0, 0x21, 0xc7, 0x13, 0x46, 0x05, 0xd5, 0xff, 0xef, 0x28, 0x9c,
+	0x51, 0x38, 0xa7, 0x9a, 0xe8, 0x48, 0xb7, 0xa4, 0xb2, 0xde, 0x66, 0x23, 0xa0, 0x01, 0xcd, 0x4a,
+	0x7b, 0x5b, 0x49, 0x15, 0xbd, 0x17, 0xb4, 0xca, 0x8d, 0x9c, 0xd1, 0x13, 0x44, 0x80, 0xee, 0x69,
+	0x55, 0x98, 0x41, 0x22, 0xfa, 0x0c, 0x7c, 0xca, 0x06, 0xdc, 0x50, 0x5b, 0xc5, 0x76, 0xb9, 0x7b,
+	0x86, 0x7f, 0x1e, 0x8d, 0xaf, 0xb6, 0xb0, 0x9b, 0xb1, 0x8e, 0xb1, 0x49, 0xad, 0xc6, 0x9c, 0xc4,
+	0xd1, 0x25, 0xfa, 0xe6, 0x81, 0xdc, 0x0a, 0x7c, 0x62, 0x5c, 0xbf, 0xd6, 0xea, 0xf2, 0xa5, 0xcf,
+	0xe1, 0x71, 0x0a, 0x89, 0x0f, 0xdc, 0x28, 0xb4, 0x8a, 0xed, 0xbf, 0xce, 0xe9, 0x26, 0xb5, 0x4e,
+	0xa4, 0xc3, 0x21, 0x81, 0xdc, 0x9a, 0x94, 0x7a, 0x3b, 0x45, 0xbf, 0xd3, 0x4a, 0x13, 0xc2, 0x48,
+	0xcc, 0x8d, 0x62, 0x4b, 0x6d, 0x97, 0xbb, 0xe6, 0xb1, 0x25, 0xef, 0x33, 0xca, 0xf9, 0xb7, 0x48,
+	0x2d, 0x65, 0x93, 0x5a, 0x55, 0x39, 0x41, 0xf6, 0x22, 0x37, 0x37, 0xd1, 0xc7, 0x5a, 0xdd, 0x9b,
+	0x0e, 0x87, 0xc0, 0x60, 0xb0, 0xbf, 0xfe, 0xcf, 0xef, 0xaf, 0xff, 0xb2, 0xfb, 0xa1, 0x0d, 0x72,
+	0x6b, 0x3b, 0x29, 0xff, 0x03, 0xe7, 0x76, 0xb1, 0x32, 0xd5, 0xe5, 0xca, 0x54, 0xdf, 0x56, 0xa6,
+	0xfa, 0xb2, 0x36, 0x95, 0xe5, 0xda, 0x54, 0x5e, 0xd7, 0xa6, 0xf2, 0x80, 0x83, 0x50, 0x8c, 0xa6,
+	0x1e, 0xf6, 0x69, 0x6c, 0xc7, 0x44, 0x84, 0x7e, 0x02, 0xe2, 0x89, 0xb2, 0xb1, 0xbd, 0x0f, 0xf9,
+	0x39, 0x8f, 0x59, 0xcc, 0x27, 0xc0, 0xbd, 0x52, 0x96, 0xe4, 0xc5, 0xc7, 0x00, 0xa6, 0x95, 0x82,
+	0x9e, 0x43, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,6 +146,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BufferedRecords) > 0 {
+		for iNdEx := len(m.BufferedRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BufferedRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.RecordSequences) > 0 {
 		for iNdEx := len(m.RecordSequences) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.RecordSequences[iNdEx])
@@ -181,6 +225,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BufferedRecords) > 0 {
+		for _, e := range m.BufferedRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -285,6 +337,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.RecordSequences = append(m.RecordSequences, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferedRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BufferedRecords = append(m.BufferedRecords, &EventRecord{})
+			if err := m.BufferedRecords[len(m.BufferedRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
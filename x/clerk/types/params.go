@@ -0,0 +1,164 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/maticnetwork/bor/common"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter values
+const (
+	DefaultMaxRecordDataSize        uint64        = 30 * 1024
+	DefaultContractWindow           time.Duration = time.Hour
+	DefaultMaxContractWindowRecords uint64        = 0
+	DefaultBufferRecords                          = false
+)
+
+// Parameter keys
+var (
+	KeyMaxRecordDataSize        = []byte("MaxRecordDataSize")
+	KeyContractWindow           = []byte("ContractWindow")
+	KeyMaxContractWindowRecords = []byte("MaxContractWindowRecords")
+	KeyAllowedContracts         = []byte("AllowedContracts")
+	KeyDeniedContracts          = []byte("DeniedContracts")
+	KeyBufferRecords            = []byte("BufferRecords")
+)
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		MaxRecordDataSize:        DefaultMaxRecordDataSize,
+		ContractWindow:           DefaultContractWindow,
+		MaxContractWindowRecords: DefaultMaxContractWindowRecords,
+		AllowedContracts:         []string{},
+		DeniedContracts:          []string{},
+		BufferRecords:            DefaultBufferRecords,
+	}
+}
+
+// ParamKeyTable for clerk module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxRecordDataSize, &p.MaxRecordDataSize, validateMaxRecordDataSize),
+		paramtypes.NewParamSetPair(KeyContractWindow, &p.ContractWindow, validateContractWindow),
+		paramtypes.NewParamSetPair(KeyMaxContractWindowRecords, &p.MaxContractWindowRecords, validateMaxContractWindowRecords),
+		paramtypes.NewParamSetPair(KeyAllowedContracts, &p.AllowedContracts, validateContracts),
+		paramtypes.NewParamSetPair(KeyDeniedContracts, &p.DeniedContracts, validateContracts),
+		paramtypes.NewParamSetPair(KeyBufferRecords, &p.BufferRecords, validateBufferRecords),
+	}
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateMaxRecordDataSize(p.MaxRecordDataSize); err != nil {
+		return err
+	}
+
+	if err := validateContractWindow(p.ContractWindow); err != nil {
+		return err
+	}
+
+	if err := validateMaxContractWindowRecords(p.MaxContractWindowRecords); err != nil {
+		return err
+	}
+
+	if err := validateContracts(p.AllowedContracts); err != nil {
+		return err
+	}
+
+	if err := validateContracts(p.DeniedContracts); err != nil {
+		return err
+	}
+
+	for _, contract := range p.DeniedContracts {
+		if ContainsContract(p.AllowedContracts, contract) {
+			return fmt.Errorf("contract %s is both allowed and denied", contract)
+		}
+	}
+
+	return nil
+}
+
+// IsContractAllowed checks if the records of the receiver contract can be stored with their data
+func (p Params) IsContractAllowed(contract string) bool {
+	if ContainsContract(p.DeniedContracts, contract) {
+		return false
+	}
+
+	return len(p.AllowedContracts) == 0 || ContainsContract(p.AllowedContracts, contract)
+}
+
+// ContainsContract checks if the contract address is in the list, ignoring case and 0x prefix
+func ContainsContract(contracts []string, contract string) bool {
+	for _, c := range contracts {
+		if strings.EqualFold(strings.TrimPrefix(c, "0x"), strings.TrimPrefix(contract, "0x")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateMaxRecordDataSize(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateContractWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("invalid contract window: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxContractWindowRecords(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBufferRecords(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateContracts(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for idx, contract := range v {
+		if !common.IsHexAddress(contract) {
+			return fmt.Errorf("invalid contract address: %s", contract)
+		}
+
+		if ContainsContract(v[:idx], contract) {
+			return fmt.Errorf("duplicate contract address: %s", contract)
+		}
+	}
+
+	return nil
+}
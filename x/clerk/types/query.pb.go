@@ -283,6 +283,429 @@ func (m *QueryRecordListResponse) GetEventRecords() []*EventRecord {
 	return nil
 }
 
+// QueryRecordsByTimeRequest is request type for the Query/RecordsByTime RPC method,
+// times are unix timestamps and to_time is excluded
+type QueryRecordsByTimeRequest struct {
+	FromTime uint64 `protobuf:"varint,1,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime   uint64 `protobuf:"varint,2,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	// from_id skips the records with a lower id
+	FromId uint64 `protobuf:"varint,3,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	// contract only returns the records of the receiver contract
+	Contract string `protobuf:"bytes,4,opt,name=contract,proto3" json:"contract,omitempty"`
+	Limit    uint64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor continues the scan after the record with the id, the cursor of the previous page
+	Cursor uint64 `protobuf:"varint,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *QueryRecordsByTimeRequest) Reset()         { *m = QueryRecordsByTimeRequest{} }
+func (m *QueryRecordsByTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecordsByTimeRequest) ProtoMessage()    {}
+func (*QueryRecordsByTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aed47df6f39147d, []int{6}
+}
+func (m *QueryRecordsByTimeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRecordsByTimeRequest.Unmarshal(m, b)
+}
+func (m *QueryRecordsByTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRecordsByTimeRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryRecordsByTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecordsByTimeRequest.Merge(m, src)
+}
+func (m *QueryRecordsByTimeRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRecordsByTimeRequest.Size(m)
+}
+func (m *QueryRecordsByTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecordsByTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecordsByTimeRequest proto.InternalMessageInfo
+
+func (m *QueryRecordsByTimeRequest) GetFromTime() uint64 {
+	if m != nil {
+		return m.FromTime
+	}
+	return 0
+}
+
+func (m *QueryRecordsByTimeRequest) GetToTime() uint64 {
+	if m != nil {
+		return m.ToTime
+	}
+	return 0
+}
+
+func (m *QueryRecordsByTimeRequest) GetFromId() uint64 {
+	if m != nil {
+		return m.FromId
+	}
+	return 0
+}
+
+func (m *QueryRecordsByTimeRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryRecordsByTimeRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *QueryRecordsByTimeRequest) GetCursor() uint64 {
+	if m != nil {
+		return m.Cursor
+	}
+	return 0
+}
+
+// QueryRecordsFromIDRequest is request type for the Query/RecordsFromID RPC method
+type QueryRecordsFromIDRequest struct {
+	FromId uint64 `protobuf:"varint,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	// to_time stops at the first record at or after the unix timestamp, if set
+	ToTime uint64 `protobuf:"varint,2,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	// contract only returns the records of the receiver contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	Limit    uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor continues the scan after the record with the id, the cursor of the previous page
+	Cursor uint64 `protobuf:"varint,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *QueryRecordsFromIDRequest) Reset()         { *m = QueryRecordsFromIDRequest{} }
+func (m *QueryRecordsFromIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecordsFromIDRequest) ProtoMessage()    {}
+func (*QueryRecordsFromIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aed47df6f39147d, []int{7}
+}
+func (m *QueryRecordsFromIDRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRecordsFromIDRequest.Unmarshal(m, b)
+}
+func (m *QueryRecordsFromIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRecordsFromIDRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryRecordsFromIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecordsFromIDRequest.Merge(m, src)
+}
+func (m *QueryRecordsFromIDRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRecordsFromIDRequest.Size(m)
+}
+func (m *QueryRecordsFromIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecordsFromIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecordsFromIDRequest proto.InternalMessageInfo
+
+func (m *QueryRecordsFromIDRequest) GetFromId() uint64 {
+	if m != nil {
+		return m.FromId
+	}
+	return 0
+}
+
+func (m *QueryRecordsFromIDRequest) GetToTime() uint64 {
+	if m != nil {
+		return m.ToTime
+	}
+	return 0
+}
+
+func (m *QueryRecordsFromIDRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryRecordsFromIDRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *QueryRecordsFromIDRequest) GetCursor() uint64 {
+	if m != nil {
+		return m.Cursor
+	}
+	return 0
+}
+
+// QueryRecordPageResponse is response type for the Query/RecordsByTime and
+// Query/RecordsFromID RPC methods
+type QueryRecordPageResponse struct {
+	EventRecords []*EventRecord `protobuf:"bytes,1,rep,name=event_records,json=eventRecords,proto3" json:"event_records,omitempty"`
+	// cursor is the id of the last record scanned, 0 once all the records were scanned
+	Cursor uint64 `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *QueryRecordPageResponse) Reset()         { *m = QueryRecordPageResponse{} }
+func (m *QueryRecordPageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecordPageResponse) ProtoMessage()    {}
+func (*QueryRecordPageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aed47df6f39147d, []int{8}
+}
+func (m *QueryRecordPageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRecordPageResponse.Unmarshal(m, b)
+}
+func (m *QueryRecordPageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRecordPageResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryRecordPageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecordPageResponse.Merge(m, src)
+}
+func (m *QueryRecordPageResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryRecordPageResponse.Size(m)
+}
+func (m *QueryRecordPageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecordPageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecordPageResponse proto.InternalMessageInfo
+
+func (m *QueryRecordPageResponse) GetEventRecords() []*EventRecord {
+	if m != nil {
+		return m.EventRecords
+	}
+	return nil
+}
+
+func (m *QueryRecordPageResponse) GetCursor() uint64 {
+	if m != nil {
+		return m.Cursor
+	}
+	return 0
+}
+
+// QueryLatestRecordIDRequest is request type for the Query/LatestRecordID RPC method
+type QueryLatestRecordIDRequest struct {
+}
+
+func (m *QueryLatestRecordIDRequest) Reset()         { *m = QueryLatestRecordIDRequest{} }
+func (m *QueryLatestRecordIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestRecordIDRequest) ProtoMessage()    {}
+func (*QueryLatestRecordIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aed47df6f39147d, []int{9}
+}
+func (m *QueryLatestRecordIDRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryLatestRecordIDRequest.Unmarshal(m, b)
+}
+func (m *QueryLatestRecordIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryLatestRecordIDRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryLatestRecordIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestRecordIDRequest.Merge(m, src)
+}
+func (m *QueryLatestRecordIDRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryLatestRecordIDRequest.Size(m)
+}
+func (m *QueryLatestRecordIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestRecordIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLatestRecordIDRequest proto.InternalMessageInfo
+
+// QueryLatestRecordIDResponse is response type for the Query/LatestRecordID RPC method
+type QueryLatestRecordIDResponse struct {
+	LatestRecordId uint64 `protobuf:"varint,1,opt,name=latest_record_id,json=latestRecordId,proto3" json:"latest_record_id,omitempty"`
+	Found          bool   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+}
+
+func (m *QueryLatestRecordIDResponse) Reset()         { *m = QueryLatestRecordIDResponse{} }
+func (m *QueryLatestRecordIDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestRecordIDResponse) ProtoMessage()    {}
+func (*QueryLatestRecordIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aed47df6f39147d, []int{10}
+}
+func (m *QueryLatestRecordIDResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryLatestRecordIDResponse.Unmarshal(m, b)
+}
+func (m *QueryLatestRecordIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryLatestRecordIDResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryLatestRecordIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestRecordIDResponse.Merge(m, src)
+}
+func (m *QueryLatestRecordIDResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryLatestRecordIDResponse.Size(m)
+}
+func (m *QueryLatestRecordIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestRecordIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLatestRecordIDResponse proto.InternalMessageInfo
+
+func (m *QueryLatestRecordIDResponse) GetLatestRecordId() uint64 {
+	if m != nil {
+		return m.LatestRecordId
+	}
+	return 0
+}
+
+func (m *QueryLatestRecordIDResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aed47df6f39147d, []int{11}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryParamsRequest.Unmarshal(m, b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryParamsRequest.Size(m)
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aed47df6f39147d, []int{12}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryParamsResponse.Unmarshal(m, b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryParamsResponse.Size(m)
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryMissingRecordsRequest is request type for the Query/MissingRecords RPC method
+type QueryMissingRecordsRequest struct {
+	Limit uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryMissingRecordsRequest) Reset()         { *m = QueryMissingRecordsRequest{} }
+func (m *QueryMissingRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMissingRecordsRequest) ProtoMessage()    {}
+func (*QueryMissingRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aed47df6f39147d, []int{13}
+}
+func (m *QueryMissingRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryMissingRecordsRequest.Unmarshal(m, b)
+}
+func (m *QueryMissingRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryMissingRecordsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryMissingRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissingRecordsRequest.Merge(m, src)
+}
+func (m *QueryMissingRecordsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryMissingRecordsRequest.Size(m)
+}
+func (m *QueryMissingRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissingRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissingRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryMissingRecordsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// QueryMissingRecordsResponse is response type for the Query/MissingRecords RPC method
+type QueryMissingRecordsResponse struct {
+	// next_record_id is the id of the first record not stored yet
+	NextRecordId uint64 `protobuf:"varint,1,opt,name=next_record_id,json=nextRecordId,proto3" json:"next_record_id,omitempty"`
+	// latest_record_id is the highest id of the stored and buffered records
+	LatestRecordId   uint64   `protobuf:"varint,2,opt,name=latest_record_id,json=latestRecordId,proto3" json:"latest_record_id,omitempty"`
+	MissingRecordIds []uint64 `protobuf:"varint,3,rep,packed,name=missing_record_ids,json=missingRecordIds,proto3" json:"missing_record_ids,omitempty"`
+}
+
+func (m *QueryMissingRecordsResponse) Reset()         { *m = QueryMissingRecordsResponse{} }
+func (m *QueryMissingRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMissingRecordsResponse) ProtoMessage()    {}
+func (*QueryMissingRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aed47df6f39147d, []int{14}
+}
+func (m *QueryMissingRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryMissingRecordsResponse.Unmarshal(m, b)
+}
+func (m *QueryMissingRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryMissingRecordsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryMissingRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissingRecordsResponse.Merge(m, src)
+}
+func (m *QueryMissingRecordsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryMissingRecordsResponse.Size(m)
+}
+func (m *QueryMissingRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissingRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissingRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryMissingRecordsResponse) GetNextRecordId() uint64 {
+	if m != nil {
+		return m.NextRecordId
+	}
+	return 0
+}
+
+func (m *QueryMissingRecordsResponse) GetLatestRecordId() uint64 {
+	if m != nil {
+		return m.LatestRecordId
+	}
+	return 0
+}
+
+func (m *QueryMissingRecordsResponse) GetMissingRecordIds() []uint64 {
+	if m != nil {
+		return m.MissingRecordIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryRecordParams)(nil), "heimdall.clerk.v1beta1.QueryRecordParams")
 	proto.RegisterType((*QueryRecordResponse)(nil), "heimdall.clerk.v1beta1.QueryRecordResponse")
@@ -290,6 +713,15 @@ func init() {
 	proto.RegisterType((*QueryIsOldTxResponse)(nil), "heimdall.clerk.v1beta1.QueryIsOldTxResponse")
 	proto.RegisterType((*QueryRecordListRequest)(nil), "heimdall.clerk.v1beta1.QueryRecordListRequest")
 	proto.RegisterType((*QueryRecordListResponse)(nil), "heimdall.clerk.v1beta1.QueryRecordListResponse")
+	proto.RegisterType((*QueryRecordsByTimeRequest)(nil), "heimdall.clerk.v1beta1.QueryRecordsByTimeRequest")
+	proto.RegisterType((*QueryRecordsFromIDRequest)(nil), "heimdall.clerk.v1beta1.QueryRecordsFromIDRequest")
+	proto.RegisterType((*QueryRecordPageResponse)(nil), "heimdall.clerk.v1beta1.QueryRecordPageResponse")
+	proto.RegisterType((*QueryLatestRecordIDRequest)(nil), "heimdall.clerk.v1beta1.QueryLatestRecordIDRequest")
+	proto.RegisterType((*QueryLatestRecordIDResponse)(nil), "heimdall.clerk.v1beta1.QueryLatestRecordIDResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "heimdall.clerk.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "heimdall.clerk.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryMissingRecordsRequest)(nil), "heimdall.clerk.v1beta1.QueryMissingRecordsRequest")
+	proto.RegisterType((*QueryMissingRecordsResponse)(nil), "heimdall.clerk.v1beta1.QueryMissingRecordsResponse")
 }
 
 func init() {
@@ -297,42 +729,67 @@ func init() {
 }
 
 var fileDescriptor_7aed47df6f39147d = []byte{
-	// 550 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x9b, 0x9f, 0xb6, 0xd3, 0x7e, 0x8b, 0x6f, 0x88, 0xd2, 0x28, 0x20, 0x43, 0xcd, 0x82,
-	0xbf, 0xca, 0x43, 0xc3, 0x1b, 0x80, 0x40, 0x89, 0x40, 0x02, 0xac, 0xae, 0x90, 0x50, 0x34, 0x89,
-	0x2f, 0xce, 0xa8, 0xb6, 0xc7, 0xf5, 0x4c, 0x8a, 0x2b, 0xc4, 0x86, 0x27, 0x00, 0x15, 0x89, 0x57,
-	0x62, 0x85, 0x2a, 0xb1, 0x61, 0x89, 0x12, 0x1e, 0x04, 0xcd, 0x4f, 0xd3, 0x54, 0x24, 0x95, 0x77,
-	0x73, 0xef, 0x9c, 0x7b, 0xe6, 0x9c, 0xa3, 0x6b, 0x23, 0x6f, 0x0c, 0x2c, 0x09, 0x69, 0x1c, 0x93,
-	0x51, 0x0c, 0xf9, 0x21, 0x39, 0xde, 0x1f, 0x82, 0xa4, 0xfb, 0xe4, 0x68, 0x02, 0xf9, 0x89, 0x9f,
-	0xe5, 0x5c, 0x72, 0xdc, 0x3a, 0xc7, 0xf8, 0x1a, 0xe3, 0x5b, 0x4c, 0xe7, 0x46, 0xc4, 0x79, 0x14,
-	0x03, 0xa1, 0x19, 0x23, 0x34, 0x4d, 0xb9, 0xa4, 0x92, 0xf1, 0x54, 0x98, 0xa9, 0xce, 0x2a, 0x66,
-	0xc3, 0x61, 0x30, 0xcd, 0x88, 0x47, 0x5c, 0x1f, 0x89, 0x3a, 0xd9, 0xee, 0xee, 0x7c, 0x72, 0x48,
-	0x05, 0x2c, 0x93, 0xe4, 0x3d, 0x44, 0xff, 0xbf, 0x56, 0x65, 0x00, 0x23, 0x9e, 0x87, 0xaf, 0x68,
-	0x4e, 0x13, 0x81, 0xaf, 0xa3, 0xcd, 0x5c, 0xd7, 0x03, 0x16, 0xb6, 0x9d, 0x5b, 0xce, 0xdd, 0x5a,
-	0xb0, 0x61, 0x1a, 0xfd, 0xd0, 0x7b, 0x8b, 0xae, 0x2d, 0x4c, 0x04, 0x20, 0x32, 0x9e, 0x0a, 0xc0,
-	0xcf, 0xd0, 0x36, 0x1c, 0x43, 0x2a, 0x07, 0x06, 0xa8, 0xc7, 0xb6, 0xba, 0xb7, 0xfd, 0xe5, 0x96,
-	0xfd, 0xa7, 0x0a, 0x6b, 0x29, 0xb6, 0xe0, 0xa2, 0xf0, 0x9e, 0x5b, 0xfa, 0xbe, 0x78, 0x19, 0x87,
-	0x07, 0x45, 0x00, 0x47, 0x13, 0x10, 0x12, 0xef, 0xa0, 0x75, 0x59, 0x0c, 0xc6, 0x54, 0x8c, 0x35,
-	0xf3, 0x66, 0xd0, 0x90, 0x45, 0x8f, 0x8a, 0xb1, 0xd2, 0x1a, 0xf3, 0x68, 0xc0, 0xd2, 0x10, 0x8a,
-	0xf6, 0x9a, 0xd1, 0x1a, 0xf3, 0xa8, 0xaf, 0x6a, 0xcf, 0x47, 0xcd, 0xcb, 0x64, 0x56, 0x6c, 0x0b,
-	0x35, 0x84, 0xa4, 0x72, 0x22, 0x34, 0xd9, 0x46, 0x60, 0x2b, 0xef, 0x8b, 0x83, 0x5a, 0x0b, 0xe6,
-	0x5e, 0x30, 0x21, 0xcf, 0x05, 0x60, 0x54, 0xcb, 0x68, 0x04, 0x36, 0x0e, 0x7d, 0xc6, 0x4d, 0x54,
-	0x8f, 0x59, 0xc2, 0xa4, 0x7d, 0xd7, 0x14, 0x4a, 0xea, 0xbb, 0x9c, 0x27, 0x2a, 0xbb, 0xaa, 0xee,
-	0x37, 0x54, 0xd9, 0x0f, 0x95, 0x54, 0x7d, 0x21, 0x59, 0x02, 0xed, 0x9a, 0x91, 0xaa, 0x1a, 0x07,
-	0x2c, 0x01, 0x6d, 0x90, 0x9b, 0xab, 0xba, 0x99, 0x92, 0x5c, 0x5d, 0x78, 0x23, 0xb4, 0xf3, 0x8f,
-	0x24, 0x6b, 0xa3, 0x87, 0xfe, 0x5b, 0xcc, 0x5c, 0xb9, 0xa9, 0x96, 0x0d, 0x7d, 0x7b, 0x21, 0x74,
-	0xd1, 0xfd, 0x51, 0x45, 0x75, 0xfd, 0x0a, 0x3e, 0x75, 0xd0, 0xba, 0xed, 0x62, 0x7f, 0x15, 0xd1,
-	0xf2, 0x8c, 0x3a, 0xa4, 0x34, 0xde, 0x18, 0xf0, 0xee, 0x7c, 0xfa, 0xf9, 0xe7, 0x74, 0x6d, 0x17,
-	0xdf, 0x24, 0x2b, 0x76, 0xdc, 0x1a, 0xc3, 0x5f, 0x1d, 0xd4, 0x30, 0xf3, 0xf8, 0x5e, 0x89, 0x47,
-	0xcc, 0x1e, 0x77, 0x1e, 0x94, 0x80, 0xce, 0xb5, 0x74, 0xb5, 0x96, 0x3d, 0x7c, 0xff, 0x6a, 0x2d,
-	0xe4, 0xc3, 0xfc, 0xd3, 0xf8, 0x88, 0xbf, 0x39, 0xf6, 0xf3, 0xb1, 0x0b, 0xf6, 0x44, 0x0d, 0xe0,
-	0xab, 0x9f, 0xbd, 0xbc, 0xd8, 0x9d, 0xbd, 0x72, 0xe0, 0xb2, 0x81, 0x31, 0xc1, 0xe3, 0x50, 0x16,
-	0x8f, 0x7b, 0xdf, 0xa7, 0x6e, 0xe5, 0x6c, 0xea, 0x56, 0x7e, 0x4f, 0x5d, 0xe7, 0xf3, 0xcc, 0xad,
-	0x9c, 0xcd, 0xdc, 0xca, 0xaf, 0x99, 0x5b, 0x79, 0xe3, 0x47, 0x4c, 0x8e, 0x27, 0x43, 0x7f, 0xc4,
-	0x13, 0x92, 0x50, 0xc9, 0x46, 0x29, 0xc8, 0xf7, 0x3c, 0x3f, 0xbc, 0x60, 0x2c, 0x2c, 0xa7, 0x3c,
-	0xc9, 0x40, 0x0c, 0x1b, 0xfa, 0x47, 0xf1, 0xe8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x5a,
-	0x5b, 0x50, 0xe1, 0x04, 0x00, 0x00,
+	// 957 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0xc7, 0xd7, 0xfb, 0xd6, 0xe4, 0x69, 0x1a, 0xb5, 0xd3, 0x28, 0x5d, 0x9c, 0x6a, 0x9b, 0x1a,
+	0x44, 0xd3, 0x34, 0x6b, 0x37, 0x9b, 0x13, 0x12, 0xa7, 0x00, 0x55, 0x56, 0x14, 0x51, 0x4c, 0x4f,
+	0x48, 0xd5, 0xca, 0xbb, 0x9e, 0x7a, 0x47, 0xb5, 0x3d, 0x5b, 0xcf, 0x6c, 0xd9, 0x28, 0xea, 0x85,
+	0x1b, 0x07, 0x24, 0x50, 0x91, 0x7a, 0xe0, 0xca, 0x09, 0x24, 0x4e, 0x7c, 0x88, 0x1e, 0x2b, 0x71,
+	0xe1, 0x84, 0x50, 0xc2, 0x07, 0x41, 0xf3, 0xb2, 0xbb, 0x76, 0x6b, 0x27, 0x8e, 0xd4, 0x9b, 0x9f,
+	0xf1, 0xf3, 0xf2, 0x7b, 0xfe, 0x63, 0xfd, 0x77, 0xc1, 0x1a, 0x61, 0x12, 0xf9, 0x5e, 0x18, 0x3a,
+	0xc3, 0x10, 0x27, 0x4f, 0x9c, 0x67, 0xbb, 0x03, 0xcc, 0xbd, 0x5d, 0xe7, 0xe9, 0x04, 0x27, 0x87,
+	0xf6, 0x38, 0xa1, 0x9c, 0xa2, 0xf5, 0x59, 0x8e, 0x2d, 0x73, 0x6c, 0x9d, 0x63, 0x5e, 0x0f, 0x28,
+	0x0d, 0x42, 0xec, 0x78, 0x63, 0xe2, 0x78, 0x71, 0x4c, 0xb9, 0xc7, 0x09, 0x8d, 0x99, 0xaa, 0x32,
+	0x8b, 0x3a, 0xab, 0x1e, 0x2a, 0x67, 0x2d, 0xa0, 0x01, 0x95, 0x8f, 0x8e, 0x78, 0xd2, 0xa7, 0x37,
+	0xe7, 0x95, 0x03, 0x8f, 0xe1, 0x3c, 0x24, 0xeb, 0x2e, 0x5c, 0xf9, 0x4a, 0x84, 0x2e, 0x1e, 0xd2,
+	0xc4, 0x7f, 0xe0, 0x25, 0x5e, 0xc4, 0xd0, 0x06, 0x2c, 0x27, 0x32, 0xee, 0x13, 0xbf, 0x65, 0x6c,
+	0x1a, 0x5b, 0x75, 0x77, 0x49, 0x1d, 0xf4, 0x7c, 0xeb, 0x11, 0x5c, 0x4d, 0x55, 0xb8, 0x98, 0x8d,
+	0x69, 0xcc, 0x30, 0xba, 0x07, 0x2b, 0xf8, 0x19, 0x8e, 0x79, 0x5f, 0x25, 0xca, 0xb2, 0x8b, 0xdd,
+	0xf7, 0xed, 0xfc, 0x95, 0xed, 0xcf, 0x44, 0xae, 0x6e, 0x71, 0x11, 0x2f, 0x02, 0xeb, 0x73, 0xdd,
+	0xbe, 0xc7, 0xbe, 0x0c, 0xfd, 0x87, 0x53, 0x17, 0x3f, 0x9d, 0x60, 0xc6, 0xd1, 0x35, 0xb8, 0xc0,
+	0xa7, 0xfd, 0x91, 0xc7, 0x46, 0xb2, 0xf3, 0xb2, 0xdb, 0xe4, 0xd3, 0x03, 0x8f, 0x8d, 0x04, 0x6b,
+	0x48, 0x83, 0x3e, 0x89, 0x7d, 0x3c, 0x6d, 0x55, 0x15, 0x6b, 0x48, 0x83, 0x9e, 0x88, 0x2d, 0x1b,
+	0xd6, 0xb2, 0xcd, 0x34, 0xec, 0x3a, 0x34, 0x19, 0xf7, 0xf8, 0x84, 0xc9, 0x66, 0x4b, 0xae, 0x8e,
+	0xac, 0x9f, 0x0c, 0x58, 0x4f, 0x2d, 0x77, 0x9f, 0x30, 0x3e, 0x03, 0x40, 0x50, 0x1f, 0x7b, 0x01,
+	0xd6, 0x72, 0xc8, 0x67, 0xb4, 0x06, 0x8d, 0x90, 0x44, 0x84, 0xeb, 0xb9, 0x2a, 0x10, 0xa8, 0x8f,
+	0x13, 0x1a, 0x09, 0xed, 0x6a, 0xf2, 0xbc, 0x29, 0xc2, 0x9e, 0x2f, 0x50, 0xe5, 0x0b, 0x4e, 0x22,
+	0xdc, 0xaa, 0x2b, 0x54, 0x71, 0xf0, 0x90, 0x44, 0x58, 0x2e, 0x48, 0xd5, 0xab, 0x86, 0xaa, 0xe2,
+	0x54, 0xbc, 0xb0, 0x86, 0x70, 0xed, 0x2d, 0x24, 0xbd, 0xc6, 0x01, 0x5c, 0x4a, 0x6b, 0x2e, 0xb6,
+	0xa9, 0x95, 0x15, 0x7d, 0x25, 0x25, 0x3a, 0xb3, 0xfe, 0x34, 0xe0, 0xbd, 0xd4, 0x14, 0xb6, 0x7f,
+	0x28, 0x66, 0xcf, 0x76, 0xcf, 0x80, 0x1b, 0xc5, 0xe0, 0xd5, 0x34, 0x78, 0xb1, 0x0e, 0x26, 0x2c,
+	0x0d, 0x69, 0xcc, 0x13, 0x6f, 0xc8, 0xa5, 0x0c, 0xcb, 0xee, 0x3c, 0x5e, 0x48, 0xda, 0x48, 0x4b,
+	0xba, 0x0e, 0xcd, 0xe1, 0x24, 0x61, 0x34, 0x69, 0x35, 0x55, 0x27, 0x15, 0x59, 0x2f, 0xdf, 0xc0,
+	0xbe, 0x27, 0x06, 0x7c, 0x9a, 0xfa, 0x66, 0x66, 0x00, 0x46, 0x06, 0xa0, 0x10, 0x39, 0x4d, 0x56,
+	0x2b, 0x22, 0xab, 0xe7, 0x93, 0x35, 0x32, 0x64, 0x47, 0x99, 0x5b, 0x7b, 0xe0, 0x05, 0xf8, 0xdd,
+	0xdf, 0x5a, 0x6a, 0x78, 0x35, 0x33, 0xfc, 0x3a, 0x98, 0x72, 0xf8, 0x7d, 0x8f, 0x63, 0xa6, 0xb3,
+	0xe7, 0xb2, 0x58, 0x8f, 0x60, 0x23, 0xf7, 0xad, 0xc6, 0xdb, 0x82, 0xcb, 0xa1, 0x7c, 0xd3, 0x7f,
+	0xd3, 0x03, 0x56, 0xc3, 0x74, 0x85, 0x2f, 0x14, 0x79, 0x4c, 0x27, 0xb1, 0x2f, 0xa7, 0x2f, 0xb9,
+	0x2a, 0xb0, 0xd6, 0x00, 0xc9, 0xf6, 0xca, 0x4b, 0x66, 0x43, 0xbf, 0x86, 0xab, 0x99, 0x53, 0x3d,
+	0xec, 0x63, 0x68, 0x8e, 0xe5, 0x89, 0xf6, 0x8b, 0x76, 0x91, 0x08, 0xaa, 0x6e, 0xbf, 0xfe, 0xea,
+	0x9f, 0x1b, 0x15, 0x57, 0xd7, 0x58, 0x5d, 0xbd, 0xe7, 0x17, 0x84, 0x31, 0x12, 0x07, 0x5a, 0x96,
+	0xd9, 0xf5, 0xcf, 0x2f, 0xcc, 0x48, 0x5d, 0x98, 0xf5, 0x8b, 0x01, 0x1b, 0xb9, 0x45, 0x9a, 0xe8,
+	0x03, 0x58, 0x8d, 0xf1, 0xf4, 0xed, 0xe5, 0x57, 0xc4, 0xe9, 0x7c, 0xf5, 0x3c, 0x91, 0xaa, 0xb9,
+	0x22, 0xed, 0x00, 0x8a, 0xd4, 0xa4, 0x45, 0x2a, 0x6b, 0xd5, 0x36, 0x6b, 0x5b, 0x75, 0xf7, 0x72,
+	0x94, 0x66, 0xe8, 0xf9, 0xac, 0xfb, 0x03, 0x40, 0x43, 0xd2, 0xa1, 0x17, 0x06, 0x5c, 0x98, 0xdd,
+	0xb3, 0x5d, 0xa4, 0x4a, 0xbe, 0x57, 0x99, 0x4e, 0xe9, 0x7c, 0xb5, 0xb4, 0x75, 0xeb, 0xbb, 0xbf,
+	0xfe, 0x7b, 0x51, 0xbd, 0x89, 0x6e, 0x38, 0x05, 0xbf, 0x35, 0xfa, 0x53, 0x45, 0x3f, 0x1b, 0xd0,
+	0x54, 0xf5, 0xe8, 0x76, 0x89, 0x21, 0xea, 0xd6, 0xcc, 0x3b, 0x25, 0x52, 0xe7, 0x2c, 0x5d, 0xc9,
+	0xb2, 0x83, 0xb6, 0x4f, 0x67, 0x71, 0x8e, 0xe6, 0x72, 0x3e, 0x47, 0x2f, 0x0d, 0xb8, 0x92, 0x36,
+	0xfa, 0x4f, 0x44, 0x01, 0x3a, 0x7d, 0x6c, 0xf6, 0x07, 0xc6, 0xdc, 0x29, 0x97, 0x5c, 0x56, 0x30,
+	0xc2, 0x68, 0xe8, 0xf3, 0x29, 0xfa, 0xd5, 0x80, 0x4b, 0x19, 0x4f, 0x45, 0xbb, 0x25, 0xc4, 0xc8,
+	0xfa, 0x6f, 0xa9, 0xfb, 0x4c, 0x5b, 0x8c, 0xe5, 0x48, 0xbc, 0xdb, 0xe8, 0xd6, 0x19, 0xf7, 0xd9,
+	0x19, 0x1c, 0x76, 0x84, 0x0d, 0xa2, 0x3f, 0x16, 0x98, 0xca, 0x43, 0xcb, 0x61, 0x66, 0xfc, 0xf6,
+	0xfc, 0x98, 0x1f, 0x49, 0xcc, 0x3d, 0xb4, 0x7b, 0x16, 0xa6, 0xf0, 0xed, 0x0e, 0xf1, 0x9d, 0x23,
+	0xed, 0xe7, 0xcf, 0xd1, 0xef, 0x06, 0xac, 0x66, 0x0d, 0x0c, 0x75, 0x4f, 0x1d, 0x9f, 0xeb, 0x85,
+	0xe6, 0xde, 0xb9, 0x6a, 0x34, 0xf6, 0x5d, 0x89, 0xbd, 0x8d, 0xb6, 0x8a, 0xb0, 0x95, 0x05, 0x74,
+	0x14, 0x7d, 0x87, 0xf8, 0xe8, 0x7b, 0x03, 0x9a, 0xfa, 0xbf, 0xd5, 0xf6, 0xa9, 0x13, 0x33, 0xa6,
+	0x69, 0xde, 0x29, 0x95, 0xab, 0xa9, 0x3e, 0x94, 0x54, 0x9b, 0xa8, 0x5d, 0x44, 0xa5, 0x4c, 0x13,
+	0xfd, 0x66, 0xc0, 0x6a, 0xd6, 0xfb, 0xce, 0x50, 0x2e, 0xd7, 0x5d, 0xcd, 0xbd, 0x73, 0xd5, 0x94,
+	0xfd, 0x2e, 0xb5, 0x21, 0x6a, 0xe9, 0xd8, 0xfe, 0xc1, 0xab, 0xe3, 0x76, 0xe5, 0xf5, 0x71, 0xbb,
+	0xf2, 0xef, 0x71, 0xdb, 0xf8, 0xf1, 0xa4, 0x5d, 0x79, 0x7d, 0xd2, 0xae, 0xfc, 0x7d, 0xd2, 0xae,
+	0x7c, 0x63, 0x07, 0x84, 0x8f, 0x26, 0x03, 0x7b, 0x48, 0x23, 0x27, 0xf2, 0x38, 0x19, 0xc6, 0x98,
+	0x7f, 0x4b, 0x93, 0x27, 0x8b, 0xce, 0x53, 0xdd, 0x9b, 0x1f, 0x8e, 0x31, 0x1b, 0x34, 0xe5, 0xff,
+	0xdd, 0xbd, 0xff, 0x07, 0x00, 0x7e, 0x56, 0x32, 0x67, 0xa8, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -352,6 +809,16 @@ type QueryClient interface {
 	// Record queries the record that match by record id.
 	Record(ctx context.Context, in *QueryRecordParams, opts ...grpc.CallOption) (*QueryRecordResponse, error)
 	QueryIsOldTxClerk(ctx context.Context, in *QueryIsOldTxRequest, opts ...grpc.CallOption) (*QueryIsOldTxResponse, error)
+	// RecordsByTime queries the records in a time range, sorted by time.
+	RecordsByTime(ctx context.Context, in *QueryRecordsByTimeRequest, opts ...grpc.CallOption) (*QueryRecordPageResponse, error)
+	// RecordsFromID queries the records from a record id on, sorted by id.
+	RecordsFromID(ctx context.Context, in *QueryRecordsFromIDRequest, opts ...grpc.CallOption) (*QueryRecordPageResponse, error)
+	// LatestRecordID queries the id of the latest record.
+	LatestRecordID(ctx context.Context, in *QueryLatestRecordIDRequest, opts ...grpc.CallOption) (*QueryLatestRecordIDResponse, error)
+	// Params queries the parameters of the clerk module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// MissingRecords queries the ids of the records missing before the latest known record.
+	MissingRecords(ctx context.Context, in *QueryMissingRecordsRequest, opts ...grpc.CallOption) (*QueryMissingRecordsResponse, error)
 }
 
 type queryClient struct {
@@ -389,6 +856,51 @@ func (c *queryClient) QueryIsOldTxClerk(ctx context.Context, in *QueryIsOldTxReq
 	return out, nil
 }
 
+func (c *queryClient) RecordsByTime(ctx context.Context, in *QueryRecordsByTimeRequest, opts ...grpc.CallOption) (*QueryRecordPageResponse, error) {
+	out := new(QueryRecordPageResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.clerk.v1beta1.Query/RecordsByTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecordsFromID(ctx context.Context, in *QueryRecordsFromIDRequest, opts ...grpc.CallOption) (*QueryRecordPageResponse, error) {
+	out := new(QueryRecordPageResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.clerk.v1beta1.Query/RecordsFromID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestRecordID(ctx context.Context, in *QueryLatestRecordIDRequest, opts ...grpc.CallOption) (*QueryLatestRecordIDResponse, error) {
+	out := new(QueryLatestRecordIDResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.clerk.v1beta1.Query/LatestRecordID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.clerk.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MissingRecords(ctx context.Context, in *QueryMissingRecordsRequest, opts ...grpc.CallOption) (*QueryMissingRecordsResponse, error) {
+	out := new(QueryMissingRecordsResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.clerk.v1beta1.Query/MissingRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Records
@@ -396,6 +908,16 @@ type QueryServer interface {
 	// Record queries the record that match by record id.
 	Record(context.Context, *QueryRecordParams) (*QueryRecordResponse, error)
 	QueryIsOldTxClerk(context.Context, *QueryIsOldTxRequest) (*QueryIsOldTxResponse, error)
+	// RecordsByTime queries the records in a time range, sorted by time.
+	RecordsByTime(context.Context, *QueryRecordsByTimeRequest) (*QueryRecordPageResponse, error)
+	// RecordsFromID queries the records from a record id on, sorted by id.
+	RecordsFromID(context.Context, *QueryRecordsFromIDRequest) (*QueryRecordPageResponse, error)
+	// LatestRecordID queries the id of the latest record.
+	LatestRecordID(context.Context, *QueryLatestRecordIDRequest) (*QueryLatestRecordIDResponse, error)
+	// Params queries the parameters of the clerk module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// MissingRecords queries the ids of the records missing before the latest known record.
+	MissingRecords(context.Context, *QueryMissingRecordsRequest) (*QueryMissingRecordsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -411,6 +933,21 @@ func (*UnimplementedQueryServer) Record(ctx context.Context, req *QueryRecordPar
 func (*UnimplementedQueryServer) QueryIsOldTxClerk(ctx context.Context, req *QueryIsOldTxRequest) (*QueryIsOldTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryIsOldTxClerk not implemented")
 }
+func (*UnimplementedQueryServer) RecordsByTime(ctx context.Context, req *QueryRecordsByTimeRequest) (*QueryRecordPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordsByTime not implemented")
+}
+func (*UnimplementedQueryServer) RecordsFromID(ctx context.Context, req *QueryRecordsFromIDRequest) (*QueryRecordPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordsFromID not implemented")
+}
+func (*UnimplementedQueryServer) LatestRecordID(ctx context.Context, req *QueryLatestRecordIDRequest) (*QueryLatestRecordIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestRecordID not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) MissingRecords(ctx context.Context, req *QueryMissingRecordsRequest) (*QueryMissingRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissingRecords not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -470,6 +1007,96 @@ func _Query_QueryIsOldTxClerk_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RecordsByTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecordsByTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordsByTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.clerk.v1beta1.Query/RecordsByTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordsByTime(ctx, req.(*QueryRecordsByTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecordsFromID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecordsFromIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordsFromID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.clerk.v1beta1.Query/RecordsFromID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordsFromID(ctx, req.(*QueryRecordsFromIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestRecordID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestRecordIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestRecordID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.clerk.v1beta1.Query/LatestRecordID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestRecordID(ctx, req.(*QueryLatestRecordIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.clerk.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MissingRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissingRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissingRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.clerk.v1beta1.Query/MissingRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissingRecords(ctx, req.(*QueryMissingRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "heimdall.clerk.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -486,6 +1113,26 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryIsOldTxClerk",
 			Handler:    _Query_QueryIsOldTxClerk_Handler,
 		},
+		{
+			MethodName: "RecordsByTime",
+			Handler:    _Query_RecordsByTime_Handler,
+		},
+		{
+			MethodName: "RecordsFromID",
+			Handler:    _Query_RecordsFromID_Handler,
+		},
+		{
+			MethodName: "LatestRecordID",
+			Handler:    _Query_LatestRecordID_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "MissingRecords",
+			Handler:    _Query_MissingRecords_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdall/clerk/v1beta1/query.proto",
@@ -583,6 +1230,155 @@ func (m *QueryRecordListResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRecordsByTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromTime != 0 {
+		n += 1 + sovQuery(uint64(m.FromTime))
+	}
+	if m.ToTime != 0 {
+		n += 1 + sovQuery(uint64(m.ToTime))
+	}
+	if m.FromId != 0 {
+		n += 1 + sovQuery(uint64(m.FromId))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	if m.Cursor != 0 {
+		n += 1 + sovQuery(uint64(m.Cursor))
+	}
+	return n
+}
+
+func (m *QueryRecordsFromIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromId != 0 {
+		n += 1 + sovQuery(uint64(m.FromId))
+	}
+	if m.ToTime != 0 {
+		n += 1 + sovQuery(uint64(m.ToTime))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	if m.Cursor != 0 {
+		n += 1 + sovQuery(uint64(m.Cursor))
+	}
+	return n
+}
+
+func (m *QueryRecordPageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EventRecords) > 0 {
+		for _, e := range m.EventRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Cursor != 0 {
+		n += 1 + sovQuery(uint64(m.Cursor))
+	}
+	return n
+}
+
+func (m *QueryLatestRecordIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestRecordIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestRecordId != 0 {
+		n += 1 + sovQuery(uint64(m.LatestRecordId))
+	}
+	if m.Found {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMissingRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryMissingRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextRecordId != 0 {
+		n += 1 + sovQuery(uint64(m.NextRecordId))
+	}
+	if m.LatestRecordId != 0 {
+		n += 1 + sovQuery(uint64(m.LatestRecordId))
+	}
+	if len(m.MissingRecordIds) > 0 {
+		l = 0
+		for _, e := range m.MissingRecordIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
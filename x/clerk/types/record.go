@@ -4,9 +4,17 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/crypto"
 	hmCommon "github.com/maticnetwork/heimdall/types/common"
 )
 
+// Reasons the data of a record isn't stored with it
+const (
+	OmitReasonContractNotAllowed = "contract-not-allowed"
+	OmitReasonDataSize           = "data-size"
+	OmitReasonContractRateLimit  = "contract-rate-limit"
+)
+
 // NewEventRecord creates new record
 func NewEventRecord(
 	txHash hmCommon.HeimdallHash,
@@ -27,3 +35,10 @@ func NewEventRecord(
 		RecordTime: recordTime,
 	}
 }
+
+// OmitData replaces the data of the record by its keccak256 hash
+func (r *EventRecord) OmitData() {
+	r.DataHash = crypto.Keccak256(r.Data)
+	r.DataOmitted = true
+	r.Data = nil
+}
@@ -0,0 +1,102 @@
+package gov_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maticnetwork/heimdall/app"
+	checkpointSim "github.com/maticnetwork/heimdall/x/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/x/gov"
+	"github.com/maticnetwork/heimdall/x/gov/test_helper"
+	"github.com/maticnetwork/heimdall/x/gov/types"
+)
+
+// ABCITestSuite integrate test suite context object
+type ABCITestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+func (suite *ABCITestSuite) SetupTest() {
+	suite.app, suite.ctx, _ = test_helper.CreateTestApp(false)
+	suite.ctx = suite.ctx.WithBlockTime(time.Now().UTC())
+}
+
+func TestABCITestSuite(t *testing.T) {
+	suite.Run(t, new(ABCITestSuite))
+}
+
+func (suite *ABCITestSuite) TestTickExpiredDepositPeriod() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, test_helper.TestProposal)
+	require.NoError(t, err)
+
+	// nothing happens before the deposit period ends
+	gov.EndBlocker(ctx, app.GovKeeper)
+	_, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+
+	newCtx := ctx.WithBlockTime(ctx.BlockHeader().Time.Add(app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod))
+	gov.EndBlocker(newCtx, app.GovKeeper)
+
+	_, ok = app.GovKeeper.GetProposal(newCtx, proposal.ProposalId)
+	require.False(t, ok)
+
+	inactiveQueue := app.GovKeeper.InactiveProposalQueueIterator(newCtx, newCtx.BlockHeader().Time)
+	require.False(t, inactiveQueue.Valid())
+	inactiveQueue.Close()
+}
+
+func (suite *ABCITestSuite) TestTickPassedVotingPeriod() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	valSet := checkpointSim.LoadValidatorSet(3, t, app.StakingKeeper, ctx, false, 10)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, test_helper.TestProposal)
+	require.NoError(t, err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	for _, validator := range valSet.Validators {
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, validator.GetSigner(), types.OptionYes, validator.ID))
+	}
+
+	newCtx := ctx.WithBlockTime(ctx.BlockHeader().Time.Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod))
+	gov.EndBlocker(newCtx, app.GovKeeper)
+
+	proposal, ok := app.GovKeeper.GetProposal(newCtx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Equal(t, sdk.NewInt(30), proposal.FinalTallyResult.Yes)
+
+	activeQueue := app.GovKeeper.ActiveProposalQueueIterator(newCtx, newCtx.BlockHeader().Time)
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+}
+
+func (suite *ABCITestSuite) TestTickRejectedVotingPeriod() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	valSet := checkpointSim.LoadValidatorSet(3, t, app.StakingKeeper, ctx, false, 10)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, test_helper.TestProposal)
+	require.NoError(t, err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, valSet.Validators[0].GetSigner(), types.OptionYes, valSet.Validators[0].ID))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, valSet.Validators[1].GetSigner(), types.OptionNo, valSet.Validators[1].ID))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, valSet.Validators[2].GetSigner(), types.OptionNo, valSet.Validators[2].ID))
+
+	newCtx := ctx.WithBlockTime(ctx.BlockHeader().Time.Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod))
+	gov.EndBlocker(newCtx, app.GovKeeper)
+
+	proposal, ok := app.GovKeeper.GetProposal(newCtx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusRejected, proposal.Status)
+}
@@ -1,9 +1,11 @@
 package rest
 
 import (
-	"github.com/gorilla/mux"
+	"fmt"
 	"net/http"
 
+	"github.com/gorilla/mux"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	// this line is used by starport scaffolding # 1
 )
@@ -13,7 +15,10 @@ const (
 )
 
 // RegisterRoutes registers gov-related REST handlers to a router
-func RegisterRoutes(clientCtx client.Context, r *mux.Router) {
+func RegisterRoutes(clientCtx client.Context, r *mux.Router, phs []ProposalRESTHandler) {
+	for _, ph := range phs {
+		r.HandleFunc(fmt.Sprintf("/gov/proposals/%s", ph.SubRoute), ph.Handler).Methods("POST")
+	}
 }
 
 // ProposalRESTHandler defines a REST handler implemented in another module. The
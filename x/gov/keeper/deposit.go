@@ -103,25 +103,46 @@ func (keeper Keeper) GetAllDeposits(ctx sdk.Context) (deposits types.Deposits) {
 	return
 }
 
-// RefundDeposits refunds and deletes all the deposits on a specific proposal
-// func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
-// 	store := ctx.KVStore(keeper.storeKey)
-
-// 	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
-// 		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
-// 		if err != nil {
-// 			panic(err)
-// 		}
-
-// 		err = keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, deposit.Amount)
-// 		if err != nil {
-// 			panic(err)
-// 		}
-
-// 		store.Delete(types.DepositKey(proposalID, depositor))
-// 		return false
-// 	})
-// }
+// DeleteDeposits deletes all the deposits on a specific proposal without refunding them
+func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit.Amount)
+		if err != nil {
+			panic(err)
+		}
+
+		store.Delete(types.DepositKey(proposalID, deposit.Depositor))
+		return false
+	})
+}
+
+// RefundDeposits refunds and deletes all the deposits on a specific proposal.
+// Deposits are refunded to the current signer of the depositing validator.
+func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		validator, ok := keeper.sk.GetValidatorFromValID(ctx, deposit.Depositor)
+		if !ok {
+			panic(fmt.Sprintf("validator %d for deposit not found", deposit.Depositor))
+		}
+
+		depositor, err := sdk.AccAddressFromHex(validator.Signer)
+		if err != nil {
+			panic(err)
+		}
+
+		err = keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, deposit.Amount)
+		if err != nil {
+			panic(err)
+		}
+
+		store.Delete(types.DepositKey(proposalID, deposit.Depositor))
+		return false
+	})
+}
 
 // IterateAllDeposits iterates over the all the stored deposits and performs a callback function
 func (keeper Keeper) IterateAllDeposits(ctx sdk.Context, cb func(deposit types.Deposit) (stop bool)) {
@@ -5,6 +5,8 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	// this line is used by starport scaffolding # 1
 )
 
@@ -22,6 +24,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		"heimdall.gov.v1beta1.Content",
 		(*Content)(nil),
 		&TextProposal{},
+		&paramproposal.ParameterChangeProposal{},
+		&upgradetypes.SoftwareUpgradeProposal{},
+		&upgradetypes.CancelSoftwareUpgradeProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -22,6 +22,10 @@ type StakingKeeper interface {
 		ctx sdk.Context,
 		address []byte,
 	) (validator hmTypes.Validator, err error)
+	GetValidatorFromValID(
+		ctx sdk.Context,
+		valID hmTypes.ValidatorID,
+	) (validator hmTypes.Validator, ok bool)
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
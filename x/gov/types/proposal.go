@@ -11,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 // DefaultStartingProposalID is 1
@@ -207,7 +209,10 @@ func (tp *TextProposal) ValidateBasic() error { return ValidateAbstract(tp) }
 // }
 
 var validProposalTypes = map[string]struct{}{
-	ProposalTypeText: {},
+	ProposalTypeText:                               {},
+	paramproposal.ProposalTypeChange:               {},
+	upgradetypes.ProposalTypeSoftwareUpgrade:       {},
+	upgradetypes.ProposalTypeCancelSoftwareUpgrade: {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	paramscutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	govcli "github.com/maticnetwork/heimdall/x/gov/client/cli"
+	govtypes "github.com/maticnetwork/heimdall/x/gov/types"
+)
+
+// NewSubmitParamChangeProposalTxCmd returns a CLI command handler for creating
+// a parameter change proposal governance transaction.
+func NewSubmitParamChangeProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "param-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a parameter change proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a parameter proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Values are amino JSON
+encoded and are validated against the target module's parameter validators
+when the proposal is submitted and again when it is executed.
+
+Example:
+$ %s tx gov submit-proposal param-change <path/to/proposal.json> --validator-id=1 --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Checkpoint Param Change",
+  "description": "Update max checkpoint length",
+  "changes": [
+    {
+      "subspace": "checkpoint",
+      "key": "MaxCheckpointLength",
+      "value": "2048"
+    }
+  ],
+  "deposit": "1000matic"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposal, err := paramscutils.ParseParamChangeProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := paramproposal.NewParameterChangeProposal(
+				proposal.Title, proposal.Description, proposal.Changes.ToParamChanges(),
+			)
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			validatorID, err := cmd.Flags().GetInt64(govcli.FlagValidatorID)
+			if err != nil {
+				return err
+			}
+			if validatorID == 0 {
+				return fmt.Errorf("Valid validator ID required")
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from, hmTypes.ValidatorID(validatorID))
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(govcli.FlagValidatorID, 0, "--validator-id=<validator ID here>")
+
+	return cmd
+}
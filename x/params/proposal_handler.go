@@ -0,0 +1,59 @@
+package params
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	govtypes "github.com/maticnetwork/heimdall/x/gov/types"
+)
+
+// NewParamChangeProposalHandler creates a new governance Handler for a ParamChangeProposal
+func NewParamChangeProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *proposal.ParameterChangeProposal:
+			return handleParameterChangeProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized param proposal content type: %T", c)
+		}
+	}
+}
+
+func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal.ParameterChangeProposal) error {
+	for _, c := range p.Changes {
+		ss, ok := k.GetSubspace(c.Subspace)
+		if !ok {
+			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
+		}
+
+		k.Logger(ctx).Info(
+			fmt.Sprintf("attempt to set new parameter value; key: %s, value: %s", c.Key, c.Value),
+		)
+
+		if err := updateParam(ctx, ss, c); err != nil {
+			return sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// updateParam applies a single change to the subspace. Subspace.Update panics
+// for keys missing from the subspace's key table; as proposals are executed in
+// the end-blocker, the panic is turned into an error so that the proposal fails
+// instead of halting the chain.
+func updateParam(ctx sdk.Context, ss types.Subspace, c proposal.ParamChange) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	return ss.Update(ctx, []byte(c.Key), []byte(c.Value))
+}
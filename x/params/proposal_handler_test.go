@@ -0,0 +1,122 @@
+package params_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	bortypes "github.com/maticnetwork/heimdall/x/bor/types"
+	checkpointtypes "github.com/maticnetwork/heimdall/x/checkpoint/types"
+	govtypes "github.com/maticnetwork/heimdall/x/gov/types"
+	"github.com/maticnetwork/heimdall/x/params"
+)
+
+// HandlerTestSuite integrate test suite context object
+type HandlerTestSuite struct {
+	suite.Suite
+
+	app        *app.HeimdallApp
+	ctx        sdk.Context
+	govHandler govtypes.Handler
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.govHandler = params.NewParamChangeProposalHandler(suite.app.ParamsKeeper)
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func testProposal(changes ...proposal.ParamChange) *proposal.ParameterChangeProposal {
+	return proposal.NewParameterChangeProposal("title", "description", changes)
+}
+
+func (suite *HandlerTestSuite) TestProposalHandler() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	testCases := []struct {
+		name     string
+		proposal *proposal.ParameterChangeProposal
+		onHandle func()
+		expErr   bool
+	}{
+		{
+			"checkpoint max length",
+			testProposal(proposal.NewParamChange(checkpointtypes.ModuleName, string(checkpointtypes.KeyMaxCheckpointLength), `"2048"`)),
+			func() {
+				require.Equal(t, uint64(2048), app.CheckpointKeeper.GetParams(ctx).MaxCheckpointLength)
+			},
+			false,
+		},
+		{
+			"bor span duration and producer count",
+			testProposal(
+				proposal.NewParamChange(bortypes.ModuleName, string(bortypes.KeySpanDuration), `"128"`),
+				proposal.NewParamChange(bortypes.ModuleName, string(bortypes.KeyProducerCount), `"7"`),
+			),
+			func() {
+				params := app.BorKeeper.GetParams(ctx)
+				require.Equal(t, uint64(128), params.SpanDuration)
+				require.Equal(t, uint64(7), params.ProducerCount)
+			},
+			false,
+		},
+		{
+			"invalid value",
+			testProposal(proposal.NewParamChange(checkpointtypes.ModuleName, string(checkpointtypes.KeyMaxCheckpointLength), `"-1"`)),
+			func() {},
+			true,
+		},
+		{
+			"unknown key",
+			testProposal(proposal.NewParamChange(checkpointtypes.ModuleName, "UnknownKey", `"1"`)),
+			func() {},
+			true,
+		},
+		{
+			"unknown subspace",
+			testProposal(proposal.NewParamChange("unknown", "UnknownKey", `"1"`)),
+			func() {},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := suite.govHandler(ctx, tc.proposal)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				tc.onHandle()
+			}
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestSubmitProposal() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	valid := testProposal(proposal.NewParamChange(checkpointtypes.ModuleName, string(checkpointtypes.KeyMaxCheckpointLength), `"2048"`))
+	require.NoError(t, valid.ValidateBasic())
+	require.True(t, govtypes.IsValidProposalType(valid.ProposalType()))
+
+	_, err := app.GovKeeper.SubmitProposal(ctx, valid)
+	require.NoError(t, err)
+
+	// changes are only evaluated on submission, not applied
+	require.NotEqual(t, uint64(2048), app.CheckpointKeeper.GetParams(ctx).MaxCheckpointLength)
+
+	invalid := testProposal(proposal.NewParamChange(checkpointtypes.ModuleName, "UnknownKey", `"1"`))
+	_, err = app.GovKeeper.SubmitProposal(ctx, invalid)
+	require.ErrorIs(t, err, govtypes.ErrInvalidProposalContent)
+}
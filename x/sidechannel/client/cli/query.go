@@ -1,21 +1,23 @@
 package cli
 
 import (
+	"context"
 	"fmt"
-	// "strings"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
-	// "github.com/cosmos/cosmos-sdk/client/flags"
-	// sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/maticnetwork/heimdall/x/sidechannel/types"
 )
 
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
-	// Group topup queries under a subcommand
+	// Group sidechannel queries under a subcommand
 	cmd := &cobra.Command{
 		Use:                        types.ModuleName,
 		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
@@ -24,7 +26,130 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	// this line is used by starport scaffolding # 1
+	cmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryVotes(),
+		GetCmdQueryVotesByHeight(),
+	)
+
+	return cmd
+}
+
+// GetCmdQueryParams implements the params query command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "show the current sidechannel parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as sidechannel parameters.
+
+Example:
+$ %s query sidechannel params
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVotes implements the side-tx votes query command.
+func GetCmdQueryVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "votes [tx-hash]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show the vote breakdown of a side-tx",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total power, power per result and the validator votes of a side-tx.
+
+Example:
+$ %s query sidechannel votes 9D3C0E41AE8C2E1AB2D9B8C2A8E67CF1C2FB14ADE9C1DCB5A2FB21A8C8AF33B0
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Votes(context.Background(), &types.QueryVotesRequest{TxHash: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Votes)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVotesByHeight implements the side-tx votes by height query command.
+func GetCmdQueryVotesByHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "votes-by-height [height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show the vote breakdown of the side-txs included at a height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the vote breakdown of all side-txs included at a height.
+
+Example:
+$ %s query sidechannel votes-by-height 1200
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VotesByHeight(context.Background(), &types.QueryVotesByHeightRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -13,6 +13,8 @@ import (
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) []abci.ValidatorUpdate {
+	k.SetParams(ctx, data.Params)
+
 	for _, pastCommit := range data.PastCommits {
 		// set all txs
 		if len(pastCommit.Txs) > 0 {
@@ -51,5 +53,5 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		return result[i].Height < result[j].Height
 	})
 
-	return types.NewGenesisState(result)
+	return types.NewGenesisState(k.GetParams(ctx), result)
 }
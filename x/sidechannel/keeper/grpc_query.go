@@ -1,7 +1,75 @@
 package keeper
 
-// import (
-// 	"github.com/maticnetwork/heimdall/x/sidechannel/types"
-// )
+import (
+	"context"
+	"encoding/hex"
+	"strings"
 
-// var _ types.QueryServer = Keeper{}
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/x/sidechannel/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+// NewQueryServerImpl returns an implementation of the sidechannel QueryServer interface
+// for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return &Querier{Keeper: keeper}
+}
+
+var _ types.QueryServer = Querier{}
+
+// Params queries sidechannel params
+func (k Querier) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Votes queries the vote breakdown of a side-tx
+func (k Querier) Votes(c context.Context, req *types.QueryVotesRequest) (*types.QueryVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(req.TxHash, "0x"), "0X"))
+	if err != nil || len(hash) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash %q", req.TxHash)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	votes, found := k.GetTxVotes(ctx, hash)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "votes not found for side-tx %s", req.TxHash)
+	}
+
+	return &types.QueryVotesResponse{Votes: *votes}, nil
+}
+
+// VotesByHeight queries the vote breakdown of the side-txs included at the height
+func (k Querier) VotesByHeight(c context.Context, req *types.QueryVotesByHeightRequest) (*types.QueryVotesByHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	result := make([]types.SideTxVotes, 0)
+	for _, votes := range k.GetTxVotesByHeight(ctx, req.Height) {
+		result = append(result, *votes)
+	}
+
+	return &types.QueryVotesByHeightResponse{Votes: result}, nil
+}
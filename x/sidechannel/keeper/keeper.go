@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -16,15 +17,22 @@ import (
 
 type (
 	Keeper struct {
-		cdc      codec.Marshaler
-		storeKey sdk.StoreKey
+		cdc           codec.Marshaler
+		storeKey      sdk.StoreKey
+		paramSubspace paramtypes.Subspace
 	}
 )
 
-func NewKeeper(cdc codec.Marshaler, storeKey sdk.StoreKey) Keeper {
+func NewKeeper(cdc codec.Marshaler, storeKey sdk.StoreKey, paramstore paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSubspace: paramstore,
 	}
 }
 
@@ -32,6 +40,21 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+//
+// Params methods
+//
+
+// SetParams sets the sidechannel module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the sidechannel module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSubspace.GetParamSet(ctx, &params)
+	return
+}
+
 //
 // Txs methods
 //
@@ -120,6 +143,130 @@ func (k Keeper) RemoveValidators(ctx sdk.Context, height uint64) {
 	store.Delete(ValidatorsKey(height))
 }
 
+//
+// Votes methods
+//
+
+// SetTxVotes sets the vote breakdown of side-tx
+func (k Keeper) SetTxVotes(ctx sdk.Context, hash []byte, votes *types.SideTxVotes) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.MarshalBinaryBare(votes)
+	if err != nil {
+		return err
+	}
+
+	// same tx included again replaces its previous votes
+	if previous := store.Get(TxVotesHeightKey(hash)); previous != nil {
+		store.Delete(TxVotesKey(binary.BigEndian.Uint64(previous), hash))
+	}
+
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, votes.Height)
+
+	store.Set(TxVotesKey(votes.Height, hash), bz)
+	store.Set(TxVotesHeightKey(hash), heightBytes)
+	return nil
+}
+
+// GetTxVotes returns the vote breakdown of side-tx
+func (k Keeper) GetTxVotes(ctx sdk.Context, hash []byte) (*types.SideTxVotes, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	heightBytes := store.Get(TxVotesHeightKey(hash))
+	if heightBytes == nil {
+		return nil, false
+	}
+
+	bz := store.Get(TxVotesKey(binary.BigEndian.Uint64(heightBytes), hash))
+	if bz == nil {
+		return nil, false
+	}
+
+	var votes types.SideTxVotes
+	if err := k.cdc.UnmarshalBinaryBare(bz, &votes); err != nil {
+		k.Logger(ctx).Error("Error while unmarshalling side-tx votes", "error", err)
+		return nil, false
+	}
+
+	return &votes, true
+}
+
+// GetTxVotesByHeight returns the vote breakdown of side-txs included at height
+func (k Keeper) GetTxVotesByHeight(ctx sdk.Context, height uint64) (result []*types.SideTxVotes) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, TxVotesByHeightKey(height))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var votes types.SideTxVotes
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &votes); err != nil {
+			k.Logger(ctx).Error("Error while unmarshalling side-tx votes", "error", err)
+			continue
+		}
+		result = append(result, &votes)
+	}
+
+	return result
+}
+
+// PruneTxVotes removes the vote breakdown of side-txs included before height
+func (k Keeper) PruneTxVotes(ctx sdk.Context, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	// collect keys first, store can't be modified while iterating
+	iterator := store.Iterator(TxVotesKeyPrefix, TxVotesByHeightKey(height))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	prefixLength := len(TxVotesKeyPrefix)
+	for _, key := range keys {
+		store.Delete(key)
+		store.Delete(TxVotesHeightKey(key[prefixLength+8:]))
+	}
+}
+
+// SetPendingTxVotes sets the votes accumulated on side-tx in its voting window
+func (k Keeper) SetPendingTxVotes(ctx sdk.Context, hash []byte, votes *types.SideTxVotes) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.MarshalBinaryBare(votes)
+	if err != nil {
+		return err
+	}
+
+	store.Set(PendingTxVotesKey(hash), bz)
+	return nil
+}
+
+// GetPendingTxVotes returns the votes accumulated on side-tx in its voting window
+func (k Keeper) GetPendingTxVotes(ctx sdk.Context, hash []byte) (*types.SideTxVotes, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(PendingTxVotesKey(hash))
+	if bz == nil {
+		return nil, false
+	}
+
+	var votes types.SideTxVotes
+	if err := k.cdc.UnmarshalBinaryBare(bz, &votes); err != nil {
+		k.Logger(ctx).Error("Error while unmarshalling pending side-tx votes", "error", err)
+		return nil, false
+	}
+
+	return &votes, true
+}
+
+// RemovePendingTxVotes removes the votes accumulated on side-tx
+func (k Keeper) RemovePendingTxVotes(ctx sdk.Context, hash []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(PendingTxVotesKey(hash))
+}
+
 //
 // Iterators
 //
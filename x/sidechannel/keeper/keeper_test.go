@@ -6,8 +6,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -222,6 +224,70 @@ func (suite *KeeperTestSuite) TestValidators() {
 	})
 }
 
+func (suite *KeeperTestSuite) TestTxVotes() {
+	t, k, ctx := suite.T(), suite.keeper, suite.ctx
+
+	tx1 := tmtypes.Tx([]byte("transaction-1"))
+	tx2 := tmtypes.Tx([]byte("transaction-2"))
+	tx3 := tmtypes.Tx([]byte("transaction-3"))
+
+	newVotes := func(height uint64, tx tmtypes.Tx) *types.SideTxVotes {
+		return &types.SideTxVotes{
+			TxHash:     fmt.Sprintf("%X", tx.Hash()),
+			Height:     height,
+			TotalPower: 30,
+			YesPower:   20,
+			NoPower:    10,
+			Result:     tmproto.SideTxResultType_SKIP,
+			Votes: []types.SideTxVote{
+				{Address: "01", Power: 20, Result: tmproto.SideTxResultType_YES},
+				{Address: "02", Power: 10, Result: tmproto.SideTxResultType_NO},
+			},
+		}
+	}
+
+	t.Run("SetTxVotes", func(t *testing.T) {
+		require.NoError(t, k.SetTxVotes(ctx, tx1.Hash(), newVotes(10, tx1)))
+		require.NoError(t, k.SetTxVotes(ctx, tx2.Hash(), newVotes(10, tx2)))
+		require.NoError(t, k.SetTxVotes(ctx, tx3.Hash(), newVotes(24, tx3)))
+
+		votes, found := k.GetTxVotes(ctx, tx1.Hash())
+		require.True(t, found)
+		require.Equal(t, newVotes(10, tx1), votes)
+
+		_, found = k.GetTxVotes(ctx, []byte("unknown"))
+		require.False(t, found)
+	})
+
+	t.Run("GetTxVotesByHeight", func(t *testing.T) {
+		require.Len(t, k.GetTxVotesByHeight(ctx, 10), 2)
+		require.Len(t, k.GetTxVotesByHeight(ctx, 24), 1)
+		require.Empty(t, k.GetTxVotesByHeight(ctx, 17))
+	})
+
+	t.Run("ReplaceTxVotes", func(t *testing.T) {
+		require.NoError(t, k.SetTxVotes(ctx, tx2.Hash(), newVotes(20, tx2)))
+
+		votes, found := k.GetTxVotes(ctx, tx2.Hash())
+		require.True(t, found)
+		require.Equal(t, uint64(20), votes.Height)
+		require.Len(t, k.GetTxVotesByHeight(ctx, 10), 1)
+	})
+
+	t.Run("PruneTxVotes", func(t *testing.T) {
+		k.PruneTxVotes(ctx, 20)
+
+		_, found := k.GetTxVotes(ctx, tx1.Hash())
+		require.False(t, found)
+		require.Empty(t, k.GetTxVotesByHeight(ctx, 10))
+
+		_, found = k.GetTxVotes(ctx, tx2.Hash())
+		require.True(t, found)
+		_, found = k.GetTxVotes(ctx, tx3.Hash())
+		require.True(t, found)
+	})
+}
+
 func (suite *KeeperTestSuite) TestLogger() {
 	t, k, ctx := suite.T(), suite.keeper, suite.ctx
 
@@ -236,11 +302,19 @@ func (suite *KeeperTestSuite) TestLogger() {
 func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
 	t.Helper()
 	key := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
 	err := ms.LoadLatestVersion()
 	require.NoError(t, err)
 	ctx := sdk.NewContext(ms, tmproto.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
-	return ctx, keeper.NewKeeper(types.ModuleCdc, key)
+
+	subspace := paramstypes.NewSubspace(types.ModuleCdc, codec.NewLegacyAmino(), paramsKey, paramsTKey, types.ModuleName)
+	k := keeper.NewKeeper(types.ModuleCdc, key, subspace)
+	k.SetParams(ctx, types.DefaultParams())
+	return ctx, k
 }
@@ -10,6 +10,15 @@ var (
 
 	// ValidatorsKeyPrefix prefix for validators
 	ValidatorsKeyPrefix = []byte{0x02}
+
+	// TxVotesKeyPrefix prefix for side-tx votes
+	TxVotesKeyPrefix = []byte{0x03}
+
+	// TxVotesHeightKeyPrefix prefix for side-tx votes heights
+	TxVotesHeightKeyPrefix = []byte{0x04}
+
+	// PendingTxVotesKeyPrefix prefix for votes of side-txs in voting window
+	PendingTxVotesKeyPrefix = []byte{0x05}
 )
 
 // TxStoreKey returns key used to get tx from store
@@ -40,3 +49,37 @@ func ValidatorsKey(height uint64) []byte {
 	result = append(result, b...)
 	return result
 }
+
+// TxVotesKey returns key used to get side-tx votes from store
+func TxVotesKey(height uint64, hash []byte) []byte {
+	result := TxVotesByHeightKey(height)
+	result = append(result, hash...)
+	return result
+}
+
+// TxVotesByHeightKey returns key used to get side-tx votes per height from store
+func TxVotesByHeightKey(height uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, height)
+
+	result := []byte{}
+	result = append(result, TxVotesKeyPrefix...)
+	result = append(result, b...)
+	return result
+}
+
+// TxVotesHeightKey returns key used to get height of side-tx votes from store
+func TxVotesHeightKey(hash []byte) []byte {
+	result := []byte{}
+	result = append(result, TxVotesHeightKeyPrefix...)
+	result = append(result, hash...)
+	return result
+}
+
+// PendingTxVotesKey returns key used to get votes of side-tx in voting window from store
+func PendingTxVotesKey(hash []byte) []byte {
+	result := []byte{}
+	result = append(result, PendingTxVotesKeyPrefix...)
+	result = append(result, hash...)
+	return result
+}
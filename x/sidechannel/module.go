@@ -1,6 +1,7 @@
 package sidechannel
 
 import (
+	"context"
 	"encoding/json"
 	"math/rand"
 
@@ -59,7 +60,7 @@ func (a AppModuleBasic) RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
 // RegisterServices registers a GRPC query service to respond to the
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	//types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
 }
 
 // DefaultGenesis returns the capability module's default genesis state.
@@ -81,8 +82,9 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONMarshaler, config client.TxE
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the auth module.
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
-	// TODO : uncomment below
-	// types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx))
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // RegisterRESTRoutes registers the capability module's REST service handlers.
@@ -180,10 +182,16 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
 // returns no validator updates.
-// Side channel module's end block will remove all validators for `height` block
+// Side channel module's end block will remove all validators for `height` block and prune old side-tx votes
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	height := ctx.BlockHeader().Height
 	am.keeper.RemoveValidators(ctx, uint64(height))
+
+	// prune side-tx votes past retention
+	if retention := am.keeper.GetParams(ctx).TxVotesRetention; uint64(height) > retention {
+		am.keeper.PruneTxVotes(ctx, uint64(height)-retention)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -7,6 +7,10 @@ import (
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	for _, pastCommit := range gs.PastCommits {
 		if pastCommit.Height <= 2 {
 			return fmt.Errorf("past commit height must be greater 2")
@@ -20,16 +24,14 @@ func (gs GenesisState) Validate() error {
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(pastCommits []*PastCommit) *GenesisState {
+func NewGenesisState(params Params, pastCommits []*PastCommit) *GenesisState {
 	return &GenesisState{
 		PastCommits: pastCommits,
-		Params: Params{
-			Enabled: true,
-		},
+		Params:      params,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(make([]*PastCommit, 0))
+	return NewGenesisState(DefaultParams(), make([]*PastCommit, 0))
 }
@@ -0,0 +1,186 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter values
+const (
+	DefaultEnabled          bool   = true
+	DefaultVotingWindow     uint64 = 1
+	DefaultTxVotesRetention uint64 = 50000
+)
+
+var (
+	// DefaultThreshold is 2/3 rounded up, requiring more than 2/3 of the total power
+	DefaultThreshold = sdk.MustNewDecFromStr("0.666666666666666667")
+
+	// MinThreshold prevents both yes and no votes from reaching the threshold
+	MinThreshold = sdk.NewDecWithPrec(5, 1)
+)
+
+// Parameter keys
+var (
+	KeyEnabled      = []byte("Enabled")
+	KeyThreshold    = []byte("Threshold")
+	KeyVotingWindow = []byte("VotingWindow")
+	KeyRouteParams  = []byte("RouteParams")
+
+	KeyTxVotesRetention = []byte("TxVotesRetention")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// NewParams creates a new Params object
+func NewParams(enabled bool, threshold sdk.Dec, votingWindow uint64, routeParams []RouteParams, txVotesRetention uint64) Params {
+	return Params{
+		Enabled:          enabled,
+		Threshold:        threshold,
+		VotingWindow:     votingWindow,
+		RouteParams:      routeParams,
+		TxVotesRetention: txVotesRetention,
+	}
+}
+
+// ParamKeyTable for sidechannel module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of sidechannel module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnabled, &p.Enabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyThreshold, &p.Threshold, validateThreshold),
+		paramtypes.NewParamSetPair(KeyVotingWindow, &p.VotingWindow, validateVotingWindow),
+		paramtypes.NewParamSetPair(KeyRouteParams, &p.RouteParams, validateRouteParams),
+		paramtypes.NewParamSetPair(KeyTxVotesRetention, &p.TxVotesRetention, validateTxVotesRetention),
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultEnabled, DefaultThreshold, DefaultVotingWindow, nil, DefaultTxVotesRetention)
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateThreshold(p.Threshold); err != nil {
+		return err
+	}
+
+	if err := validateVotingWindow(p.VotingWindow); err != nil {
+		return err
+	}
+
+	if err := validateRouteParams(p.RouteParams); err != nil {
+		return err
+	}
+
+	return validateTxVotesRetention(p.TxVotesRetention)
+}
+
+// ParamsForRoute returns the threshold and voting window of side-txs with msgs of the route
+func (p Params) ParamsForRoute(route string) RouteParams {
+	for _, routeParams := range p.RouteParams {
+		if routeParams.Route == route {
+			return routeParams
+		}
+	}
+
+	return RouteParams{
+		Route:        route,
+		Threshold:    p.Threshold,
+		VotingWindow: p.VotingWindow,
+	}
+}
+
+// HasMajority checks if the power is more than the threshold fraction of the total power
+func (p RouteParams) HasMajority(power int64, totalPower int64) bool {
+	return sdk.NewDec(power).GT(p.Threshold.MulInt64(totalPower))
+}
+
+func validateEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(MinThreshold) {
+		return fmt.Errorf("threshold must be at least %s: %s", MinThreshold, v)
+	}
+
+	if v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("threshold must be less than 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateVotingWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("voting window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateTxVotesRetention(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("tx votes retention must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateRouteParams(i interface{}) error {
+	v, ok := i.([]RouteParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	routes := make(map[string]bool)
+	for _, routeParams := range v {
+		if routeParams.Route == "" {
+			return fmt.Errorf("route params without route")
+		}
+
+		if routes[routeParams.Route] {
+			return fmt.Errorf("duplicate route params for route %s", routeParams.Route)
+		}
+		routes[routeParams.Route] = true
+
+		if err := validateThreshold(routeParams.Threshold); err != nil {
+			return fmt.Errorf("invalid route params for route %s: %w", routeParams.Route, err)
+		}
+
+		if err := validateVotingWindow(routeParams.VotingWindow); err != nil {
+			return fmt.Errorf("invalid route params for route %s: %w", routeParams.Route, err)
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -27,6 +28,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// enable/disable sidechannel
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// side-tx is approved (or rejected) with more than the threshold
+	// fraction of the total power voting yes (or no)
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold"`
+	// number of blocks votes on a side-tx are accumulated for before it's
+	// skipped
+	VotingWindow uint64 `protobuf:"varint,3,opt,name=voting_window,json=votingWindow,proto3" json:"voting_window,omitempty" yaml:"voting_window"`
+	// threshold and voting window overrides per msg route
+	RouteParams []RouteParams `protobuf:"bytes,4,rep,name=route_params,json=routeParams,proto3" json:"route_params" yaml:"route_params"`
+	// number of blocks the votes of finalized side-txs are kept for
+	TxVotesRetention uint64 `protobuf:"varint,5,opt,name=tx_votes_retention,json=txVotesRetention,proto3" json:"tx_votes_retention,omitempty" yaml:"tx_votes_retention"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,8 +80,85 @@ func (m *Params) GetEnabled() bool {
 	return false
 }
 
+func (m *Params) GetVotingWindow() uint64 {
+	if m != nil {
+		return m.VotingWindow
+	}
+	return 0
+}
+
+func (m *Params) GetRouteParams() []RouteParams {
+	if m != nil {
+		return m.RouteParams
+	}
+	return nil
+}
+
+func (m *Params) GetTxVotesRetention() uint64 {
+	if m != nil {
+		return m.TxVotesRetention
+	}
+	return 0
+}
+
+// RouteParams defines the threshold and voting window of side-txs with msgs
+// of the route
+type RouteParams struct {
+	Route        string                                 `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	Threshold    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold"`
+	VotingWindow uint64                                 `protobuf:"varint,3,opt,name=voting_window,json=votingWindow,proto3" json:"voting_window,omitempty" yaml:"voting_window"`
+}
+
+func (m *RouteParams) Reset()         { *m = RouteParams{} }
+func (m *RouteParams) String() string { return proto.CompactTextString(m) }
+func (*RouteParams) ProtoMessage()    {}
+func (*RouteParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ceedcf0c36c1a655, []int{1}
+}
+func (m *RouteParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteParams.Merge(m, src)
+}
+func (m *RouteParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteParams proto.InternalMessageInfo
+
+func (m *RouteParams) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *RouteParams) GetVotingWindow() uint64 {
+	if m != nil {
+		return m.VotingWindow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "heimdall.sidechannel.v1beta1.Params")
+	proto.RegisterType((*RouteParams)(nil), "heimdall.sidechannel.v1beta1.RouteParams")
 }
 
 func init() {
@@ -78,20 +166,33 @@ func init() {
 }
 
 var fileDescriptor_ceedcf0c36c1a655 = []byte{
-	// 197 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcc, 0x48, 0xcd, 0xcc,
-	0x4d, 0x49, 0xcc, 0xc9, 0xd1, 0x2f, 0xce, 0x4c, 0x49, 0x4d, 0xce, 0x48, 0xcc, 0xcb, 0x4b, 0xcd,
-	0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0x29, 0xd5, 0x43, 0x52, 0xaa, 0x07, 0x55, 0x2a,
-	0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x28, 0xe9, 0x70, 0xb1,
-	0x05, 0x80, 0xcd, 0x10, 0x92, 0xe0, 0x62, 0x4f, 0xcd, 0x4b, 0x4c, 0xca, 0x49, 0x4d, 0x91, 0x60,
-	0x54, 0x60, 0xd4, 0xe0, 0x08, 0x82, 0x71, 0xad, 0x38, 0x66, 0x2c, 0x90, 0x67, 0x7c, 0xb1, 0x40,
-	0x9e, 0xd1, 0xc9, 0xef, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
-	0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd2,
-	0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x73, 0x13, 0x4b, 0x32, 0x93, 0xf3,
-	0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xf5, 0xe1, 0xce, 0xaf, 0x40, 0xf1, 0x40, 0x49, 0x65, 0x41,
-	0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x11, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x62, 0xaf, 0x07,
-	0x10, 0xe5, 0x00, 0x00, 0x00,
+	// 410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x52, 0xbb, 0x8e, 0xd3, 0x40,
+	0x14, 0xf5, 0xec, 0x8b, 0xdd, 0x49, 0x90, 0xd0, 0x90, 0xc2, 0xbc, 0x6c, 0xcb, 0x05, 0xf2, 0x16,
+	0x8c, 0xb5, 0x40, 0xb5, 0x12, 0x8d, 0x45, 0x07, 0x42, 0x68, 0x0a, 0x90, 0x68, 0xac, 0xb1, 0x3d,
+	0xb2, 0x47, 0x6b, 0xcf, 0x44, 0x9e, 0xd9, 0x24, 0xfb, 0x17, 0x94, 0x94, 0xfb, 0x1d, 0x7c, 0x41,
+	0xca, 0x94, 0x88, 0xc2, 0x42, 0x49, 0x43, 0x4b, 0xbe, 0x00, 0x79, 0x9c, 0x10, 0x47, 0x48, 0xd4,
+	0x54, 0xf6, 0xb9, 0x73, 0xee, 0xb9, 0xe7, 0x5e, 0x1d, 0x78, 0x5e, 0x30, 0x5e, 0x65, 0xb4, 0x2c,
+	0x43, 0xc5, 0x33, 0x96, 0x16, 0x54, 0x08, 0x56, 0x86, 0x93, 0x8b, 0x84, 0x69, 0x7a, 0x11, 0x8e,
+	0x69, 0x4d, 0x2b, 0x85, 0xc7, 0xb5, 0xd4, 0x12, 0x3d, 0xde, 0x52, 0x71, 0x8f, 0x8a, 0x37, 0xd4,
+	0x87, 0xa3, 0x5c, 0xe6, 0xd2, 0x10, 0xc3, 0xf6, 0xaf, 0xeb, 0xf1, 0x7f, 0x1d, 0xc0, 0x93, 0xf7,
+	0x46, 0x04, 0xd9, 0xf0, 0x0e, 0x13, 0x34, 0x29, 0x59, 0x66, 0x03, 0x0f, 0x04, 0xa7, 0x64, 0x0b,
+	0xd1, 0x5b, 0x78, 0xa6, 0x8b, 0x9a, 0xa9, 0x42, 0x96, 0x99, 0x7d, 0xe0, 0x81, 0x60, 0x18, 0xe1,
+	0x79, 0xe3, 0x5a, 0xdf, 0x1b, 0xf7, 0x69, 0xce, 0x75, 0x71, 0x9d, 0xe0, 0x54, 0x56, 0x61, 0x2a,
+	0x55, 0x25, 0xd5, 0xe6, 0xf3, 0x4c, 0x65, 0x57, 0xa1, 0xbe, 0x19, 0x33, 0x85, 0x5f, 0xb3, 0x94,
+	0xec, 0x04, 0xd0, 0x2b, 0x78, 0x77, 0x22, 0x35, 0x17, 0x79, 0x3c, 0xe5, 0x22, 0x93, 0x53, 0xfb,
+	0xd0, 0x03, 0xc1, 0x51, 0x64, 0xaf, 0x1b, 0x77, 0x74, 0x43, 0xab, 0xf2, 0xd2, 0xdf, 0x7b, 0xf6,
+	0xc9, 0xb0, 0xc3, 0x1f, 0x0d, 0x44, 0x1c, 0x0e, 0x6b, 0x79, 0xad, 0x59, 0xdc, 0xed, 0x6e, 0x1f,
+	0x79, 0x87, 0xc1, 0xe0, 0xf9, 0x39, 0xfe, 0xd7, 0xf2, 0x98, 0xb4, 0x1d, 0xdd, 0x9e, 0xd1, 0xa3,
+	0xd6, 0xfa, 0xba, 0x71, 0xef, 0x77, 0xc3, 0xfa, 0x62, 0x3e, 0x19, 0xd4, 0x3b, 0x26, 0x7a, 0x03,
+	0x91, 0x9e, 0xc5, 0x13, 0xa9, 0x99, 0x8a, 0x6b, 0xa6, 0x99, 0xd0, 0x5c, 0x0a, 0xfb, 0xd8, 0xd8,
+	0x7d, 0xb2, 0x6e, 0xdc, 0x07, 0x9d, 0xc2, 0xdf, 0x1c, 0x9f, 0xdc, 0xd3, 0xb3, 0x0f, 0x6d, 0x8d,
+	0x6c, 0x4b, 0x97, 0xa7, 0x5f, 0x6e, 0x5d, 0xf0, 0xf3, 0xd6, 0x05, 0xfe, 0x57, 0x00, 0x07, 0x3d,
+	0x43, 0x68, 0x04, 0x8f, 0xcd, 0x54, 0x73, 0xf6, 0x33, 0xd2, 0x81, 0xff, 0xea, 0xe8, 0x3b, 0xf3,
+	0xd1, 0xbb, 0xf9, 0xd2, 0x01, 0x8b, 0xa5, 0x03, 0x7e, 0x2c, 0x1d, 0xf0, 0x79, 0xe5, 0x58, 0x8b,
+	0x95, 0x63, 0x7d, 0x5b, 0x39, 0xd6, 0xa7, 0x97, 0x3d, 0x57, 0x15, 0xd5, 0x3c, 0x15, 0x4c, 0x4f,
+	0x65, 0x7d, 0x15, 0xfe, 0x49, 0xf0, 0x6c, 0x2f, 0xc3, 0xc6, 0x67, 0x72, 0x62, 0x72, 0xf8, 0xe2,
+	0xf7, 0x00, 0x86, 0x41, 0xdf, 0x8a, 0xe8, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -116,6 +217,53 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.Enabled != that1.Enabled {
 		return false
 	}
+	if !this.Threshold.Equal(that1.Threshold) {
+		return false
+	}
+	if this.VotingWindow != that1.VotingWindow {
+		return false
+	}
+	if len(this.RouteParams) != len(that1.RouteParams) {
+		return false
+	}
+	for i := range this.RouteParams {
+		if !this.RouteParams[i].Equal(&that1.RouteParams[i]) {
+			return false
+		}
+	}
+	if this.TxVotesRetention != that1.TxVotesRetention {
+		return false
+	}
+	return true
+}
+func (this *RouteParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RouteParams)
+	if !ok {
+		that2, ok := that.(RouteParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Route != that1.Route {
+		return false
+	}
+	if !this.Threshold.Equal(that1.Threshold) {
+		return false
+	}
+	if this.VotingWindow != that1.VotingWindow {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -138,6 +286,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TxVotesRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TxVotesRetention))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RouteParams) > 0 {
+		for iNdEx := len(m.RouteParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RouteParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.VotingWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VotingWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.Enabled {
 		i--
 		if m.Enabled {
@@ -151,6 +333,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RouteParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VotingWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -171,6 +398,38 @@ func (m *Params) Size() (n int) {
 	if m.Enabled {
 		n += 2
 	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.VotingWindow != 0 {
+		n += 1 + sovParams(uint64(m.VotingWindow))
+	}
+	if len(m.RouteParams) > 0 {
+		for _, e := range m.RouteParams {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.TxVotesRetention != 0 {
+		n += 1 + sovParams(uint64(m.TxVotesRetention))
+	}
+	return n
+}
+
+func (m *RouteParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.VotingWindow != 0 {
+		n += 1 + sovParams(uint64(m.VotingWindow))
+	}
 	return n
 }
 
@@ -229,6 +488,248 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingWindow", wireType)
+			}
+			m.VotingWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RouteParams = append(m.RouteParams, RouteParams{})
+			if err := m.RouteParams[len(m.RouteParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxVotesRetention", wireType)
+			}
+			m.TxVotesRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxVotesRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingWindow", wireType)
+			}
+			m.VotingWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -8,6 +8,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/tendermint/tendermint/abci/types"
+	types1 "github.com/tendermint/tendermint/proto/tendermint/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -76,8 +77,173 @@ func (m *PreviousValidators) GetValidators() []*types.Validator {
 	return nil
 }
 
+// SideTxVote is the vote of a validator on a side-tx
+type SideTxVote struct {
+	Address string                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Power   int64                   `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	Result  types1.SideTxResultType `protobuf:"varint,3,opt,name=result,proto3,enum=tendermint.types.SideTxResultType" json:"result,omitempty"`
+}
+
+func (m *SideTxVote) Reset()         { *m = SideTxVote{} }
+func (m *SideTxVote) String() string { return proto.CompactTextString(m) }
+func (*SideTxVote) ProtoMessage()    {}
+func (*SideTxVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_687ea62bd722fafc, []int{1}
+}
+func (m *SideTxVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SideTxVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SideTxVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SideTxVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SideTxVote.Merge(m, src)
+}
+func (m *SideTxVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *SideTxVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_SideTxVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SideTxVote proto.InternalMessageInfo
+
+func (m *SideTxVote) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SideTxVote) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *SideTxVote) GetResult() types1.SideTxResultType {
+	if m != nil {
+		return m.Result
+	}
+	return types1.SideTxResultType_SKIP
+}
+
+// SideTxVotes is the vote breakdown of a side-tx processed in begin side
+// block
+type SideTxVotes struct {
+	TxHash     string                  `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
+	Height     uint64                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	TotalPower int64                   `protobuf:"varint,3,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty" yaml:"total_power"`
+	YesPower   int64                   `protobuf:"varint,4,opt,name=yes_power,json=yesPower,proto3" json:"yes_power,omitempty" yaml:"yes_power"`
+	NoPower    int64                   `protobuf:"varint,5,opt,name=no_power,json=noPower,proto3" json:"no_power,omitempty" yaml:"no_power"`
+	SkipPower  int64                   `protobuf:"varint,6,opt,name=skip_power,json=skipPower,proto3" json:"skip_power,omitempty" yaml:"skip_power"`
+	Result     types1.SideTxResultType `protobuf:"varint,7,opt,name=result,proto3,enum=tendermint.types.SideTxResultType" json:"result,omitempty"`
+	Votes      []SideTxVote            `protobuf:"bytes,8,rep,name=votes,proto3" json:"votes"`
+}
+
+func (m *SideTxVotes) Reset()         { *m = SideTxVotes{} }
+func (m *SideTxVotes) String() string { return proto.CompactTextString(m) }
+func (*SideTxVotes) ProtoMessage()    {}
+func (*SideTxVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_687ea62bd722fafc, []int{2}
+}
+func (m *SideTxVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SideTxVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SideTxVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SideTxVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SideTxVotes.Merge(m, src)
+}
+func (m *SideTxVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SideTxVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SideTxVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SideTxVotes proto.InternalMessageInfo
+
+func (m *SideTxVotes) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *SideTxVotes) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SideTxVotes) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+func (m *SideTxVotes) GetYesPower() int64 {
+	if m != nil {
+		return m.YesPower
+	}
+	return 0
+}
+
+func (m *SideTxVotes) GetNoPower() int64 {
+	if m != nil {
+		return m.NoPower
+	}
+	return 0
+}
+
+func (m *SideTxVotes) GetSkipPower() int64 {
+	if m != nil {
+		return m.SkipPower
+	}
+	return 0
+}
+
+func (m *SideTxVotes) GetResult() types1.SideTxResultType {
+	if m != nil {
+		return m.Result
+	}
+	return types1.SideTxResultType_SKIP
+}
+
+func (m *SideTxVotes) GetVotes() []SideTxVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PreviousValidators)(nil), "heimdall.sidechannel.v1beta1.PreviousValidators")
+	proto.RegisterType((*SideTxVote)(nil), "heimdall.sidechannel.v1beta1.SideTxVote")
+	proto.RegisterType((*SideTxVotes)(nil), "heimdall.sidechannel.v1beta1.SideTxVotes")
 }
 
 func init() {
@@ -85,23 +251,39 @@ func init() {
 }
 
 var fileDescriptor_687ea62bd722fafc = []byte{
-	// 244 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcb, 0x48, 0xcd, 0xcc,
-	0x4d, 0x49, 0xcc, 0xc9, 0xd1, 0x2f, 0xce, 0x4c, 0x49, 0x4d, 0xce, 0x48, 0xcc, 0xcb, 0x4b, 0xcd,
-	0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0x44, 0x16, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0x81, 0xa9, 0xd7, 0x43, 0x96, 0x83, 0xaa, 0x97, 0x92, 0x2e, 0x49, 0xcd, 0x4b, 0x49,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4c, 0x4a, 0xce, 0xd4, 0x2f, 0xa9, 0x2c, 0x48, 0x2d,
-	0x86, 0x68, 0x95, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52,
-	0x06, 0x97, 0x50, 0x40, 0x51, 0x6a, 0x59, 0x66, 0x7e, 0x69, 0x71, 0x58, 0x62, 0x4e, 0x66, 0x4a,
-	0x62, 0x49, 0x7e, 0x51, 0xb1, 0x90, 0x18, 0x17, 0x5b, 0x46, 0x6a, 0x66, 0x7a, 0x46, 0x89, 0x04,
-	0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0x94, 0x27, 0x64, 0xc5, 0xc5, 0x55, 0x06, 0x57, 0x25, 0xc1,
-	0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xa5, 0x87, 0xb0, 0x55, 0x0f, 0x64, 0xab, 0x1e, 0xdc, 0xa0,
-	0x20, 0x24, 0xd5, 0x4e, 0x7e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91,
-	0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65,
-	0x92, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x9b, 0x58, 0x92, 0x99,
-	0x9c, 0x97, 0x5a, 0x52, 0x9e, 0x5f, 0x94, 0xad, 0x0f, 0x0f, 0x9c, 0x0a, 0x94, 0xe0, 0x01, 0xfb,
-	0x2a, 0x89, 0x0d, 0xec, 0x01, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x75, 0xc8, 0x95, 0xd0,
-	0x43, 0x01, 0x00, 0x00,
+	// 498 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x8a, 0xdb, 0x30,
+	0x10, 0xc6, 0xe3, 0xcd, 0x7f, 0x05, 0xb6, 0xad, 0x9a, 0x2e, 0x26, 0x5d, 0xec, 0xa0, 0x53, 0xa0,
+	0x20, 0x93, 0xed, 0x42, 0x21, 0xc7, 0xd0, 0x43, 0x4f, 0x25, 0xa8, 0xcb, 0x1e, 0x7a, 0x59, 0x94,
+	0x58, 0xc4, 0x62, 0x1d, 0x2b, 0x58, 0x4a, 0x36, 0x7e, 0x8b, 0x3e, 0x45, 0x9f, 0x65, 0x8f, 0x7b,
+	0xec, 0xc9, 0x94, 0xe4, 0x0d, 0xfc, 0x04, 0xc5, 0x92, 0x13, 0x3b, 0x3d, 0x14, 0x7a, 0xf3, 0xe8,
+	0xfb, 0x7e, 0x33, 0xa3, 0xf1, 0x08, 0xe0, 0x80, 0xf1, 0x95, 0x4f, 0xc3, 0xd0, 0x93, 0xdc, 0x67,
+	0x8b, 0x80, 0x46, 0x11, 0x0b, 0xbd, 0xed, 0x78, 0xce, 0x14, 0x1d, 0x57, 0xcf, 0xf0, 0x3a, 0x16,
+	0x4a, 0xc0, 0xeb, 0xa3, 0x1f, 0x57, 0xb5, 0xc2, 0x3f, 0x78, 0xaf, 0x58, 0xe4, 0xb3, 0x78, 0xc5,
+	0x23, 0xe5, 0xd1, 0xf9, 0x82, 0x7b, 0x2a, 0x59, 0x33, 0x69, 0xd0, 0xc1, 0x75, 0x45, 0xd4, 0xe7,
+	0x67, 0x6a, 0x7f, 0x29, 0x96, 0x42, 0x7f, 0x7a, 0xf9, 0x97, 0x39, 0x45, 0x01, 0x80, 0xb3, 0x98,
+	0x6d, 0xb9, 0xd8, 0xc8, 0x7b, 0x1a, 0x72, 0x9f, 0x2a, 0x11, 0x4b, 0x78, 0x05, 0x5a, 0x01, 0xe3,
+	0xcb, 0x40, 0xd9, 0xd6, 0xd0, 0x1a, 0x35, 0x48, 0x11, 0xc1, 0x09, 0x00, 0xdb, 0x93, 0xcb, 0xbe,
+	0x18, 0xd6, 0x47, 0xbd, 0x9b, 0x01, 0x2e, 0xcb, 0xe2, 0xbc, 0x27, 0x7c, 0x4a, 0x44, 0x2a, 0x6e,
+	0xb4, 0x03, 0xe0, 0x1b, 0xf7, 0xd9, 0xdd, 0xee, 0x5e, 0x28, 0x06, 0x6d, 0xd0, 0xa6, 0xbe, 0x1f,
+	0x33, 0x29, 0x75, 0x89, 0x2e, 0x39, 0x86, 0xb0, 0x0f, 0x9a, 0x6b, 0xf1, 0xc4, 0x62, 0xfb, 0x62,
+	0x68, 0x8d, 0xea, 0xc4, 0x04, 0x70, 0x02, 0x5a, 0x31, 0x93, 0x9b, 0x50, 0xd9, 0xf5, 0xa1, 0x35,
+	0xba, 0xbc, 0x41, 0xd5, 0xaa, 0xe6, 0x9a, 0x26, 0x3b, 0xd1, 0xae, 0xbb, 0x64, 0xcd, 0x48, 0x41,
+	0xa0, 0x9f, 0x75, 0xd0, 0x2b, 0x4b, 0x4b, 0xf8, 0x01, 0xb4, 0xd5, 0xee, 0x21, 0xa0, 0x32, 0x30,
+	0xb5, 0xa7, 0x30, 0x4b, 0xdd, 0xcb, 0x84, 0xae, 0xc2, 0x09, 0x2a, 0x04, 0x44, 0x5a, 0x6a, 0xf7,
+	0x85, 0xca, 0xa0, 0x32, 0x8a, 0x8b, 0xb3, 0x51, 0x7c, 0x02, 0x3d, 0x25, 0x14, 0x0d, 0x1f, 0x4c,
+	0xb3, 0x79, 0x57, 0xf5, 0xe9, 0x55, 0x96, 0xba, 0xb0, 0x48, 0x54, 0x8a, 0x88, 0x00, 0x1d, 0xcd,
+	0xf4, 0x4d, 0xc6, 0xa0, 0x9b, 0x30, 0x59, 0x60, 0x0d, 0x8d, 0xf5, 0xb3, 0xd4, 0x7d, 0x6d, 0xb0,
+	0x93, 0x84, 0x48, 0x27, 0x61, 0xd2, 0x20, 0x18, 0x74, 0x22, 0x51, 0x10, 0x4d, 0x4d, 0xbc, 0xcd,
+	0x52, 0xf7, 0x95, 0x21, 0x8e, 0x0a, 0x22, 0xed, 0x48, 0x18, 0xff, 0x2d, 0x00, 0xf2, 0x91, 0xaf,
+	0x0b, 0xa2, 0xa5, 0x89, 0x77, 0x59, 0xea, 0xbe, 0x31, 0x44, 0xa9, 0x21, 0xd2, 0xcd, 0x83, 0xd9,
+	0x5f, 0x23, 0x6e, 0xff, 0xef, 0x88, 0xe1, 0x67, 0xd0, 0xdc, 0xe6, 0xb3, 0xb5, 0x3b, 0x7a, 0x27,
+	0x46, 0xf8, 0x5f, 0x5b, 0x8c, 0xcb, 0x9f, 0x31, 0x6d, 0x3c, 0xa7, 0x6e, 0x8d, 0x18, 0x78, 0xfa,
+	0xf5, 0x79, 0xef, 0x58, 0x2f, 0x7b, 0xc7, 0xfa, 0xbd, 0x77, 0xac, 0x1f, 0x07, 0xa7, 0xf6, 0x72,
+	0x70, 0x6a, 0xbf, 0x0e, 0x4e, 0xed, 0xfb, 0xed, 0x92, 0xab, 0x60, 0x33, 0xc7, 0x0b, 0xb1, 0xf2,
+	0x56, 0x54, 0xf1, 0x45, 0xc4, 0xd4, 0x93, 0x88, 0x1f, 0xbd, 0xd3, 0xeb, 0xda, 0x9d, 0xbd, 0x2f,
+	0xdd, 0xee, 0xbc, 0xa5, 0x77, 0xfc, 0xe3, 0x9f, 0x01, 0x00, 0x84, 0x9a, 0x98, 0xb1, 0x84, 0x03,
+	0x00, 0x00,
 }
 
 func (m *PreviousValidators) Marshal() (dAtA []byte, err error) {
@@ -146,6 +328,120 @@ func (m *PreviousValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SideTxVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SideTxVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SideTxVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Power != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSidechannel(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SideTxVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SideTxVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SideTxVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSidechannel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Result != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SkipPower != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.SkipPower))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NoPower != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.NoPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.YesPower != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.YesPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintSidechannel(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSidechannel(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSidechannel(v)
 	base := offset
@@ -175,6 +471,62 @@ func (m *PreviousValidators) Size() (n int) {
 	return n
 }
 
+func (m *SideTxVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSidechannel(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovSidechannel(uint64(m.Power))
+	}
+	if m.Result != 0 {
+		n += 1 + sovSidechannel(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *SideTxVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovSidechannel(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSidechannel(uint64(m.Height))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovSidechannel(uint64(m.TotalPower))
+	}
+	if m.YesPower != 0 {
+		n += 1 + sovSidechannel(uint64(m.YesPower))
+	}
+	if m.NoPower != 0 {
+		n += 1 + sovSidechannel(uint64(m.NoPower))
+	}
+	if m.SkipPower != 0 {
+		n += 1 + sovSidechannel(uint64(m.SkipPower))
+	}
+	if m.Result != 0 {
+		n += 1 + sovSidechannel(uint64(m.Result))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovSidechannel(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovSidechannel(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -287,6 +639,362 @@ func (m *PreviousValidators) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SideTxVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSidechannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SideTxVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SideTxVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= types1.SideTxResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSidechannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SideTxVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSidechannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SideTxVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SideTxVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesPower", wireType)
+			}
+			m.YesPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.YesPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoPower", wireType)
+			}
+			m.NoPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NoPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipPower", wireType)
+			}
+			m.SkipPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkipPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= types1.SideTxResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, SideTxVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSidechannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSidechannel(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
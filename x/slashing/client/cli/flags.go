@@ -0,0 +1,8 @@
+package cli
+
+const (
+	FlagProposerAddress = "proposer"
+	FlagValidatorID     = "validator-id"
+	FlagTxHash          = "tx-hash"
+	FlagLogIndex        = "log-index"
+)
@@ -0,0 +1,313 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string) *cobra.Command {
+	// Group slashing queries under a subcommand
+	slashingQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	slashingQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQuerySigningInfo(),
+		GetCmdQuerySigningInfos(),
+		GetCmdQuerySlashingInfos(),
+		GetCmdQueryLatestSlashInfoBytes(),
+		GetCmdQueryTickSlashInfos(),
+		GetCmdQueryTickCount(),
+		GetCmdQueryIsOldTx(),
+	)
+
+	return slashingQueryCmd
+}
+
+// GetCmdQueryParams implements the params query command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "show the current slashing parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as slashing parameters.
+
+Example:
+$ %s query slashing params
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySigningInfo implements the signing info query command.
+func GetCmdQuerySigningInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-info [validator-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show signing info of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query missed signature counters of a validator.
+
+Example:
+$ %s query slashing signing-info 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			valID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid validator id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SigningInfo(context.Background(), &types.QuerySigningInfoRequest{ValId: valID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.ValSigningInfo)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySigningInfos implements the signing infos query command.
+func GetCmdQuerySigningInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-infos",
+		Args:  cobra.NoArgs,
+		Short: "show signing info of all validators",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SigningInfos(context.Background(), &types.QuerySigningInfosRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySlashingInfos implements the buffered slashing infos query command.
+func GetCmdQuerySlashingInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashing-infos",
+		Args:  cobra.NoArgs,
+		Short: "show slashing infos present in buffer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SlashingInfos(context.Background(), &types.QuerySlashingInfosRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryLatestSlashInfoBytes implements the latest slash info bytes query command.
+func GetCmdQueryLatestSlashInfoBytes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "latest-slash-info-bytes",
+		Args:  cobra.NoArgs,
+		Short: "show rlp encoded bytes of buffered slashing infos",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LatestSlashInfoBytes(context.Background(), &types.QueryLatestSlashInfoBytesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(res.SlashInfoBytes)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTickSlashInfos implements the tick slashing infos query command.
+func GetCmdQueryTickSlashInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tick-slash-infos",
+		Args:  cobra.NoArgs,
+		Short: "show slashing infos of the last tick awaiting ack",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TickSlashInfos(context.Background(), &types.QueryTickSlashInfosRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTickCount implements the tick count query command.
+func GetCmdQueryTickCount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tick-count",
+		Args:  cobra.NoArgs,
+		Short: "show tick count",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TickCount(context.Background(), &types.QueryTickCountRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprint(res.TickCount))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryIsOldTx checks whether a slashing event from root chain is already processed
+func GetCmdQueryIsOldTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "is-old-tx",
+		Short: "check whether the transaction is old",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			logIndex, err := cmd.Flags().GetUint64(FlagLogIndex)
+			if err != nil {
+				return err
+			}
+
+			txHashStr, err := cmd.Flags().GetString(FlagTxHash)
+			if err != nil {
+				return err
+			}
+
+			if txHashStr == "" {
+				return fmt.Errorf("LogIndex and transaction hash required")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SlashingOldTx(context.Background(), &types.QuerySlashingOldTxRequest{TxHash: txHashStr, LogIndex: logIndex})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprint(res.Status))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
+	cmd.Flags().Uint64(FlagLogIndex, 0, "--log-index=<log-index>")
+
+	_ = cmd.MarkFlagRequired(FlagTxHash)
+	_ = cmd.MarkFlagRequired(FlagLogIndex)
+
+	return cmd
+}
@@ -0,0 +1,226 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types/common"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/x/chainmanager/types"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		UnjailTxCmd(),
+		TickAckTxCmd(),
+	)
+
+	return txCmd
+}
+
+// Fetch chain manager params
+func getChainmanagerParams(clientCtx client.Context) (*chainmanagerTypes.Params, error) {
+	queryClient := chainmanagerTypes.NewQueryClient(clientCtx)
+	res, err := queryClient.Params(context.Background(), &chainmanagerTypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return res.GetParams(), nil
+}
+
+// UnjailTxCmd will create an unjail tx from a confirmed root chain unjail event
+func UnjailTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail",
+		Short: "Unjail validator previously jailed for downtime or double sign",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := client.GetClientContextFromCmd(cmd)
+			cliCtx, err := client.ReadTxCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// get proposer
+			proposerAddrStr, _ := cmd.Flags().GetString(FlagProposerAddress)
+			proposer, err := sdk.AccAddressFromHex(proposerAddrStr)
+			if err != nil {
+				return fmt.Errorf("Invalid proposer address: %s", err)
+			}
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			validator, _ := cmd.Flags().GetUint64(FlagValidatorID)
+			if validator == 0 {
+				return fmt.Errorf("Validator ID cannot be zero")
+			}
+
+			txhash, _ := cmd.Flags().GetString(FlagTxHash)
+			if txhash == "" {
+				return fmt.Errorf("transaction hash has to be supplied")
+			}
+
+			logIndex, _ := cmd.Flags().GetUint64(FlagLogIndex)
+
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			chainmanagerParams, err := getChainmanagerParams(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := contractCallerObj.GetConfirmedTxReceipt(
+				hmTypes.HexToHeimdallHash(txhash).EthHash(),
+				chainmanagerParams.MainchainTxConfirmations,
+			)
+			if err != nil || receipt == nil {
+				return errors.New("Transaction is not confirmed yet. Please wait for sometime and try again")
+			}
+
+			event, err := contractCallerObj.DecodeUnJailedEvent(
+				common.HexToAddress(chainmanagerParams.ChainParams.StakingInfoAddress),
+				receipt,
+				logIndex,
+			)
+			if err != nil {
+				return err
+			}
+
+			if event.ValidatorId.Uint64() != validator {
+				return fmt.Errorf("Validator ID mismatch with event, expected %v", event.ValidatorId)
+			}
+
+			msg := types.NewMsgUnjail(
+				proposer,
+				validator,
+				hmTypes.HexToHeimdallHash(txhash),
+				logIndex,
+				receipt.BlockNumber.Uint64(),
+			)
+
+			// broadcast msg with cli
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--validator-id=<validator-id>")
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
+	cmd.Flags().Uint64(FlagLogIndex, 0, "--log-index=<log-index>")
+
+	_ = cmd.MarkFlagRequired(FlagValidatorID)
+	_ = cmd.MarkFlagRequired(FlagTxHash)
+	_ = cmd.MarkFlagRequired(FlagLogIndex)
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// TickAckTxCmd will create a tick ack tx from a confirmed root chain slashed event
+func TickAckTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tick-ack",
+		Short: "Send tick ack for slashing processed on root chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := client.GetClientContextFromCmd(cmd)
+			cliCtx, err := client.ReadTxCommandFlags(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// get proposer
+			proposerAddrStr, _ := cmd.Flags().GetString(FlagProposerAddress)
+			proposer, err := sdk.AccAddressFromHex(proposerAddrStr)
+			if err != nil {
+				return fmt.Errorf("Invalid proposer address: %s", err)
+			}
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			txhash, _ := cmd.Flags().GetString(FlagTxHash)
+			if txhash == "" {
+				return fmt.Errorf("transaction hash has to be supplied")
+			}
+
+			logIndex, _ := cmd.Flags().GetUint64(FlagLogIndex)
+
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			chainmanagerParams, err := getChainmanagerParams(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := contractCallerObj.GetConfirmedTxReceipt(
+				hmTypes.HexToHeimdallHash(txhash).EthHash(),
+				chainmanagerParams.MainchainTxConfirmations,
+			)
+			if err != nil || receipt == nil {
+				return errors.New("Transaction is not confirmed yet. Please wait for sometime and try again")
+			}
+
+			event, err := contractCallerObj.DecodeSlashedEvent(
+				common.HexToAddress(chainmanagerParams.ChainParams.StakingInfoAddress),
+				receipt,
+				logIndex,
+			)
+			if err != nil {
+				return err
+			}
+
+			slashedAmount, err := helper.GetPowerFromAmount(event.Amount)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTickAck(
+				proposer,
+				event.Nonce.Uint64(),
+				slashedAmount.Uint64(),
+				hmTypes.HexToHeimdallHash(txhash),
+				logIndex,
+				receipt.BlockNumber.Uint64(),
+			)
+
+			// broadcast msg with cli
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
+	cmd.Flags().Uint64(FlagLogIndex, 0, "--log-index=<log-index>")
+
+	_ = cmd.MarkFlagRequired(FlagTxHash)
+	_ = cmd.MarkFlagRequired(FlagLogIndex)
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
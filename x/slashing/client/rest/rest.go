@@ -0,0 +1,12 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// RegisterRoutes registers slashing-related REST handlers to a router
+func RegisterRoutes(clientCtx client.Context, r *mux.Router) {
+
+}
@@ -0,0 +1,73 @@
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/slashing/keeper"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+// InitGenesis initializes the slashing module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState *types.GenesisState) {
+	keeper.SetParams(ctx, genState.Params)
+
+	// set signing infos
+	for _, info := range genState.SigningInfos {
+		keeper.SetValidatorSigningInfo(ctx, info.ValID, info)
+	}
+
+	// set missed blocks
+	for _, array := range genState.MissedBlocks {
+		for _, missed := range array.MissedBlocks {
+			keeper.SetValidatorMissedBlockBitArray(ctx, array.ValID, missed.Index, missed.Missed)
+		}
+	}
+
+	// set slashing buffer and total slashed amount
+	totalSlashedAmount := uint64(0)
+	for _, info := range genState.BufferValSlashingInfo {
+		keeper.SetBufferValSlashingInfo(ctx, info.ID, *info)
+		totalSlashedAmount += info.SlashedAmount
+	}
+	keeper.SetTotalSlashedAmount(ctx, totalSlashedAmount)
+
+	// set tick data
+	for _, info := range genState.TickValSlashingInfo {
+		keeper.SetTickValSlashingInfo(ctx, info.ID, *info)
+	}
+
+	// set tick count
+	keeper.UpdateTickCountWithValue(ctx, genState.TickCount)
+}
+
+// ExportGenesis returns the slashing module's exported genesis.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	signingInfos := keeper.GetAllValidatorSigningInfos(ctx)
+
+	missedBlocks := make([]types.ValidatorMissedBlocks, 0, len(signingInfos))
+	for _, info := range signingInfos {
+		missedBlocks = append(missedBlocks, types.ValidatorMissedBlocks{
+			ValID:        info.ValID,
+			MissedBlocks: getMissedBlocks(ctx, keeper, info.ValID),
+		})
+	}
+
+	return types.NewGenesisState(
+		keeper.GetParams(ctx),
+		signingInfos,
+		missedBlocks,
+		keeper.GetBufferValSlashingInfos(ctx),
+		keeper.GetTickValSlashingInfos(ctx),
+		keeper.GetTickCount(ctx),
+	)
+}
+
+func getMissedBlocks(ctx sdk.Context, keeper keeper.Keeper, valID hmTypes.ValidatorID) (missedBlocks []types.MissedBlock) {
+	keeper.IterateValidatorMissedBlockBitArray(ctx, valID, func(index int64, missed bool) bool {
+		missedBlocks = append(missedBlocks, types.MissedBlock{Index: index, Missed: missed})
+		return false
+	})
+	return
+}
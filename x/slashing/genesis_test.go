@@ -0,0 +1,78 @@
+package slashing_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maticnetwork/heimdall/app"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/slashing"
+	"github.com/maticnetwork/heimdall/x/slashing/test_helper"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+// GenesisTestSuite integrate test suite context object
+type GenesisTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+// SetupTest setup necessary things for genesis test
+func (suite *GenesisTestSuite) SetupTest() {
+	suite.app, suite.ctx, _ = test_helper.CreateTestApp(true)
+}
+
+// TestGenesisTestSuite
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+// TestInitExportGenesis test import and export genesis state
+func (suite *GenesisTestSuite) TestInitExportGenesis() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+
+	params := types.DefaultParams()
+	params.EnableSlashing = true
+
+	signingInfos := []hmTypes.ValidatorSigningInfo{
+		hmTypes.NewValidatorSigningInfo(hmTypes.NewValidatorID(1), 10, 3, 1),
+		hmTypes.NewValidatorSigningInfo(hmTypes.NewValidatorID(2), 20, 5, 0),
+	}
+	missedBlocks := []types.ValidatorMissedBlocks{
+		{
+			ValID:        hmTypes.NewValidatorID(1),
+			MissedBlocks: []types.MissedBlock{{Index: 2, Missed: true}},
+		},
+		{
+			ValID: hmTypes.NewValidatorID(2),
+		},
+	}
+	bufferInfo := hmTypes.NewValidatorSlashingInfo(hmTypes.NewValidatorID(1), 100, true)
+	tickInfo := hmTypes.NewValidatorSlashingInfo(hmTypes.NewValidatorID(2), 50, false)
+
+	genesisState := types.NewGenesisState(
+		params,
+		signingInfos,
+		missedBlocks,
+		[]*hmTypes.ValidatorSlashingInfo{&bufferInfo},
+		[]*hmTypes.ValidatorSlashingInfo{&tickInfo},
+		4,
+	)
+	require.NoError(t, genesisState.Validate())
+
+	slashing.InitGenesis(ctx, initApp.SlashingKeeper, genesisState)
+
+	actual := slashing.ExportGenesis(ctx, initApp.SlashingKeeper)
+	require.Equal(t, genesisState.Params, actual.Params)
+	require.Equal(t, genesisState.SigningInfos, actual.SigningInfos)
+	require.Equal(t, genesisState.MissedBlocks, actual.MissedBlocks)
+	require.Equal(t, genesisState.BufferValSlashingInfo, actual.BufferValSlashingInfo)
+	require.Equal(t, genesisState.TickValSlashingInfo, actual.TickValSlashingInfo)
+	require.Equal(t, genesisState.TickCount, actual.TickCount)
+	require.Equal(t, uint64(100), initApp.SlashingKeeper.GetTotalSlashedAmount(ctx))
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+	contractCaller helper.IContractCaller
+}
+
+// NewQueryServerImpl returns an implementation of the slashing QueryServer interface
+// for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper, contractCaller helper.IContractCaller) types.QueryServer {
+	return &Querier{Keeper: keeper, contractCaller: contractCaller}
+}
+
+var _ types.QueryServer = Querier{}
+
+// Params queries slashing params
+func (k Querier) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// SigningInfo queries signing info of a validator
+func (k Querier) SigningInfo(c context.Context, req *types.QuerySigningInfoRequest) (*types.QuerySigningInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty validator id")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, hmTypes.ValidatorID(req.ValId))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "signing info not found for validator %d", req.ValId)
+	}
+
+	return &types.QuerySigningInfoResponse{ValSigningInfo: signingInfo}, nil
+}
+
+// SigningInfos queries signing info of all validators
+func (k Querier) SigningInfos(c context.Context, req *types.QuerySigningInfosRequest) (*types.QuerySigningInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QuerySigningInfosResponse{SigningInfos: k.GetAllValidatorSigningInfos(ctx)}, nil
+}
+
+// SlashingInfos queries buffered slashing info of all validators
+func (k Querier) SlashingInfos(c context.Context, req *types.QuerySlashingInfosRequest) (*types.QuerySlashingInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QuerySlashingInfosResponse{SlashingInfos: k.GetBufferValSlashingInfos(ctx)}, nil
+}
+
+// LatestSlashInfoBytes queries rlp encoded bytes of the slashing buffer
+func (k Querier) LatestSlashInfoBytes(c context.Context, req *types.QueryLatestSlashInfoBytesRequest) (*types.QueryLatestSlashInfoBytesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	slashInfoBytes, err := types.SortAndRLPEncodeSlashInfos(k.GetBufferValSlashingInfos(ctx))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLatestSlashInfoBytesResponse{SlashInfoBytes: hmTypes.HexBytes(slashInfoBytes).String()}, nil
+}
+
+// TickSlashInfos queries slashing info submitted in the last tick
+func (k Querier) TickSlashInfos(c context.Context, req *types.QueryTickSlashInfosRequest) (*types.QueryTickSlashInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTickSlashInfosResponse{SlashingInfos: k.GetTickValSlashingInfos(ctx)}, nil
+}
+
+// TickCount queries tick count
+func (k Querier) TickCount(c context.Context, req *types.QueryTickCountRequest) (*types.QueryTickCountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTickCountResponse{TickCount: k.GetTickCount(ctx)}, nil
+}
+
+// SlashingOldTx queries whether the root chain event has already been processed
+func (k Querier) SlashingOldTx(c context.Context, req *types.QuerySlashingOldTxRequest) (*types.QuerySlashingOldTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TxHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty tx hash")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chainParams := k.Ck.GetParams(ctx)
+
+	// get main tx receipt
+	receipt, err := k.contractCaller.GetConfirmedTxReceipt(hmCommonTypes.HexToHeimdallHash(req.TxHash).EthHash(), chainParams.MainchainTxConfirmations)
+	if err != nil || receipt == nil {
+		return nil, status.Error(codes.Internal, "transaction is not confirmed yet. Please wait for sometime and try again")
+	}
+
+	sequence := GetSlashingSequence(receipt.BlockNumber.Uint64(), req.LogIndex)
+
+	return &types.QuerySlashingOldTxResponse{Status: k.HasSlashingSequence(ctx, sequence)}, nil
+}
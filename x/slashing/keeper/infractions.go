@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+// HandleValidatorSignature handles a validator signature, must be called once per validator per block.
+func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr []byte, power int64, signed bool) {
+	logger := k.Logger(ctx)
+	height := ctx.BlockHeight()
+	params := k.GetParams(ctx)
+
+	// fetch the validator by signer address
+	validator, err := k.Sk.GetValidatorInfo(ctx, addr)
+	if err != nil {
+		logger.Error("Validator signer address not found", "address", fmt.Sprintf("%X", addr))
+		return
+	}
+
+	// fetch signing info, validators from genesis start tracking from the first vote
+	signInfo, found := k.GetValidatorSigningInfo(ctx, validator.ID)
+	if !found {
+		signInfo = hmTypes.NewValidatorSigningInfo(validator.ID, height, 0, 0)
+	}
+
+	// this is a relative index, so it counts blocks the validator *should* have signed
+	// will use the 0-value default signing info if not present, except for start height
+	index := signInfo.IndexOffset % params.SignedBlocksWindow
+	signInfo.IndexOffset++
+
+	// Update signed block bit array & counter
+	// This counter just tracks the sum of the bit array
+	// That way we avoid needing to read/write the whole array each time
+	previous := k.GetValidatorMissedBlockBitArray(ctx, validator.ID, index)
+	missed := !signed
+	switch {
+	case !previous && missed:
+		// Array value has changed from not missed to missed, increment counter
+		k.SetValidatorMissedBlockBitArray(ctx, validator.ID, index, true)
+		signInfo.MissedBlocksCounter++
+	case previous && !missed:
+		// Array value has changed from missed to not missed, decrement counter
+		k.SetValidatorMissedBlockBitArray(ctx, validator.ID, index, false)
+		signInfo.MissedBlocksCounter--
+	default:
+		// Array value at this index has not changed, no need to update counter
+	}
+
+	if missed {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLiveness,
+				sdk.NewAttribute(types.AttributeKeyValID, validator.ID.String()),
+				sdk.NewAttribute(types.AttributeKeyMissedBlocks, fmt.Sprintf("%d", signInfo.MissedBlocksCounter)),
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", height)),
+			),
+		)
+
+		logger.Debug(
+			"absent validator",
+			"height", height,
+			"validator", validator.ID,
+			"missed", signInfo.MissedBlocksCounter,
+			"threshold", params.MinSignedPerWindowInt(),
+		)
+	}
+
+	minHeight := signInfo.StartHeight + params.SignedBlocksWindow
+	maxMissed := params.SignedBlocksWindow - params.MinSignedPerWindowInt()
+
+	// if we are past the minimum height and the validator has missed too many blocks, punish them
+	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed {
+		if !validator.Jailed && !k.IsJailedInBuffer(ctx, validator.ID) {
+			// Downtime confirmed: add slashing info of the validator to the buffer
+			logger.Info(
+				"slashing and jailing validator due to liveness fault",
+				"height", height,
+				"validator", validator.ID,
+				"min_height", minHeight,
+				"threshold", params.MinSignedPerWindowInt(),
+				"slashed", params.SlashFractionDowntime.String(),
+			)
+
+			k.SlashInterim(ctx, validator.ID, power, params.SlashFractionDowntime, types.AttributeValueMissingSignature)
+
+			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
+			signInfo.MissedBlocksCounter = 0
+			signInfo.IndexOffset = 0
+			k.ClearValidatorMissedBlockBitArray(ctx, validator.ID)
+		} else {
+			// validator is already jailed so we do not slash
+			logger.Info(
+				"validator would have been slashed for downtime, but was already jailed",
+				"validator", validator.ID,
+			)
+		}
+	}
+
+	// Set the updated signing info
+	k.SetValidatorSigningInfo(ctx, validator.ID, signInfo)
+}
+
+// HandleDoubleSign handles a validator signing two blocks at the same height.
+func (k Keeper) HandleDoubleSign(ctx sdk.Context, addr []byte, infractionHeight int64, timestamp time.Time, power int64) {
+	logger := k.Logger(ctx)
+	params := k.GetParams(ctx)
+
+	// calculate the age of the evidence
+	age := ctx.BlockHeader().Time.Sub(timestamp)
+
+	// reject evidence if the double-sign is too old
+	if age > params.MaxEvidenceAge {
+		logger.Info(
+			"ignored double sign, evidence too old",
+			"address", fmt.Sprintf("%X", addr),
+			"infraction_height", infractionHeight,
+			"age", age,
+			"max_age", params.MaxEvidenceAge,
+		)
+		return
+	}
+
+	// fetch the validator by signer address
+	validator, err := k.Sk.GetValidatorInfo(ctx, addr)
+	if err != nil {
+		// Ignore evidence that cannot be handled.
+		logger.Error("ignored double sign, validator not found", "address", fmt.Sprintf("%X", addr))
+		return
+	}
+
+	if validator.Jailed || k.IsJailedInBuffer(ctx, validator.ID) {
+		logger.Info("ignored double sign, validator already jailed", "validator", validator.ID)
+		return
+	}
+
+	logger.Info(
+		"confirmed double sign",
+		"validator", validator.ID,
+		"infraction_height", infractionHeight,
+		"slashed", params.SlashFractionDoubleSign.String(),
+	)
+
+	k.SlashInterim(ctx, validator.ID, power, params.SlashFractionDoubleSign, types.AttributeValueDoubleSign)
+}
+
+// SlashInterim adds the slashed amount of a validator to the slashing buffer.
+// The buffer is submitted with a tick once the slash limit is reached and is
+// applied to the staking state once the tick is acknowledged on the root chain.
+func (k Keeper) SlashInterim(ctx sdk.Context, valID hmTypes.ValidatorID, power int64, slashFraction sdk.Dec, reason string) {
+	params := k.GetParams(ctx)
+
+	// amount to slash in voting power
+	slashAmount := uint64(sdk.NewDec(power).Mul(slashFraction).TruncateInt64())
+
+	info, found := k.GetBufferValSlashingInfo(ctx, valID)
+	if !found {
+		info = hmTypes.NewValidatorSlashingInfo(valID, 0, false)
+	}
+
+	// jail the validator unless the power jailed within this tick would
+	// exceed the jail fraction limit of total power
+	if !info.IsJailed {
+		jailLimit := params.JailFractionLimit.MulInt64(k.Sk.GetTotalPower(ctx)).TruncateInt64()
+		if k.getBufferJailedPower(ctx)+power <= jailLimit {
+			info.IsJailed = true
+		} else {
+			k.Logger(ctx).Info("Jail fraction limit reached, slashing validator without jailing", "validator", valID)
+		}
+	}
+
+	info.SlashedAmount += slashAmount
+	k.SetBufferValSlashingInfo(ctx, valID, info)
+	k.SetTotalSlashedAmount(ctx, k.GetTotalSlashedAmount(ctx)+slashAmount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyValID, valID.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeySlashedAmount, fmt.Sprintf("%d", slashAmount)),
+			sdk.NewAttribute(types.AttributeKeyJailed, fmt.Sprintf("%t", info.IsJailed)),
+		),
+	)
+}
+
+// IsSlashLimitReached returns true if the buffered slashed amount has reached
+// the slash fraction limit of total power
+func (k Keeper) IsSlashLimitReached(ctx sdk.Context) bool {
+	totalSlashedAmount := k.GetTotalSlashedAmount(ctx)
+	if totalSlashedAmount == 0 {
+		return false
+	}
+
+	slashLimit := k.GetParams(ctx).SlashFractionLimit.MulInt64(k.Sk.GetTotalPower(ctx)).TruncateInt64()
+	return totalSlashedAmount >= uint64(slashLimit)
+}
+
+// IsJailedInBuffer returns true if the validator is marked to be jailed in the slashing buffer
+func (k Keeper) IsJailedInBuffer(ctx sdk.Context, valID hmTypes.ValidatorID) bool {
+	info, found := k.GetBufferValSlashingInfo(ctx, valID)
+	return found && info.IsJailed
+}
+
+// getBufferJailedPower returns the current power of validators marked to be jailed in the buffer
+func (k Keeper) getBufferJailedPower(ctx sdk.Context) (power int64) {
+	for _, info := range k.GetBufferValSlashingInfos(ctx) {
+		if !info.IsJailed {
+			continue
+		}
+
+		if validator, ok := k.Sk.GetValidatorFromValID(ctx, info.ID); ok {
+			power += validator.VotingPower
+		}
+	}
+	return
+}
@@ -0,0 +1,430 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	chainKeeper "github.com/maticnetwork/heimdall/x/chainmanager/keeper"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+	stakingKeeper "github.com/maticnetwork/heimdall/x/staking/keeper"
+)
+
+var (
+	DefaultValue = []byte{0x01} // Value to store for sequences and missed blocks
+
+	ValidatorSigningInfoKey         = []byte{0x01} // Prefix for signing info
+	ValidatorMissedBlockBitArrayKey = []byte{0x02} // Prefix for missed block bit array
+	BufferValSlashingInfoKey        = []byte{0x03} // Prefix for Slashing Info in buffer
+	TotalSlashedAmountKey           = []byte{0x04} // Key to store total slashed amount in buffer
+	TickValSlashingInfoKey          = []byte{0x05} // Prefix for Slashing Info submitted in the last tick
+	SlashingSequenceKey             = []byte{0x06} // prefix for each key for slashing sequence map
+	TickCountKey                    = []byte{0x07} // key to store Tick counts
+)
+
+// Keeper of the slashing store
+type Keeper struct {
+	cdc           codec.BinaryMarshaler
+	storeKey      sdk.StoreKey
+	paramSubspace paramtypes.Subspace
+	Sk            stakingKeeper.Keeper
+	Ck            chainKeeper.Keeper
+}
+
+// NewKeeper creates a slashing keeper
+func NewKeeper(
+	cdc codec.BinaryMarshaler,
+	storeKey sdk.StoreKey,
+	paramstore paramtypes.Subspace,
+	stakingKeeper stakingKeeper.Keeper,
+	chainKeeper chainKeeper.Keeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSubspace: paramstore,
+		Sk:            stakingKeeper,
+		Ck:            chainKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+//
+// Keys
+//
+
+// GetValidatorSigningInfoKey returns signing info key for validator id
+func GetValidatorSigningInfoKey(valID hmTypes.ValidatorID) []byte {
+	return append(ValidatorSigningInfoKey, sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
+// GetValidatorMissedBlockBitArrayPrefixKey returns missed block bit array prefix for validator id
+func GetValidatorMissedBlockBitArrayPrefixKey(valID hmTypes.ValidatorID) []byte {
+	return append(ValidatorMissedBlockBitArrayKey, sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
+// GetValidatorMissedBlockBitArrayKey returns missed block bit array key for validator id and index
+func GetValidatorMissedBlockBitArrayKey(valID hmTypes.ValidatorID, index int64) []byte {
+	return append(GetValidatorMissedBlockBitArrayPrefixKey(valID), sdk.Uint64ToBigEndian(uint64(index))...)
+}
+
+// GetBufferValSlashingInfoKey returns buffered slashing info key for validator id
+func GetBufferValSlashingInfoKey(valID hmTypes.ValidatorID) []byte {
+	return append(BufferValSlashingInfoKey, sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
+// GetTickValSlashingInfoKey returns tick slashing info key for validator id
+func GetTickValSlashingInfoKey(valID hmTypes.ValidatorID) []byte {
+	return append(TickValSlashingInfoKey, sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
+// GetSlashingSequenceKey returns slashing sequence key
+func GetSlashingSequenceKey(sequence string) []byte {
+	return append(SlashingSequenceKey, []byte(sequence)...)
+}
+
+//
+// Signing info
+//
+
+// GetValidatorSigningInfo returns signing info of validator
+func (k Keeper) GetValidatorSigningInfo(ctx sdk.Context, valID hmTypes.ValidatorID) (info hmTypes.ValidatorSigningInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorSigningInfoKey(valID))
+	if bz == nil {
+		return info, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return info, true
+}
+
+// SetValidatorSigningInfo sets signing info of validator
+func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, valID hmTypes.ValidatorID, info hmTypes.ValidatorSigningInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorSigningInfoKey(valID), k.cdc.MustMarshalBinaryBare(&info))
+}
+
+// IterateValidatorSigningInfos iterates over signing infos
+func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context, handler func(info hmTypes.ValidatorSigningInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorSigningInfoKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info hmTypes.ValidatorSigningInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &info)
+		if handler(info) {
+			break
+		}
+	}
+}
+
+// GetAllValidatorSigningInfos returns all signing infos
+func (k Keeper) GetAllValidatorSigningInfos(ctx sdk.Context) (infos []hmTypes.ValidatorSigningInfo) {
+	k.IterateValidatorSigningInfos(ctx, func(info hmTypes.ValidatorSigningInfo) bool {
+		infos = append(infos, info)
+		return false
+	})
+	return
+}
+
+//
+// Missed block bit array
+//
+
+// GetValidatorMissedBlockBitArray returns true if validator missed the block at given index
+func (k Keeper) GetValidatorMissedBlockBitArray(ctx sdk.Context, valID hmTypes.ValidatorID, index int64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetValidatorMissedBlockBitArrayKey(valID, index))
+}
+
+// SetValidatorMissedBlockBitArray sets the missed status of validator at given index
+func (k Keeper) SetValidatorMissedBlockBitArray(ctx sdk.Context, valID hmTypes.ValidatorID, index int64, missed bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetValidatorMissedBlockBitArrayKey(valID, index)
+	if missed {
+		store.Set(key, DefaultValue)
+	} else {
+		store.Delete(key)
+	}
+}
+
+// IterateValidatorMissedBlockBitArray iterates over the missed blocks of a validator
+func (k Keeper) IterateValidatorMissedBlockBitArray(ctx sdk.Context, valID hmTypes.ValidatorID, handler func(index int64, missed bool) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := GetValidatorMissedBlockBitArrayPrefixKey(valID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		index := int64(sdk.BigEndianToUint64(iterator.Key()[len(prefix):]))
+		if handler(index, true) {
+			break
+		}
+	}
+}
+
+// ClearValidatorMissedBlockBitArray deletes every missed block of a validator
+func (k Keeper) ClearValidatorMissedBlockBitArray(ctx sdk.Context, valID hmTypes.ValidatorID) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetValidatorMissedBlockBitArrayPrefixKey(valID))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+//
+// Slashing buffer
+//
+
+// GetBufferValSlashingInfo returns buffered slashing info of validator
+func (k Keeper) GetBufferValSlashingInfo(ctx sdk.Context, valID hmTypes.ValidatorID) (info hmTypes.ValidatorSlashingInfo, found bool) {
+	return k.getValSlashingInfo(ctx, GetBufferValSlashingInfoKey(valID))
+}
+
+// SetBufferValSlashingInfo sets buffered slashing info of validator
+func (k Keeper) SetBufferValSlashingInfo(ctx sdk.Context, valID hmTypes.ValidatorID, info hmTypes.ValidatorSlashingInfo) {
+	k.setValSlashingInfo(ctx, GetBufferValSlashingInfoKey(valID), info)
+}
+
+// GetBufferValSlashingInfos returns all buffered slashing infos
+func (k Keeper) GetBufferValSlashingInfos(ctx sdk.Context) []*hmTypes.ValidatorSlashingInfo {
+	return k.getValSlashingInfos(ctx, BufferValSlashingInfoKey)
+}
+
+// FlushBufferValSlashingInfos removes all buffered slashing infos
+func (k Keeper) FlushBufferValSlashingInfos(ctx sdk.Context) {
+	k.flushPrefix(ctx, BufferValSlashingInfoKey)
+}
+
+// GetTotalSlashedAmount returns total slashed amount in buffer
+func (k Keeper) GetTotalSlashedAmount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(TotalSlashedAmountKey) {
+		amount, err := strconv.ParseUint(string(store.Get(TotalSlashedAmountKey)), 10, 64)
+		if err != nil {
+			k.Logger(ctx).Error("Unable to convert key to int")
+		} else {
+			return amount
+		}
+	}
+
+	return 0
+}
+
+// SetTotalSlashedAmount sets total slashed amount in buffer
+func (k Keeper) SetTotalSlashedAmount(ctx sdk.Context, amount uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(TotalSlashedAmountKey, []byte(strconv.FormatUint(amount, 10)))
+}
+
+//
+// Tick
+//
+
+// GetTickValSlashingInfo returns slashing info of validator submitted in last tick
+func (k Keeper) GetTickValSlashingInfo(ctx sdk.Context, valID hmTypes.ValidatorID) (info hmTypes.ValidatorSlashingInfo, found bool) {
+	return k.getValSlashingInfo(ctx, GetTickValSlashingInfoKey(valID))
+}
+
+// SetTickValSlashingInfo sets slashing info of validator submitted in last tick
+func (k Keeper) SetTickValSlashingInfo(ctx sdk.Context, valID hmTypes.ValidatorID, info hmTypes.ValidatorSlashingInfo) {
+	k.setValSlashingInfo(ctx, GetTickValSlashingInfoKey(valID), info)
+}
+
+// GetTickValSlashingInfos returns all slashing infos submitted in last tick
+func (k Keeper) GetTickValSlashingInfos(ctx sdk.Context) []*hmTypes.ValidatorSlashingInfo {
+	return k.getValSlashingInfos(ctx, TickValSlashingInfoKey)
+}
+
+// FlushTickValSlashingInfos removes all slashing infos submitted in last tick
+func (k Keeper) FlushTickValSlashingInfos(ctx sdk.Context) {
+	k.flushPrefix(ctx, TickValSlashingInfoKey)
+}
+
+// CheckBufferValSlashingInfos checks that the slashing buffer covers the slashing
+// infos of a tick. Validators may have been slashed again since the tick was
+// proposed, so the buffered amounts only have to be at least the tick amounts.
+func (k Keeper) CheckBufferValSlashingInfos(ctx sdk.Context, infos []*hmTypes.ValidatorSlashingInfo) error {
+	if len(infos) == 0 {
+		return sdkerrors.Wrap(types.ErrSlashInfoDetails, "no slashing infos")
+	}
+
+	seen := make(map[hmTypes.ValidatorID]bool, len(infos))
+	for _, info := range infos {
+		if seen[info.ID] {
+			return sdkerrors.Wrapf(types.ErrSlashInfoDetails, "duplicate slashing info of validator %v", info.ID)
+		}
+		seen[info.ID] = true
+
+		buffered, found := k.GetBufferValSlashingInfo(ctx, info.ID)
+		if !found || buffered.SlashedAmount < info.SlashedAmount || (info.IsJailed && !buffered.IsJailed) {
+			return sdkerrors.Wrapf(types.ErrSlashInfoDetails, "slashing info of validator %v isn't buffered", info.ID)
+		}
+	}
+
+	return nil
+}
+
+// MoveBufferValSlashingInfosToTickData moves the slashing infos of a tick from the
+// slashing buffer into tick data, keeping what was buffered after the tick
+func (k Keeper) MoveBufferValSlashingInfosToTickData(ctx sdk.Context, infos []*hmTypes.ValidatorSlashingInfo) {
+	store := ctx.KVStore(k.storeKey)
+
+	var tickSlashedAmount uint64
+	for _, info := range infos {
+		buffered, _ := k.GetBufferValSlashingInfo(ctx, info.ID)
+		remaining := hmTypes.NewValidatorSlashingInfo(info.ID, buffered.SlashedAmount-info.SlashedAmount, buffered.IsJailed && !info.IsJailed)
+		if remaining.SlashedAmount == 0 && !remaining.IsJailed {
+			store.Delete(GetBufferValSlashingInfoKey(info.ID))
+		} else {
+			k.SetBufferValSlashingInfo(ctx, info.ID, remaining)
+		}
+
+		k.SetTickValSlashingInfo(ctx, info.ID, *info)
+		tickSlashedAmount += info.SlashedAmount
+	}
+
+	totalSlashedAmount := k.GetTotalSlashedAmount(ctx)
+	if totalSlashedAmount < tickSlashedAmount {
+		totalSlashedAmount = tickSlashedAmount
+	}
+	k.SetTotalSlashedAmount(ctx, totalSlashedAmount-tickSlashedAmount)
+}
+
+// GetTickSlashedAmount returns the total slashed amount submitted in last tick
+func (k Keeper) GetTickSlashedAmount(ctx sdk.Context) (amount uint64) {
+	for _, info := range k.GetTickValSlashingInfos(ctx) {
+		amount += info.SlashedAmount
+	}
+	return
+}
+
+// GetTickCount returns current tick count
+func (k Keeper) GetTickCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(TickCountKey) {
+		tickCount, err := strconv.ParseUint(string(store.Get(TickCountKey)), 10, 64)
+		if err != nil {
+			k.Logger(ctx).Error("Unable to convert key to int")
+		} else {
+			return tickCount
+		}
+	}
+
+	return 0
+}
+
+// UpdateTickCountWithValue updates tick count with value
+func (k Keeper) UpdateTickCountWithValue(ctx sdk.Context, value uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(TickCountKey, []byte(strconv.FormatUint(value, 10)))
+}
+
+// IncrementTickCount increments tick count by one
+func (k Keeper) IncrementTickCount(ctx sdk.Context) {
+	k.UpdateTickCountWithValue(ctx, k.GetTickCount(ctx)+1)
+}
+
+//
+// Sequences
+//
+
+// SetSlashingSequence sets mapping for sequence id to bool
+func (k Keeper) SetSlashingSequence(ctx sdk.Context, sequence string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetSlashingSequenceKey(sequence), DefaultValue)
+}
+
+// HasSlashingSequence checks if slashing sequence already exists
+func (k Keeper) HasSlashingSequence(ctx sdk.Context, sequence string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetSlashingSequenceKey(sequence))
+}
+
+//
+// Params
+//
+
+// SetParams sets the slashing module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the slashing module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSubspace.GetParamSet(ctx, &params)
+	return
+}
+
+//
+// utils
+//
+
+func (k Keeper) getValSlashingInfo(ctx sdk.Context, key []byte) (info hmTypes.ValidatorSlashingInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return info, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return info, true
+}
+
+func (k Keeper) setValSlashingInfo(ctx sdk.Context, key []byte, info hmTypes.ValidatorSlashingInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&info))
+}
+
+func (k Keeper) getValSlashingInfos(ctx sdk.Context, prefix []byte) (infos []*hmTypes.ValidatorSlashingInfo) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info hmTypes.ValidatorSlashingInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &info)
+		infos = append(infos, &info)
+	}
+
+	return infos
+}
+
+func (k Keeper) flushPrefix(ctx sdk.Context, prefix []byte) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maticnetwork/heimdall/app"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	checkpointSim "github.com/maticnetwork/heimdall/x/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/x/slashing/keeper"
+	"github.com/maticnetwork/heimdall/x/slashing/test_helper"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app, suite.ctx, _ = test_helper.CreateTestApp(false)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// Tests
+
+func (suite *KeeperTestSuite) TestValidatorSigningInfo() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	valID := hmTypes.NewValidatorID(1)
+
+	_, found := initApp.SlashingKeeper.GetValidatorSigningInfo(ctx, valID)
+	require.False(t, found)
+
+	info := hmTypes.NewValidatorSigningInfo(valID, 10, 2, 1)
+	initApp.SlashingKeeper.SetValidatorSigningInfo(ctx, valID, info)
+
+	actual, found := initApp.SlashingKeeper.GetValidatorSigningInfo(ctx, valID)
+	require.True(t, found)
+	require.Equal(t, info, actual)
+	require.Len(t, initApp.SlashingKeeper.GetAllValidatorSigningInfos(ctx), 1)
+}
+
+func (suite *KeeperTestSuite) TestValidatorMissedBlockBitArray() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	valID := hmTypes.NewValidatorID(1)
+
+	initApp.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, valID, 3, true)
+	initApp.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, valID, 5, true)
+	require.True(t, initApp.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, valID, 3))
+	require.False(t, initApp.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, valID, 4))
+
+	initApp.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, valID, 5, false)
+	require.False(t, initApp.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, valID, 5))
+
+	initApp.SlashingKeeper.ClearValidatorMissedBlockBitArray(ctx, valID)
+	require.False(t, initApp.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, valID, 3))
+}
+
+func (suite *KeeperTestSuite) TestBufferAndTickData() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	slashingKeeper := initApp.SlashingKeeper
+
+	info1 := hmTypes.NewValidatorSlashingInfo(hmTypes.NewValidatorID(1), 10, true)
+	info2 := hmTypes.NewValidatorSlashingInfo(hmTypes.NewValidatorID(2), 20, false)
+	slashingKeeper.SetBufferValSlashingInfo(ctx, info1.ID, info1)
+	slashingKeeper.SetBufferValSlashingInfo(ctx, info2.ID, info2)
+	require.Len(t, slashingKeeper.GetBufferValSlashingInfos(ctx), 2)
+	require.True(t, slashingKeeper.IsJailedInBuffer(ctx, info1.ID))
+	require.False(t, slashingKeeper.IsJailedInBuffer(ctx, info2.ID))
+
+	slashingKeeper.SetTotalSlashedAmount(ctx, 30)
+	tickInfos := []*hmTypes.ValidatorSlashingInfo{&info1, &info2}
+	require.NoError(t, slashingKeeper.CheckBufferValSlashingInfos(ctx, tickInfos))
+
+	// the buffer grows after the tick is proposed
+	grown := hmTypes.NewValidatorSlashingInfo(info2.ID, 25, true)
+	slashingKeeper.SetBufferValSlashingInfo(ctx, info2.ID, grown)
+	slashingKeeper.SetTotalSlashedAmount(ctx, 35)
+	require.NoError(t, slashingKeeper.CheckBufferValSlashingInfos(ctx, tickInfos))
+
+	// slashing infos which aren't buffered are rejected
+	info3 := hmTypes.NewValidatorSlashingInfo(hmTypes.NewValidatorID(3), 5, false)
+	require.Error(t, slashingKeeper.CheckBufferValSlashingInfos(ctx, []*hmTypes.ValidatorSlashingInfo{&info3}))
+	require.Error(t, slashingKeeper.CheckBufferValSlashingInfos(ctx, []*hmTypes.ValidatorSlashingInfo{&info1, &info1}))
+	require.Error(t, slashingKeeper.CheckBufferValSlashingInfos(ctx, nil))
+
+	slashingKeeper.MoveBufferValSlashingInfosToTickData(ctx, tickInfos)
+
+	// what was slashed after the proposal stays buffered
+	remaining := hmTypes.NewValidatorSlashingInfo(info2.ID, 5, true)
+	require.Equal(t, []*hmTypes.ValidatorSlashingInfo{&remaining}, slashingKeeper.GetBufferValSlashingInfos(ctx))
+	require.Equal(t, uint64(5), slashingKeeper.GetTotalSlashedAmount(ctx))
+
+	actual, found := slashingKeeper.GetTickValSlashingInfo(ctx, info2.ID)
+	require.True(t, found)
+	require.Equal(t, info2, actual)
+	require.Len(t, slashingKeeper.GetTickValSlashingInfos(ctx), 2)
+	require.Equal(t, uint64(30), slashingKeeper.GetTickSlashedAmount(ctx))
+
+	slashingKeeper.FlushTickValSlashingInfos(ctx)
+	require.Empty(t, slashingKeeper.GetTickValSlashingInfos(ctx))
+}
+
+func (suite *KeeperTestSuite) TestTickCountAndSequence() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	slashingKeeper := initApp.SlashingKeeper
+
+	require.Equal(t, uint64(0), slashingKeeper.GetTickCount(ctx))
+	slashingKeeper.IncrementTickCount(ctx)
+	slashingKeeper.IncrementTickCount(ctx)
+	require.Equal(t, uint64(2), slashingKeeper.GetTickCount(ctx))
+
+	sequence := keeper.GetSlashingSequence(100, 2)
+	require.False(t, slashingKeeper.HasSlashingSequence(ctx, sequence))
+	slashingKeeper.SetSlashingSequence(ctx, sequence)
+	require.True(t, slashingKeeper.HasSlashingSequence(ctx, sequence))
+}
+
+func (suite *KeeperTestSuite) TestHandleValidatorSignature() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	slashingKeeper := initApp.SlashingKeeper
+
+	valSet := checkpointSim.LoadValidatorSet(4, t, initApp.StakingKeeper, ctx, false, 10)
+	validator := valSet.Validators[0]
+	signer, err := sdk.AccAddressFromHex(validator.Signer)
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.SignedBlocksWindow = 10
+	slashingKeeper.SetParams(ctx, params)
+
+	// miss every block past the first window
+	for height := int64(1); height <= params.SignedBlocksWindow+2; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		slashingKeeper.HandleValidatorSignature(ctx, signer, validator.VotingPower, false)
+	}
+
+	// downtime slashes and jails the validator in buffer, and resets signing info
+	info, found := slashingKeeper.GetBufferValSlashingInfo(ctx, validator.ID)
+	require.True(t, found)
+	require.True(t, info.IsJailed)
+	require.Equal(t, uint64(params.SlashFractionDowntime.MulInt64(validator.VotingPower).TruncateInt64()), info.SlashedAmount)
+	require.Equal(t, info.SlashedAmount, slashingKeeper.GetTotalSlashedAmount(ctx))
+
+	signInfo, found := slashingKeeper.GetValidatorSigningInfo(ctx, validator.ID)
+	require.True(t, found)
+	require.Equal(t, int64(0), signInfo.MissedBlocksCounter)
+}
+
+func (suite *KeeperTestSuite) TestSlashInterimJailLimit() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	slashingKeeper := initApp.SlashingKeeper
+
+	valSet := checkpointSim.LoadValidatorSet(4, t, initApp.StakingKeeper, ctx, false, 10)
+	params := slashingKeeper.GetParams(ctx)
+
+	// jail fraction limit of 1/3 allows jailing only one of four equal validators
+	for _, validator := range valSet.Validators {
+		slashingKeeper.SlashInterim(ctx, validator.ID, validator.VotingPower, params.SlashFractionDoubleSign, types.AttributeValueDoubleSign)
+	}
+
+	jailed := 0
+	for _, info := range slashingKeeper.GetBufferValSlashingInfos(ctx) {
+		if info.IsJailed {
+			jailed++
+		}
+	}
+	require.Equal(t, 1, jailed)
+	require.Len(t, slashingKeeper.GetBufferValSlashingInfos(ctx), 4)
+}
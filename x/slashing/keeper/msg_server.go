@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+type msgServer struct {
+	Keeper
+	contractCaller helper.IContractCaller
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the slashing MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper, contractCaller helper.IContractCaller) types.MsgServer {
+	return &msgServer{Keeper: keeper, contractCaller: contractCaller}
+}
+
+// Unjail handles unjail msg
+func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.Logger(ctx).Debug("✅ Validating unjail msg",
+		"validatorId", msg.ID,
+		"txHash", msg.TxHash,
+		"logIndex", msg.LogIndex,
+		"blockNumber", msg.BlockNumber,
+	)
+
+	// check if incoming tx is older
+	if k.HasSlashingSequence(ctx, GetSlashingSequence(msg.BlockNumber, msg.LogIndex)) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return nil, hmCommon.ErrOldTx
+	}
+
+	// get validator from state and check jail status
+	validator, found := k.Sk.GetValidatorFromValID(ctx, hmTypes.ValidatorID(msg.ID))
+	if !found {
+		k.Logger(ctx).Error("Unable to fetch validator from store", "validatorId", msg.ID)
+		return nil, types.ErrNoValidatorForAddress
+	}
+
+	if !validator.Jailed {
+		k.Logger(ctx).Error("Validator is not jailed", "validatorId", msg.ID)
+		return nil, types.ErrValidatorNotJailed
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValID, strconv.FormatUint(msg.ID, 10)),
+		),
+	})
+
+	return &types.MsgUnjailResponse{}, nil
+}
+
+// Tick handles tick msg
+func (k msgServer) Tick(goCtx context.Context, msg *types.MsgTick) (*types.MsgTickResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.Logger(ctx).Debug("✅ Validating tick msg",
+		"id", msg.ID,
+		"proposer", msg.Proposer,
+		"slashInfoBytes", msg.SlashingInfoBytes,
+	)
+
+	// check if tick msgs are in continuity
+	tickCount := k.GetTickCount(ctx)
+	if msg.ID != tickCount+1 {
+		k.Logger(ctx).Error("Tick not in continuity", "msgID", msg.ID, "expectedMsgID", tickCount+1)
+		return nil, types.ErrInvalidTickID
+	}
+
+	// a new tick can only be submitted once the previous one is acknowledged
+	if len(k.GetTickValSlashingInfos(ctx)) != 0 {
+		k.Logger(ctx).Error("Previous tick is not yet acknowledged", "tickCount", tickCount)
+		return nil, types.ErrInvalidTickID
+	}
+
+	// check if slash limit is exceeded or not
+	if !k.IsSlashLimitReached(ctx) {
+		k.Logger(ctx).Error("Slash limit is not reached, tick is not allowed", "totalSlashedAmount", k.GetTotalSlashedAmount(ctx))
+		return nil, types.ErrSlashInfoDetails
+	}
+
+	// compare slashing info bytes with the buffer
+	slashInfoBytes, err := types.SortAndRLPEncodeSlashInfos(k.GetBufferValSlashingInfos(ctx))
+	if err != nil {
+		k.Logger(ctx).Error("Error generating slashing info bytes", "error", err)
+		return nil, types.ErrSlashInfoDetails
+	}
+
+	if !bytes.Equal(slashInfoBytes, msg.GetSlashingInfoBytes().Bytes()) {
+		k.Logger(ctx).Error("Slashing info bytes mismatch", "expected", hmTypes.HexBytes(slashInfoBytes).String(), "received", msg.SlashingInfoBytes)
+		return nil, types.ErrSlashInfoDetails
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTick,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyTickID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer),
+			sdk.NewAttribute(types.AttributeKeySlashInfoBytes, msg.SlashingInfoBytes),
+		),
+	})
+
+	return &types.MsgTickResponse{}, nil
+}
+
+// TickAck handles tick ack msg
+func (k msgServer) TickAck(goCtx context.Context, msg *types.MsgTickAck) (*types.MsgTickAckResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.Logger(ctx).Debug("✅ Validating tick ack msg",
+		"id", msg.ID,
+		"slashedAmount", msg.SlashedAmount,
+		"txHash", msg.TxHash,
+		"logIndex", msg.LogIndex,
+		"blockNumber", msg.BlockNumber,
+	)
+
+	// check if incoming tx is older
+	if k.HasSlashingSequence(ctx, GetSlashingSequence(msg.BlockNumber, msg.LogIndex)) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return nil, hmCommon.ErrOldTx
+	}
+
+	// check if tick ack msgs are in continuity
+	tickCount := k.GetTickCount(ctx)
+	if msg.ID != tickCount {
+		k.Logger(ctx).Error("Tick ack not in continuity", "msgID", msg.ID, "expectedMsgID", tickCount)
+		return nil, types.ErrTickAckNotInCountinuity
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTickAck,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyTickID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeySlashedAmount, strconv.FormatUint(msg.SlashedAmount, 10)),
+		),
+	})
+
+	return &types.MsgTickAckResponse{}, nil
+}
+
+// GetSlashingSequence returns the sequence of a root chain event
+func GetSlashingSequence(blockNumber uint64, logIndex uint64) string {
+	sequence := new(big.Int).Mul(new(big.Int).SetUint64(blockNumber), big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(logIndex))
+	return sequence.String()
+}
@@ -0,0 +1,348 @@
+package slashing
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	borCommon "github.com/maticnetwork/bor/common"
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
+	"github.com/maticnetwork/heimdall/x/slashing/keeper"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+// NewSideTxHandler returns a side handler for "slashing" type messages.
+func NewSideTxHandler(k keeper.Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgTick:
+			return SideHandleMsgTick(ctx, k, *msg, contractCaller)
+		case *types.MsgTickAck:
+			return SideHandleMsgTickAck(ctx, k, *msg, contractCaller)
+		case *types.MsgUnjail:
+			return SideHandleMsgUnjail(ctx, k, *msg, contractCaller)
+		default:
+			return abci.ResponseDeliverSideTx{
+				Code: sdkerrors.ErrUnknownRequest.ABCICode(),
+			}
+		}
+	}
+}
+
+// NewPostTxHandler returns a post handler for "slashing" type messages.
+func NewPostTxHandler(k keeper.Keeper, contractCaller helper.IContractCaller) hmTypes.PostTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg, sideTxResult tmprototypes.SideTxResultType) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgTick:
+			return PostHandleMsgTick(ctx, k, *msg, sideTxResult)
+		case *types.MsgTickAck:
+			return PostHandleMsgTickAck(ctx, k, *msg, sideTxResult)
+		case *types.MsgUnjail:
+			return PostHandleMsgUnjail(ctx, k, *msg, sideTxResult)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized %s message type: %T", types.ModuleName, msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+// SideHandleMsgTick handles MsgTick message for external call
+func SideHandleMsgTick(ctx sdk.Context, k keeper.Keeper, msg types.MsgTick, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for tick msg",
+		"msgID", msg.ID,
+		"proposer", msg.Proposer,
+		"slashInfoBytes", msg.SlashingInfoBytes,
+	)
+
+	// check if tick msgs are in continuity
+	if msg.ID != k.GetTickCount(ctx)+1 {
+		k.Logger(ctx).Error("Tick not in countinuity", "msgID", msg.ID, "expectedMsgID", k.GetTickCount(ctx)+1)
+		return hmCommon.ErrorSideTx(types.ErrInvalidTickID)
+	}
+
+	// check slashing infos against the buffer
+	slashingInfos, err := types.RLPDecodeSlashInfos(msg.GetSlashingInfoBytes().Bytes())
+	if err != nil {
+		k.Logger(ctx).Error("Error decoding slashing info bytes", "slashInfoBytes", msg.SlashingInfoBytes, "error", err)
+		return hmCommon.ErrorSideTx(types.ErrSlashInfoDetails)
+	}
+
+	if err := k.CheckBufferValSlashingInfos(ctx, slashingInfos); err != nil {
+		k.Logger(ctx).Error("Slashing infos mismatch", "slashInfoBytes", msg.SlashingInfoBytes, "error", err)
+		return hmCommon.ErrorSideTx(types.ErrSlashInfoDetails)
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for tick msg")
+	result.Result = tmprototypes.SideTxResultType_YES
+	return
+}
+
+// SideHandleMsgTickAck handles MsgTickAck message for external call
+func SideHandleMsgTickAck(ctx sdk.Context, k keeper.Keeper, msg types.MsgTickAck, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for tick-ack msg",
+		"txHash", msg.TxHash,
+		"logIndex", msg.LogIndex,
+		"blockNumber", msg.BlockNumber,
+	)
+
+	// chainManager params
+	params := k.Ck.GetParams(ctx)
+	chainParams := params.ChainParams
+
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(hmCommonTypes.HexToHeimdallHash(msg.TxHash).EthHash(), params.MainchainTxConfirmations)
+	if err != nil || receipt == nil {
+		return hmCommon.ErrorSideTx(hmCommon.ErrWaitForConfirmation)
+	}
+
+	// decode slashed event
+	stakingInfoAddress := borCommon.HexToAddress(chainParams.StakingInfoAddress)
+	eventLog, err := contractCaller.DecodeSlashedEvent(stakingInfoAddress, receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmCommon.ErrorSideTx(hmCommon.ErrDecodeEvent)
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	if eventLog.Nonce.Uint64() != msg.ID {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgId", msg.ID, "tickIdFromTx", eventLog.Nonce)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	slashedAmount, err := helper.GetPowerFromAmount(eventLog.Amount)
+	if err != nil || slashedAmount.Uint64() != msg.SlashedAmount {
+		k.Logger(ctx).Error("SlashedAmount in message doesn't match with amount in log", "msgSlashedAmount", msg.SlashedAmount, "amountFromTx", eventLog.Amount)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for tick-ack msg")
+	result.Result = tmprototypes.SideTxResultType_YES
+	return
+}
+
+// SideHandleMsgUnjail handles MsgUnjail message for external call
+func SideHandleMsgUnjail(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnjail, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for unjail msg",
+		"validatorId", msg.ID,
+		"txHash", msg.TxHash,
+		"logIndex", msg.LogIndex,
+		"blockNumber", msg.BlockNumber,
+	)
+
+	// chainManager params
+	params := k.Ck.GetParams(ctx)
+	chainParams := params.ChainParams
+
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(hmCommonTypes.HexToHeimdallHash(msg.TxHash).EthHash(), params.MainchainTxConfirmations)
+	if err != nil || receipt == nil {
+		return hmCommon.ErrorSideTx(hmCommon.ErrWaitForConfirmation)
+	}
+
+	// decode unjailed event
+	stakingInfoAddress := borCommon.HexToAddress(chainParams.StakingInfoAddress)
+	eventLog, err := contractCaller.DecodeUnJailedEvent(stakingInfoAddress, receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmCommon.ErrorSideTx(hmCommon.ErrDecodeEvent)
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgId", msg.ID, "validatorIdFromTx", eventLog.ValidatorId)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for unjail msg")
+	result.Result = tmprototypes.SideTxResultType_YES
+	return
+}
+
+// PostHandleMsgTick moves the slashing buffer into tick data once the tick is approved
+func PostHandleMsgTick(ctx sdk.Context, k keeper.Keeper, msg types.MsgTick, sideTxResult tmprototypes.SideTxResultType) (*sdk.Result, error) {
+	// Skip handler if tick is not approved
+	if sideTxResult != tmprototypes.SideTxResultType_YES {
+		k.Logger(ctx).Debug("Skipping tick since side-tx didn't get yes votes")
+		return nil, hmCommon.ErrSideTxValidation
+	}
+
+	// check if tick msgs are in continuity
+	if msg.ID != k.GetTickCount(ctx)+1 {
+		k.Logger(ctx).Error("Tick not in countinuity", "msgID", msg.ID, "expectedMsgID", k.GetTickCount(ctx)+1)
+		return nil, types.ErrInvalidTickID
+	}
+
+	// make sure the buffer still covers the tick, validators slashed since the
+	// tick was proposed stay in the buffer for the next tick
+	slashingInfos, err := types.RLPDecodeSlashInfos(msg.GetSlashingInfoBytes().Bytes())
+	if err == nil {
+		err = k.CheckBufferValSlashingInfos(ctx, slashingInfos)
+	}
+	if err != nil {
+		k.Logger(ctx).Error("Slashing infos mismatch, skipping tick", "slashInfoBytes", msg.SlashingInfoBytes, "error", err)
+		return nil, types.ErrSlashInfoDetails
+	}
+
+	k.Logger(ctx).Debug("Persisting tick state", "sideTxResult", sideTxResult)
+
+	// move tick slashing infos from the buffer to tick data
+	k.MoveBufferValSlashingInfosToTickData(ctx, slashingInfos)
+
+	// increment tick count
+	k.IncrementTickCount(ctx)
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	// emit event for tick confirmation, bridge submits the tick to root chain
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTickConfirm,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                        // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                      // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmCommonTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),                   // result
+			sdk.NewAttribute(types.AttributeKeyTickID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer),
+			sdk.NewAttribute(types.AttributeKeySlashInfoBytes, msg.SlashingInfoBytes),
+		),
+	})
+
+	return &sdk.Result{
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
+
+// PostHandleMsgTickAck applies the tick slashing data to the staking state
+func PostHandleMsgTickAck(ctx sdk.Context, k keeper.Keeper, msg types.MsgTickAck, sideTxResult tmprototypes.SideTxResultType) (*sdk.Result, error) {
+	// Skip handler if tick-ack is not approved
+	if sideTxResult != tmprototypes.SideTxResultType_YES {
+		k.Logger(ctx).Debug("Skipping tick-ack since side-tx didn't get yes votes")
+		return nil, hmCommon.ErrSideTxValidation
+	}
+
+	// check if incoming tx is older
+	sequence := keeper.GetSlashingSequence(msg.BlockNumber, msg.LogIndex)
+	if k.HasSlashingSequence(ctx, sequence) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return nil, hmCommon.ErrOldTx
+	}
+
+	// the ack is for the latest tick
+	if msg.ID != k.GetTickCount(ctx) {
+		k.Logger(ctx).Error("Tick ack not in countinuity", "msgID", msg.ID, "expectedMsgID", k.GetTickCount(ctx))
+		return nil, types.ErrTickAckNotInCountinuity
+	}
+
+	// the amount slashed on the root chain matches the tick data
+	if tickSlashedAmount := k.GetTickSlashedAmount(ctx); msg.SlashedAmount != tickSlashedAmount {
+		k.Logger(ctx).Error("SlashedAmount in message doesn't match with tick data", "msgSlashedAmount", msg.SlashedAmount, "tickSlashedAmount", tickSlashedAmount)
+		return nil, types.ErrSlashInfoDetails
+	}
+
+	k.Logger(ctx).Debug("Persisting tick-ack state", "sideTxResult", sideTxResult)
+
+	// slash validators as per tick data
+	for _, slashingInfo := range k.GetTickValSlashingInfos(ctx) {
+		if err := k.Sk.Slash(ctx, *slashingInfo); err != nil {
+			k.Logger(ctx).Error("Error slashing validator", "validatorId", slashingInfo.ID, "error", err)
+			return nil, err
+		}
+	}
+
+	// remove tick data
+	k.FlushTickValSlashingInfos(ctx)
+
+	// save sequence
+	k.SetSlashingSequence(ctx, sequence)
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTickAck,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                        // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                      // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmCommonTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),                   // result
+			sdk.NewAttribute(types.AttributeKeyTickID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeySlashedAmount, strconv.FormatUint(msg.SlashedAmount, 10)),
+		),
+	})
+
+	return &sdk.Result{
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
+
+// PostHandleMsgUnjail unjails the validator and resets its signing info
+func PostHandleMsgUnjail(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnjail, sideTxResult tmprototypes.SideTxResultType) (*sdk.Result, error) {
+	// Skip handler if unjail is not approved
+	if sideTxResult != tmprototypes.SideTxResultType_YES {
+		k.Logger(ctx).Debug("Skipping unjail since side-tx didn't get yes votes")
+		return nil, hmCommon.ErrSideTxValidation
+	}
+
+	// check if incoming tx is older
+	sequence := keeper.GetSlashingSequence(msg.BlockNumber, msg.LogIndex)
+	if k.HasSlashingSequence(ctx, sequence) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return nil, hmCommon.ErrOldTx
+	}
+
+	k.Logger(ctx).Debug("Persisting unjail state", "sideTxResult", sideTxResult)
+
+	valID := hmTypes.ValidatorID(msg.ID)
+
+	// unjail validator
+	k.Sk.Unjail(ctx, valID)
+
+	// restart liveness tracking from the current height
+	k.SetValidatorSigningInfo(ctx, valID, hmTypes.NewValidatorSigningInfo(valID, ctx.BlockHeight(), 0, 0))
+	k.ClearValidatorMissedBlockBitArray(ctx, valID)
+
+	// save sequence
+	k.SetSlashingSequence(ctx, sequence)
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                        // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                      // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmCommonTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),                   // result
+			sdk.NewAttribute(types.AttributeKeyValID, valID.String()),
+		),
+	})
+
+	return &sdk.Result{
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
@@ -0,0 +1,261 @@
+package slashing_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/app"
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
+	checkpointSim "github.com/maticnetwork/heimdall/x/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/x/slashing"
+	"github.com/maticnetwork/heimdall/x/slashing/test_helper"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+//
+// Create test suite
+//
+
+// SideHandlerTestSuite integrate test suite context object
+type SideHandlerTestSuite struct {
+	suite.Suite
+
+	app            *app.HeimdallApp
+	ctx            sdk.Context
+	sideHandler    hmTypes.SideTxHandler
+	postHandler    hmTypes.PostTxHandler
+	contractCaller mocks.IContractCaller
+}
+
+func (suite *SideHandlerTestSuite) SetupTest() {
+	suite.app, suite.ctx, _ = test_helper.CreateTestApp(false)
+
+	suite.contractCaller = mocks.IContractCaller{}
+	suite.sideHandler = slashing.NewSideTxHandler(suite.app.SlashingKeeper, &suite.contractCaller)
+	suite.postHandler = slashing.NewPostTxHandler(suite.app.SlashingKeeper, &suite.contractCaller)
+}
+
+func TestSideHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SideHandlerTestSuite))
+}
+
+//
+// Test cases
+//
+
+func (suite *SideHandlerTestSuite) TestSideHandler() {
+	t, ctx := suite.T(), suite.ctx
+
+	// side handler
+	result := suite.sideHandler(ctx, nil)
+	require.Equal(t, sdkerrors.ErrUnknownRequest.ABCICode(), result.Code)
+	require.Equal(t, abci.SideTxResultType_SKIP, result.Result)
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgTick() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	slashingKeeper := initApp.SlashingKeeper
+
+	info := hmTypes.NewValidatorSlashingInfo(hmTypes.NewValidatorID(1), 10, true)
+	slashingKeeper.SetBufferValSlashingInfo(ctx, info.ID, info)
+	slashInfoBytes, err := types.SortAndRLPEncodeSlashInfos(slashingKeeper.GetBufferValSlashingInfos(ctx))
+	require.NoError(t, err)
+
+	proposer := sdk.AccAddress([]byte("proposer"))
+
+	t.Run("Success", func(t *testing.T) {
+		msg := types.NewMsgTick(1, proposer, slashInfoBytes)
+		result := suite.sideHandler(ctx, &msg)
+		require.Equal(t, uint32(0), result.Code)
+		require.Equal(t, abci.SideTxResultType_YES, result.Result)
+	})
+
+	t.Run("InvalidTickID", func(t *testing.T) {
+		msg := types.NewMsgTick(2, proposer, slashInfoBytes)
+		result := suite.sideHandler(ctx, &msg)
+		require.NotEqual(t, uint32(0), result.Code)
+		require.Equal(t, abci.SideTxResultType_SKIP, result.Result)
+	})
+
+	t.Run("SlashInfoMismatch", func(t *testing.T) {
+		msg := types.NewMsgTick(1, proposer, hmTypes.HexBytes("mismatch"))
+		result := suite.sideHandler(ctx, &msg)
+		require.Equal(t, types.ErrSlashInfoDetails.ABCICode(), result.Code)
+		require.Equal(t, abci.SideTxResultType_SKIP, result.Result)
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgUnjail() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	chainParams := initApp.ChainKeeper.GetParams(ctx)
+
+	logIndex := uint64(1)
+	blockNumber := uint64(100)
+	txHash := hmCommonTypes.HexToHeimdallHash("unjail hash")
+	txReceipt := &ethTypes.Receipt{BlockNumber: new(big.Int).SetUint64(blockNumber)}
+
+	t.Run("Success", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+		msg := types.NewMsgUnjail(sdk.AccAddress([]byte("from")), 1, txHash, logIndex, blockNumber)
+
+		event := &stakinginfo.StakinginfoUnJailed{ValidatorId: big.NewInt(1)}
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txReceipt, nil)
+		suite.contractCaller.On("DecodeUnJailedEvent", mock.Anything, txReceipt, logIndex).Return(event, nil)
+
+		result := suite.sideHandler(ctx, &msg)
+		require.Equal(t, uint32(0), result.Code)
+		require.Equal(t, abci.SideTxResultType_YES, result.Result)
+	})
+
+	t.Run("ValidatorIDMismatch", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+		msg := types.NewMsgUnjail(sdk.AccAddress([]byte("from")), 2, txHash, logIndex, blockNumber)
+
+		event := &stakinginfo.StakinginfoUnJailed{ValidatorId: big.NewInt(1)}
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txReceipt, nil)
+		suite.contractCaller.On("DecodeUnJailedEvent", mock.Anything, txReceipt, logIndex).Return(event, nil)
+
+		result := suite.sideHandler(ctx, &msg)
+		require.Equal(t, hmCommon.ErrInvalidMsg.ABCICode(), result.Code)
+		require.Equal(t, abci.SideTxResultType_SKIP, result.Result)
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgTick() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	slashingKeeper := initApp.SlashingKeeper
+
+	info := hmTypes.NewValidatorSlashingInfo(hmTypes.NewValidatorID(1), 10, true)
+	slashingKeeper.SetBufferValSlashingInfo(ctx, info.ID, info)
+	slashingKeeper.SetTotalSlashedAmount(ctx, info.SlashedAmount)
+	slashInfoBytes, err := types.SortAndRLPEncodeSlashInfos(slashingKeeper.GetBufferValSlashingInfos(ctx))
+	require.NoError(t, err)
+
+	msg := types.NewMsgTick(1, sdk.AccAddress([]byte("proposer")), slashInfoBytes)
+
+	t.Run("NoResult", func(t *testing.T) {
+		_, err := suite.postHandler(ctx, &msg, abci.SideTxResultType_NO)
+		require.Error(t, err)
+		require.Len(t, slashingKeeper.GetBufferValSlashingInfos(ctx), 1)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		result, err := suite.postHandler(ctx, &msg, abci.SideTxResultType_YES)
+		require.NoError(t, err)
+		require.NotEmpty(t, result.Events)
+
+		require.Empty(t, slashingKeeper.GetBufferValSlashingInfos(ctx))
+		require.Equal(t, uint64(0), slashingKeeper.GetTotalSlashedAmount(ctx))
+		require.Equal(t, []*hmTypes.ValidatorSlashingInfo{&info}, slashingKeeper.GetTickValSlashingInfos(ctx))
+		require.Equal(t, uint64(1), slashingKeeper.GetTickCount(ctx))
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgTickGrownBuffer() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	slashingKeeper := initApp.SlashingKeeper
+
+	info := hmTypes.NewValidatorSlashingInfo(hmTypes.NewValidatorID(1), 10, true)
+	slashInfoBytes, err := types.SortAndRLPEncodeSlashInfos([]*hmTypes.ValidatorSlashingInfo{&info})
+	require.NoError(t, err)
+	msg := types.NewMsgTick(1, sdk.AccAddress([]byte("proposer")), slashInfoBytes)
+
+	// validators got slashed after the tick was proposed
+	slashingKeeper.SetBufferValSlashingInfo(ctx, info.ID, hmTypes.NewValidatorSlashingInfo(info.ID, 15, true))
+	other := hmTypes.NewValidatorSlashingInfo(hmTypes.NewValidatorID(2), 7, false)
+	slashingKeeper.SetBufferValSlashingInfo(ctx, other.ID, other)
+	slashingKeeper.SetTotalSlashedAmount(ctx, 22)
+
+	_, err = suite.postHandler(ctx, &msg, abci.SideTxResultType_YES)
+	require.NoError(t, err)
+
+	remaining := hmTypes.NewValidatorSlashingInfo(info.ID, 5, false)
+	require.Equal(t, []*hmTypes.ValidatorSlashingInfo{&remaining, &other}, slashingKeeper.GetBufferValSlashingInfos(ctx))
+	require.Equal(t, uint64(12), slashingKeeper.GetTotalSlashedAmount(ctx))
+	require.Equal(t, []*hmTypes.ValidatorSlashingInfo{&info}, slashingKeeper.GetTickValSlashingInfos(ctx))
+
+	// tick slashing more than buffered is rejected
+	tooMuch := hmTypes.NewValidatorSlashingInfo(other.ID, 8, false)
+	slashInfoBytes, err = types.SortAndRLPEncodeSlashInfos([]*hmTypes.ValidatorSlashingInfo{&tooMuch})
+	require.NoError(t, err)
+	msg = types.NewMsgTick(2, sdk.AccAddress([]byte("proposer")), slashInfoBytes)
+	_, err = suite.postHandler(ctx, &msg, abci.SideTxResultType_YES)
+	require.Equal(t, types.ErrSlashInfoDetails, err)
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgTickAck() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	slashingKeeper := initApp.SlashingKeeper
+
+	valSet := checkpointSim.LoadValidatorSet(2, t, initApp.StakingKeeper, ctx, false, 10)
+	validator := valSet.Validators[0]
+
+	info := hmTypes.NewValidatorSlashingInfo(validator.ID, 1, true)
+	slashingKeeper.SetTickValSlashingInfo(ctx, info.ID, info)
+	slashingKeeper.UpdateTickCountWithValue(ctx, 3)
+
+	from := sdk.AccAddress([]byte("from"))
+	txHash := hmCommonTypes.HexToHeimdallHash("tick ack hash")
+
+	t.Run("TickIDMismatch", func(t *testing.T) {
+		msg := types.NewMsgTickAck(from, 2, info.SlashedAmount, txHash, 1, 100)
+		_, err := suite.postHandler(ctx, &msg, abci.SideTxResultType_YES)
+		require.Equal(t, types.ErrTickAckNotInCountinuity, err)
+	})
+
+	t.Run("SlashedAmountMismatch", func(t *testing.T) {
+		msg := types.NewMsgTickAck(from, 3, info.SlashedAmount+1, txHash, 1, 100)
+		_, err := suite.postHandler(ctx, &msg, abci.SideTxResultType_YES)
+		require.Equal(t, types.ErrSlashInfoDetails, err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		msg := types.NewMsgTickAck(from, 3, info.SlashedAmount, txHash, 1, 100)
+		_, err := suite.postHandler(ctx, &msg, abci.SideTxResultType_YES)
+		require.NoError(t, err)
+		require.Empty(t, slashingKeeper.GetTickValSlashingInfos(ctx))
+
+		slashed, found := initApp.StakingKeeper.GetValidatorFromValID(ctx, validator.ID)
+		require.True(t, found)
+		require.Equal(t, validator.VotingPower-1, slashed.VotingPower)
+		require.True(t, slashed.Jailed)
+
+		// replay is rejected
+		_, err = suite.postHandler(ctx, &msg, abci.SideTxResultType_YES)
+		require.Equal(t, hmCommon.ErrOldTx, err)
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgUnjail() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+
+	valSet := checkpointSim.LoadValidatorSet(2, t, initApp.StakingKeeper, ctx, false, 10)
+	validator := valSet.Validators[0]
+
+	txHash := hmCommonTypes.HexToHeimdallHash("unjail hash")
+	msg := types.NewMsgUnjail(sdk.AccAddress([]byte("from")), validator.ID.Uint64(), txHash, 1, 100)
+
+	ctx = ctx.WithBlockHeight(50)
+	_, err := suite.postHandler(ctx, &msg, abci.SideTxResultType_YES)
+	require.NoError(t, err)
+
+	signInfo, found := initApp.SlashingKeeper.GetValidatorSigningInfo(ctx, validator.ID)
+	require.True(t, found)
+	require.Equal(t, int64(50), signInfo.StartHeight)
+
+	// replay is rejected
+	_, err = suite.postHandler(ctx, &msg, abci.SideTxResultType_YES)
+	require.Equal(t, hmCommon.ErrOldTx, err)
+}
@@ -0,0 +1,17 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/slashing module sentinel errors
+var (
+	ErrNoValidatorForAddress   = sdkerrors.Register(ModuleName, 10002, "address is not associated with any known validator")
+	ErrValidatorNotJailed      = sdkerrors.Register(ModuleName, 10003, "validator not jailed, cannot be unjailed")
+	ErrNoSigningInfoFound      = sdkerrors.Register(ModuleName, 10004, "no validator signing info found")
+	ErrInvalidTickID           = sdkerrors.Register(ModuleName, 10005, "invalid tick id")
+	ErrSlashInfoDetails        = sdkerrors.Register(ModuleName, 10006, "slash info details mismatch")
+	ErrTickAckNotInCountinuity = sdkerrors.Register(ModuleName, 10007, "tick ack not in continuity")
+)
@@ -0,0 +1,29 @@
+package types
+
+// Slashing module event types
+var (
+	EventTypeSlash       = "slash"
+	EventTypeLiveness    = "liveness"
+	EventTypeSlashLimit  = "slash-limit"
+	EventTypeTick        = "tick"
+	EventTypeTickConfirm = "tick-confirm"
+	EventTypeTickAck     = "tick-ack"
+	EventTypeUnjail      = "unjail"
+
+	AttributeKeyValID          = "val-id"
+	AttributeKeyPower          = "power"
+	AttributeKeyReason         = "reason"
+	AttributeKeyJailed         = "jailed"
+	AttributeKeyMissedBlocks   = "missed-blocks"
+	AttributeKeyHeight         = "height"
+	AttributeKeyProposer       = "proposer"
+	AttributeKeyTickID         = "tick-id"
+	AttributeKeySlashInfoBytes = "slash-info-bytes"
+	AttributeKeySlashedAmount  = "slashed-amount"
+	AttributeKeyTotalSlashed   = "total-slashed-amount"
+
+	AttributeValueDoubleSign       = "double-sign"
+	AttributeValueMissingSignature = "missing-signature"
+
+	AttributeValueCategory = ModuleName
+)
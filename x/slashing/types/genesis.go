@@ -0,0 +1,80 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(
+	params Params,
+	signingInfos []hmTypes.ValidatorSigningInfo,
+	missedBlocks []ValidatorMissedBlocks,
+	bufferValSlashingInfo []*hmTypes.ValidatorSlashingInfo,
+	tickValSlashingInfo []*hmTypes.ValidatorSlashingInfo,
+	tickCount uint64,
+) *GenesisState {
+	return &GenesisState{
+		Params:                params,
+		SigningInfos:          signingInfos,
+		MissedBlocks:          missedBlocks,
+		BufferValSlashingInfo: bufferValSlashingInfo,
+		TickValSlashingInfo:   tickValSlashingInfo,
+		TickCount:             tickCount,
+	}
+}
+
+// DefaultGenesis returns the default slashing genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[hmTypes.ValidatorID]bool, len(gs.SigningInfos))
+	for _, info := range gs.SigningInfos {
+		if seen[info.ValID] {
+			return fmt.Errorf("duplicate signing info for validator %d", info.ValID)
+		}
+		seen[info.ValID] = true
+	}
+
+	for _, missed := range gs.MissedBlocks {
+		for _, block := range missed.MissedBlocks {
+			if block.Index < 0 || block.Index >= gs.Params.SignedBlocksWindow {
+				return fmt.Errorf("invalid missed block index %d for validator %d", block.Index, missed.ValID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetGenesisStateFromAppState returns slashing GenesisState given raw application genesis state
+func GetGenesisStateFromAppState(cdc codec.JSONMarshaler, appState map[string]json.RawMessage) GenesisState {
+	var genesisState GenesisState
+	if appState[ModuleName] != nil {
+		cdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+	return genesisState
+}
+
+// SetGenesisStateToAppState sets state into app state
+func SetGenesisStateToAppState(cdc codec.JSONMarshaler, appState map[string]json.RawMessage, signingInfos []hmTypes.ValidatorSigningInfo) (map[string]json.RawMessage, error) {
+	slashingState := GetGenesisStateFromAppState(cdc, appState)
+	slashingState.SigningInfos = signingInfos
+
+	appState[ModuleName] = cdc.MustMarshalJSON(&slashingState)
+	return appState, nil
+}
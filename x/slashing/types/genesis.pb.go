@@ -0,0 +1,584 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: heimdall/slashing/v1beta1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/maticnetwork/heimdall/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the slashing module's genesis state.
+type GenesisState struct {
+	Params                Params                         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	SigningInfos          []types.ValidatorSigningInfo   `protobuf:"bytes,2,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos" yaml:"signing_infos"`
+	MissedBlocks          []ValidatorMissedBlocks        `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
+	BufferValSlashingInfo []*types.ValidatorSlashingInfo `protobuf:"bytes,4,rep,name=buffer_val_slashing_info,json=bufferValSlashingInfo,proto3" json:"buffer_val_slashing_info,omitempty" yaml:"buffer_val_slashing_info"`
+	TickValSlashingInfo   []*types.ValidatorSlashingInfo `protobuf:"bytes,5,rep,name=tick_val_slashing_info,json=tickValSlashingInfo,proto3" json:"tick_val_slashing_info,omitempty" yaml:"tick_val_slashing_info"`
+	TickCount             uint64                         `protobuf:"varint,6,opt,name=tick_count,json=tickCount,proto3" json:"tick_count,omitempty" yaml:"tick_count"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95bd7a59de0e3246, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "heimdall.slashing.v1beta1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("heimdall/slashing/v1beta1/genesis.proto", fileDescriptor_95bd7a59de0e3246)
+}
+
+var fileDescriptor_95bd7a59de0e3246 = []byte{
+	// 449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0x6d, 0x6a, 0x22, 0x71, 0x4d, 0x07, 0x4c, 0x8b, 0x4c, 0x05, 0x76, 0x6a, 0x8a, 0xf0,
+	0x64, 0x93, 0xc2, 0xd4, 0x05, 0xc9, 0x0c, 0x08, 0x21, 0x24, 0xe4, 0x4a, 0x1d, 0x58, 0xac, 0xb3,
+	0x73, 0xbe, 0x9c, 0x72, 0xbe, 0x8b, 0x7c, 0x97, 0x42, 0x07, 0x26, 0x16, 0x46, 0x3e, 0x02, 0x1f,
+	0xa7, 0x63, 0x47, 0x26, 0x0b, 0x25, 0x3b, 0x83, 0x3f, 0x01, 0xf2, 0x39, 0x71, 0x92, 0xaa, 0x46,
+	0x62, 0xbb, 0xd3, 0xf3, 0xfb, 0xbf, 0x3c, 0xd2, 0x03, 0x9e, 0x8f, 0x11, 0xc9, 0x47, 0x90, 0xd2,
+	0x40, 0x50, 0x28, 0xc6, 0x84, 0xe1, 0xe0, 0x62, 0x98, 0x20, 0x09, 0x87, 0x01, 0x46, 0x0c, 0x09,
+	0x22, 0xfc, 0x69, 0xc1, 0x25, 0x37, 0x1f, 0xad, 0x40, 0x7f, 0x05, 0xfa, 0x4b, 0xf0, 0x70, 0x1f,
+	0x73, 0xcc, 0x15, 0x15, 0xd4, 0xaf, 0x46, 0x70, 0x78, 0xdc, 0x3a, 0x27, 0x50, 0xa0, 0xd6, 0xb5,
+	0x55, 0x37, 0x94, 0xd7, 0x9d, 0xbf, 0x4d, 0xba, 0x7f, 0x0c, 0xd0, 0x7f, 0xdb, 0x54, 0x3a, 0x93,
+	0x50, 0x22, 0xf3, 0x35, 0xe8, 0x4d, 0x61, 0x01, 0x73, 0x61, 0xe9, 0x03, 0xdd, 0xdb, 0x3d, 0x39,
+	0xf2, 0x3b, 0x2b, 0xfa, 0x1f, 0x15, 0x18, 0x1a, 0x57, 0xa5, 0xa3, 0x45, 0x4b, 0x99, 0x89, 0xc1,
+	0x9e, 0x20, 0x98, 0x11, 0x86, 0x63, 0xc2, 0x32, 0x2e, 0xac, 0x3b, 0x83, 0x1d, 0x6f, 0xf7, 0xe4,
+	0x78, 0xed, 0x23, 0x2f, 0xa7, 0x48, 0xf8, 0xe7, 0x90, 0x92, 0x11, 0x94, 0xbc, 0x38, 0x6b, 0xe8,
+	0x77, 0x2c, 0xe3, 0xe1, 0xe3, 0xda, 0xaa, 0x2a, 0x9d, 0xfd, 0x4b, 0x98, 0xd3, 0x53, 0x77, 0xcb,
+	0xc8, 0x8d, 0xfa, 0x62, 0x8d, 0x0a, 0x53, 0x80, 0xbd, 0x9c, 0x08, 0x81, 0x46, 0x71, 0x42, 0x79,
+	0x3a, 0x11, 0xd6, 0x8e, 0x0a, 0x7a, 0xf1, 0x8f, 0xc2, 0x6d, 0xe6, 0x07, 0x25, 0x0c, 0x95, 0xee,
+	0x66, 0xe8, 0x96, 0xa9, 0x1b, 0xf5, 0xf3, 0x0d, 0xd6, 0xfc, 0xa6, 0x03, 0x2b, 0x99, 0x65, 0x19,
+	0x2a, 0xe2, 0x0b, 0x48, 0xe3, 0x55, 0x82, 0x6a, 0x68, 0x19, 0xaa, 0xc0, 0xb3, 0xee, 0x4d, 0x97,
+	0xb4, 0x5a, 0xf5, 0x69, 0x55, 0x3a, 0x4e, 0x93, 0xd8, 0x65, 0xe8, 0x46, 0x07, 0xcd, 0xe8, 0x1c,
+	0xd2, 0x4d, 0xad, 0xf9, 0x15, 0x3c, 0x94, 0x24, 0x9d, 0xdc, 0x52, 0xe1, 0xee, 0xff, 0x54, 0x38,
+	0xaa, 0x4a, 0xe7, 0x49, 0x53, 0xe1, 0x76, 0x3b, 0x37, 0x7a, 0x50, 0x0f, 0x6e, 0xc6, 0xbf, 0x02,
+	0x40, 0xf1, 0x29, 0x9f, 0x31, 0x69, 0xf5, 0x06, 0xba, 0x67, 0x84, 0x07, 0x55, 0xe9, 0xdc, 0xdf,
+	0xf0, 0x52, 0x33, 0x37, 0xba, 0x57, 0x7f, 0xde, 0xd4, 0xef, 0x53, 0xe3, 0xfb, 0x4f, 0x47, 0x0b,
+	0xdf, 0x5f, 0xcd, 0x6d, 0xfd, 0x7a, 0x6e, 0xeb, 0xbf, 0xe7, 0xb6, 0xfe, 0x63, 0x61, 0x6b, 0xd7,
+	0x0b, 0x5b, 0xfb, 0xb5, 0xb0, 0xb5, 0x4f, 0x43, 0x4c, 0xe4, 0x78, 0x96, 0xf8, 0x29, 0xcf, 0x83,
+	0x1c, 0x4a, 0x92, 0x32, 0x24, 0x3f, 0xf3, 0x62, 0x12, 0xb4, 0xc7, 0xfc, 0x65, 0x7d, 0xce, 0x6a,
+	0xad, 0xa4, 0xa7, 0x8e, 0xf8, 0xe5, 0xdf, 0x01, 0x00, 0x7f, 0xc8, 0x87, 0x77, 0x70, 0x03, 0x00,
+	0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TickCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TickCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TickValSlashingInfo) > 0 {
+		for iNdEx := len(m.TickValSlashingInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TickValSlashingInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BufferValSlashingInfo) > 0 {
+		for iNdEx := len(m.BufferValSlashingInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BufferValSlashingInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BufferValSlashingInfo) > 0 {
+		for _, e := range m.BufferValSlashingInfo {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TickValSlashingInfo) > 0 {
+		for _, e := range m.TickValSlashingInfo {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TickCount != 0 {
+		n += 1 + sovGenesis(uint64(m.TickCount))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, types.ValidatorSigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks, ValidatorMissedBlocks{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferValSlashingInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BufferValSlashingInfo = append(m.BufferValSlashingInfo, &types.ValidatorSlashingInfo{})
+			if err := m.BufferValSlashingInfo[len(m.BufferValSlashingInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickValSlashingInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickValSlashingInfo = append(m.TickValSlashingInfo, &types.ValidatorSlashingInfo{})
+			if err := m.TickValSlashingInfo[len(m.TickValSlashingInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickCount", wireType)
+			}
+			m.TickCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
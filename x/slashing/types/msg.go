@@ -0,0 +1,250 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/accounts/abi"
+	"github.com/maticnetwork/bor/common"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
+)
+
+//
+// Msg Unjail
+//
+
+var _ sdk.Msg = &MsgUnjail{}
+
+// NewMsgUnjail creates new unjail message using mentioned arguments
+func NewMsgUnjail(
+	from sdk.AccAddress,
+	id uint64,
+	txHash hmCommonTypes.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) MsgUnjail {
+	return MsgUnjail{
+		From:        from.String(),
+		ID:          id,
+		TxHash:      txHash.String(),
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+// Route returns message route
+func (msg MsgUnjail) Route() string {
+	return RouterKey
+}
+
+// Type returns message type
+func (msg MsgUnjail) Type() string {
+	return "unjail"
+}
+
+// GetSigners returns address of the signer
+func (msg MsgUnjail) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromHex(msg.From)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns sign bytes
+func (msg MsgUnjail) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic validate basic
+func (msg MsgUnjail) ValidateBasic() error {
+	if msg.From == "" {
+		return hmCommon.ErrInvalidMsg
+	}
+
+	if msg.ID == 0 {
+		return hmCommon.ErrInvalidMsg
+	}
+
+	if msg.TxHash == "" {
+		return hmCommon.ErrInvalidMsg
+	}
+
+	return nil
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgUnjail) GetTxHash() hmCommonTypes.HeimdallHash {
+	return hmCommonTypes.HexToHeimdallHash(msg.TxHash)
+}
+
+// GetLogIndex Returns log index
+func (msg MsgUnjail) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgUnjail) GetSideSignBytes() []byte {
+	return nil
+}
+
+//
+// Msg Tick
+//
+
+var _ sdk.Msg = &MsgTick{}
+
+// NewMsgTick creates new tick message using mentioned arguments
+func NewMsgTick(
+	id uint64,
+	proposer sdk.AccAddress,
+	slashingInfoBytes hmTypes.HexBytes,
+) MsgTick {
+	return MsgTick{
+		ID:                id,
+		Proposer:          proposer.String(),
+		SlashingInfoBytes: slashingInfoBytes.String(),
+	}
+}
+
+// Route returns message route
+func (msg MsgTick) Route() string {
+	return RouterKey
+}
+
+// Type returns message type
+func (msg MsgTick) Type() string {
+	return "tick"
+}
+
+// GetSigners returns address of the signer
+func (msg MsgTick) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromHex(msg.Proposer)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns sign bytes
+func (msg MsgTick) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic validate basic
+func (msg MsgTick) ValidateBasic() error {
+	if msg.Proposer == "" {
+		return hmCommon.ErrInvalidMsg
+	}
+
+	if msg.ID == 0 {
+		return hmCommon.ErrInvalidMsg
+	}
+
+	if len(msg.GetSlashingInfoBytes()) == 0 {
+		return hmCommon.ErrInvalidMsg
+	}
+
+	return nil
+}
+
+// GetSlashingInfoBytes returns the decoded slashing info bytes
+func (msg MsgTick) GetSlashingInfoBytes() hmTypes.HexBytes {
+	return hmTypes.HexBytes(common.FromHex(msg.SlashingInfoBytes))
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgTick) GetSideSignBytes() []byte {
+	// abi.encode(tickID, proposer, slashingInfoBytes) as expected by slash manager
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	addressType, _ := abi.NewType("address", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+
+	arguments := abi.Arguments{
+		{Type: uint256Type},
+		{Type: addressType},
+		{Type: bytesType},
+	}
+
+	data, err := arguments.Pack(
+		new(big.Int).SetUint64(msg.ID),
+		common.HexToAddress(msg.Proposer),
+		msg.GetSlashingInfoBytes().Bytes(),
+	)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+//
+// Msg Tick Ack
+//
+
+var _ sdk.Msg = &MsgTickAck{}
+
+// NewMsgTickAck creates new tick ack message using mentioned arguments
+func NewMsgTickAck(
+	from sdk.AccAddress,
+	id uint64,
+	slashedAmount uint64,
+	txHash hmCommonTypes.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) MsgTickAck {
+	return MsgTickAck{
+		From:          from.String(),
+		ID:            id,
+		SlashedAmount: slashedAmount,
+		TxHash:        txHash.String(),
+		LogIndex:      logIndex,
+		BlockNumber:   blockNumber,
+	}
+}
+
+// Route returns message route
+func (msg MsgTickAck) Route() string {
+	return RouterKey
+}
+
+// Type returns message type
+func (msg MsgTickAck) Type() string {
+	return "tick-ack"
+}
+
+// GetSigners returns address of the signer
+func (msg MsgTickAck) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromHex(msg.From)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns sign bytes
+func (msg MsgTickAck) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic validate basic
+func (msg MsgTickAck) ValidateBasic() error {
+	if msg.From == "" {
+		return hmCommon.ErrInvalidMsg
+	}
+
+	if msg.TxHash == "" {
+		return hmCommon.ErrInvalidMsg
+	}
+
+	return nil
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgTickAck) GetTxHash() hmCommonTypes.HeimdallHash {
+	return hmCommonTypes.HexToHeimdallHash(msg.TxHash)
+}
+
+// GetLogIndex Returns log index
+func (msg MsgTickAck) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgTickAck) GetSideSignBytes() []byte {
+	return nil
+}
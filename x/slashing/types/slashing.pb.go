@@ -0,0 +1,1037 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: heimdall/slashing/v1beta1/slashing.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types "github.com/maticnetwork/heimdall/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params represents the parameters used for by the slashing module.
+type Params struct {
+	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty" yaml:"signed_blocks_window"`
+	MinSignedPerWindow      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_signed_per_window" yaml:"min_signed_per_window"`
+	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
+	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+	SlashFractionLimit      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_limit,json=slashFractionLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_limit" yaml:"slash_fraction_limit"`
+	JailFractionLimit       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=jail_fraction_limit,json=jailFractionLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"jail_fraction_limit" yaml:"jail_fraction_limit"`
+	MaxEvidenceAge          time.Duration                          `protobuf:"bytes,7,opt,name=max_evidence_age,json=maxEvidenceAge,proto3,stdduration" json:"max_evidence_age" yaml:"max_evidence_age"`
+	EnableSlashing          bool                                   `protobuf:"varint,8,opt,name=enable_slashing,json=enableSlashing,proto3" json:"enable_slashing,omitempty" yaml:"enable_slashing"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_889e646635452a28, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// ValidatorMissedBlocks contains array of missed blocks of corresponding
+// validator.
+type ValidatorMissedBlocks struct {
+	ValID        types.ValidatorID `protobuf:"varint,1,opt,name=val_id,json=valId,proto3,enum=heimdall.types.ValidatorID" json:"val_id,omitempty" yaml:"val_id"`
+	MissedBlocks []MissedBlock     `protobuf:"bytes,2,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
+}
+
+func (m *ValidatorMissedBlocks) Reset()         { *m = ValidatorMissedBlocks{} }
+func (m *ValidatorMissedBlocks) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMissedBlocks) ProtoMessage()    {}
+func (*ValidatorMissedBlocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_889e646635452a28, []int{1}
+}
+func (m *ValidatorMissedBlocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMissedBlocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMissedBlocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorMissedBlocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMissedBlocks.Merge(m, src)
+}
+func (m *ValidatorMissedBlocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMissedBlocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMissedBlocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorMissedBlocks proto.InternalMessageInfo
+
+// MissedBlock contains height and missed status as boolean.
+type MissedBlock struct {
+	Index  int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Missed bool  `protobuf:"varint,2,opt,name=missed,proto3" json:"missed,omitempty"`
+}
+
+func (m *MissedBlock) Reset()         { *m = MissedBlock{} }
+func (m *MissedBlock) String() string { return proto.CompactTextString(m) }
+func (*MissedBlock) ProtoMessage()    {}
+func (*MissedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_889e646635452a28, []int{2}
+}
+func (m *MissedBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MissedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MissedBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MissedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissedBlock.Merge(m, src)
+}
+func (m *MissedBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MissedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissedBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissedBlock proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "heimdall.slashing.v1beta1.Params")
+	proto.RegisterType((*ValidatorMissedBlocks)(nil), "heimdall.slashing.v1beta1.ValidatorMissedBlocks")
+	proto.RegisterType((*MissedBlock)(nil), "heimdall.slashing.v1beta1.MissedBlock")
+}
+
+func init() {
+	proto.RegisterFile("heimdall/slashing/v1beta1/slashing.proto", fileDescriptor_889e646635452a28)
+}
+
+var fileDescriptor_889e646635452a28 = []byte{
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x81, 0x84, 0x68, 0xf8, 0xb9, 0xf7, 0xfa, 0x06, 0x08, 0x01, 0xd9, 0xa9, 0xab, 0xa2,
+	0x6c, 0x6a, 0x0b, 0xba, 0x63, 0x87, 0x9b, 0x56, 0x42, 0x05, 0x44, 0x8d, 0x44, 0xa5, 0x6e, 0xac,
+	0xb1, 0x3d, 0x38, 0x53, 0x3c, 0x1e, 0x64, 0x3b, 0x3f, 0x48, 0x95, 0xaa, 0xee, 0x58, 0xb2, 0x64,
+	0xc9, 0x4b, 0xf4, 0x1d, 0x58, 0xb2, 0x44, 0x5d, 0xb8, 0x55, 0x78, 0x83, 0x3c, 0x41, 0xe5, 0x99,
+	0x89, 0x9b, 0xa6, 0x61, 0x91, 0x55, 0x32, 0xdf, 0x39, 0xe7, 0x3b, 0x9f, 0x8f, 0xce, 0x77, 0x40,
+	0xa3, 0x85, 0x30, 0xf1, 0x60, 0x10, 0x18, 0x71, 0x00, 0xe3, 0x16, 0x0e, 0x7d, 0xa3, 0xb3, 0xed,
+	0xa0, 0x04, 0x6e, 0xe7, 0x80, 0x7e, 0x11, 0xd1, 0x84, 0xca, 0xeb, 0xc3, 0x4c, 0x3d, 0x0f, 0x88,
+	0xcc, 0x5a, 0xc5, 0xa7, 0x3e, 0x65, 0x59, 0x46, 0xf6, 0x8f, 0x17, 0xd4, 0x14, 0x9f, 0x52, 0x3f,
+	0x40, 0x06, 0x7b, 0x39, 0xed, 0x33, 0xc3, 0x6b, 0x47, 0x30, 0xc1, 0x34, 0x14, 0xf1, 0x17, 0x79,
+	0x6b, 0x07, 0xc6, 0x28, 0x6f, 0xdb, 0x81, 0x01, 0xf6, 0x60, 0x42, 0x23, 0x9e, 0xa6, 0x7d, 0x9b,
+	0x07, 0xa5, 0x63, 0x18, 0x41, 0x12, 0xcb, 0xef, 0x41, 0x25, 0xc6, 0x7e, 0x88, 0x3c, 0xdb, 0x09,
+	0xa8, 0x7b, 0x1e, 0xdb, 0x5d, 0x1c, 0x7a, 0xb4, 0x5b, 0x95, 0xea, 0x52, 0x63, 0xd6, 0x54, 0x07,
+	0xa9, 0xba, 0x71, 0x09, 0x49, 0xb0, 0xab, 0x4d, 0xca, 0xd2, 0x2c, 0x99, 0xc3, 0x26, 0x43, 0x3f,
+	0x30, 0x50, 0xfe, 0x2a, 0x81, 0x15, 0x82, 0x43, 0x5b, 0x54, 0x5c, 0xa0, 0x68, 0x48, 0x3a, 0x53,
+	0x97, 0x1a, 0x8b, 0xe6, 0xd1, 0x5d, 0xaa, 0x16, 0xbe, 0xa7, 0xea, 0x96, 0x8f, 0x93, 0x56, 0xdb,
+	0xd1, 0x5d, 0x4a, 0x0c, 0x97, 0xc6, 0x84, 0xc6, 0xe2, 0xe7, 0x65, 0xec, 0x9d, 0x1b, 0xc9, 0xe5,
+	0x05, 0x8a, 0xf5, 0x26, 0x72, 0x07, 0xa9, 0xba, 0xc9, 0x25, 0x4c, 0x24, 0xd5, 0x2c, 0x99, 0xe0,
+	0xf0, 0x84, 0xc1, 0xc7, 0x28, 0x12, 0x1a, 0xae, 0x25, 0x50, 0x63, 0x33, 0xb5, 0xcf, 0x22, 0xe8,
+	0x66, 0x13, 0xb2, 0x3d, 0xda, 0x76, 0x02, 0xc4, 0x08, 0xaa, 0xb3, 0x4c, 0xc8, 0xc9, 0xd4, 0x42,
+	0x9e, 0x89, 0x59, 0x3c, 0xc9, 0xac, 0x59, 0x6b, 0x2c, 0xf8, 0x56, 0xc4, 0x9a, 0x2c, 0x94, 0xa9,
+	0x93, 0xaf, 0x24, 0xb0, 0xf6, 0x57, 0x61, 0x37, 0x4c, 0x30, 0x41, 0xd5, 0x39, 0xa6, 0xe7, 0x78,
+	0x6a, 0x3d, 0xca, 0x13, 0x7a, 0x38, 0xad, 0x66, 0xad, 0x8c, 0x89, 0xe1, 0xb8, 0xfc, 0x05, 0x54,
+	0xc6, 0x4a, 0x02, 0x4c, 0x70, 0x52, 0x2d, 0x32, 0x19, 0x87, 0x53, 0xcb, 0xd8, 0x98, 0x28, 0x83,
+	0x71, 0x66, 0x2b, 0x32, 0xaa, 0xe1, 0x20, 0x03, 0xe5, 0xcf, 0xe0, 0xff, 0x4f, 0x10, 0x07, 0xe3,
+	0xfd, 0x4b, 0xac, 0xff, 0xc1, 0xd4, 0xfd, 0x6b, 0xbc, 0xff, 0x04, 0x4a, 0xcd, 0xfa, 0x2f, 0x43,
+	0xff, 0xec, 0xde, 0x02, 0xff, 0x12, 0xd8, 0xb3, 0x51, 0x07, 0x7b, 0x28, 0x74, 0x91, 0x0d, 0x7d,
+	0x54, 0x9d, 0xaf, 0x4b, 0x8d, 0x85, 0x9d, 0x75, 0x9d, 0x1b, 0x4c, 0x1f, 0x1a, 0x4c, 0x6f, 0x0a,
+	0x83, 0x99, 0xcf, 0x33, 0x55, 0x83, 0x54, 0x5d, 0x13, 0xbb, 0x38, 0x46, 0xa0, 0xdd, 0xfc, 0x50,
+	0x25, 0x6b, 0x99, 0xc0, 0xde, 0x1b, 0x81, 0xee, 0xf9, 0x48, 0x7e, 0x0d, 0xfe, 0x41, 0x21, 0x64,
+	0xcb, 0x21, 0x0c, 0x5e, 0x2d, 0xd7, 0xa5, 0x46, 0xd9, 0xac, 0x0d, 0x52, 0x75, 0x95, 0x33, 0x8d,
+	0x25, 0x68, 0xd6, 0x32, 0x47, 0x4e, 0x04, 0xb0, 0x5b, 0xbe, 0xba, 0x55, 0x0b, 0x37, 0xb7, 0x6a,
+	0x41, 0x7b, 0x90, 0xc0, 0xca, 0xe9, 0xd0, 0xcb, 0x87, 0x38, 0x8e, 0x87, 0xce, 0x93, 0x8f, 0x40,
+	0xa9, 0x03, 0x03, 0x1b, 0x7b, 0xcc, 0xb8, 0xcb, 0x3b, 0x1b, 0x7a, 0x7e, 0x5a, 0xf8, 0xa0, 0xf2,
+	0xb2, 0xfd, 0xa6, 0x59, 0xeb, 0xa7, 0x6a, 0xf1, 0x14, 0x06, 0xfb, 0xcd, 0x41, 0xaa, 0x2e, 0x71,
+	0x15, 0xbc, 0x5a, 0xb3, 0x8a, 0x1d, 0x18, 0xec, 0x7b, 0x32, 0x06, 0x4b, 0x84, 0xf1, 0x0b, 0xc3,
+	0x57, 0x67, 0xea, 0xb3, 0x8d, 0x85, 0x9d, 0x2d, 0xfd, 0xc9, 0x8b, 0xa5, 0x8f, 0xe8, 0x31, 0x37,
+	0xc5, 0xb0, 0x2a, 0x43, 0xe3, 0x8e, 0x50, 0x69, 0xd6, 0x22, 0x19, 0x91, 0xbe, 0x3b, 0x97, 0x7d,
+	0x9e, 0xb6, 0x07, 0x16, 0x46, 0x08, 0xe4, 0x0a, 0x28, 0xe2, 0xd0, 0x43, 0x3d, 0x7e, 0x87, 0x2c,
+	0xfe, 0x90, 0x57, 0x41, 0x89, 0x97, 0xb2, 0x4b, 0x52, 0xb6, 0xc4, 0x8b, 0x53, 0x98, 0xef, 0xee,
+	0xfa, 0x8a, 0x74, 0xdf, 0x57, 0xa4, 0x9f, 0x7d, 0x45, 0xba, 0x7e, 0x54, 0x0a, 0xf7, 0x8f, 0x4a,
+	0xe1, 0xe1, 0x51, 0x29, 0x7c, 0xdc, 0x1e, 0xd9, 0x24, 0x02, 0x13, 0xec, 0x86, 0x28, 0xe9, 0xd2,
+	0xe8, 0xdc, 0xc8, 0xcf, 0x65, 0xef, 0xf7, 0xad, 0x66, 0xf3, 0x72, 0x4a, 0x6c, 0x03, 0x5e, 0xfd,
+	0x1a, 0x00, 0x03, 0x64, 0xbc, 0x7d, 0xcd, 0x05, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EnableSlashing {
+		i--
+		if m.EnableSlashing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxEvidenceAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxEvidenceAge):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.JailFractionLimit.Size()
+		i -= size
+		if _, err := m.JailFractionLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SlashFractionLimit.Size()
+		i -= size
+		if _, err := m.SlashFractionLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashFractionDowntime.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SlashFractionDoubleSign.Size()
+		i -= size
+		if _, err := m.SlashFractionDoubleSign.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorMissedBlocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorMissedBlocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorMissedBlocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ValID != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ValID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MissedBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MissedBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MissedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Missed {
+		i--
+		if m.Missed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDoubleSign.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionLimit.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.JailFractionLimit.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxEvidenceAge)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.EnableSlashing {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorMissedBlocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValID != 0 {
+		n += 1 + sovSlashing(uint64(m.ValID))
+	}
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MissedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovSlashing(uint64(m.Index))
+	}
+	if m.Missed {
+		n += 2
+	}
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLimit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailFractionLimit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JailFractionLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEvidenceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxEvidenceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableSlashing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableSlashing = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorMissedBlocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorMissedBlocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorMissedBlocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValID", wireType)
+			}
+			m.ValID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValID |= types.ValidatorID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks, MissedBlock{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MissedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MissedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MissedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Missed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlashing(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlashing
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlashing
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlashing
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlashing        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlashing          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlashing = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"github.com/maticnetwork/bor/rlp"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// rlpSlashingInfo is the on-chain layout of a validator slashing info
+type rlpSlashingInfo struct {
+	ID            uint64
+	SlashedAmount uint64
+	IsJailed      bool
+}
+
+// SortAndRLPEncodeSlashInfos sorts slashing infos by validator id and rlp
+// encodes them in the format expected by the slash manager contract
+func SortAndRLPEncodeSlashInfos(slashingInfos []*hmTypes.ValidatorSlashingInfo) ([]byte, error) {
+	sorted := make([]*hmTypes.ValidatorSlashingInfo, len(slashingInfos))
+	copy(sorted, slashingInfos)
+	sorted = hmTypes.SortValidatorSlashingInfoByID(sorted)
+
+	encoded := make([]rlpSlashingInfo, 0, len(sorted))
+	for _, info := range sorted {
+		encoded = append(encoded, rlpSlashingInfo{
+			ID:            info.ID.Uint64(),
+			SlashedAmount: info.SlashedAmount,
+			IsJailed:      info.IsJailed,
+		})
+	}
+
+	return rlp.EncodeToBytes(encoded)
+}
+
+// RLPDecodeSlashInfos decodes rlp encoded slashing infos
+func RLPDecodeSlashInfos(data []byte) ([]*hmTypes.ValidatorSlashingInfo, error) {
+	var decoded []rlpSlashingInfo
+	if err := rlp.DecodeBytes(data, &decoded); err != nil {
+		return nil, err
+	}
+
+	slashingInfos := make([]*hmTypes.ValidatorSlashingInfo, 0, len(decoded))
+	for _, info := range decoded {
+		slashingInfo := hmTypes.NewValidatorSlashingInfo(hmTypes.ValidatorID(info.ID), info.SlashedAmount, info.IsJailed)
+		slashingInfos = append(slashingInfos, &slashingInfo)
+	}
+
+	return slashingInfos, nil
+}
@@ -0,0 +1,20 @@
+package staking
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/x/staking/keeper"
+)
+
+// EndBlocker rotates the validator set, returning the validator updates for
+// tendermint.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	updates, err := k.ApplyAndReturnValidatorSetUpdates(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to update current validator set", "Error", err)
+		return []abci.ValidatorUpdate{}
+	}
+
+	return updates
+}
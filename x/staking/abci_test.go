@@ -0,0 +1,46 @@
+package staking_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maticnetwork/heimdall/app"
+	checkpointSim "github.com/maticnetwork/heimdall/x/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/x/staking"
+	stakingSim "github.com/maticnetwork/heimdall/x/staking/simulation"
+	"github.com/maticnetwork/heimdall/x/staking/test_helper"
+)
+
+// ABCITestSuite integrate test suite context object
+type ABCITestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+func (suite *ABCITestSuite) SetupTest() {
+	suite.app, suite.ctx, _ = test_helper.CreateTestApp(false)
+}
+
+func TestABCITestSuite(t *testing.T) {
+	suite.Run(t, new(ABCITestSuite))
+}
+
+func (suite *ABCITestSuite) TestEndBlockerValidatorUpdates() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	checkpointSim.LoadValidatorSet(4, t, app.StakingKeeper, ctx, false, 10)
+	require.Empty(t, staking.EndBlocker(ctx, app.StakingKeeper))
+
+	validator := stakingSim.GenRandomVal(1, 0, 10, 10, false, 1)[0]
+	require.NoError(t, app.StakingKeeper.AddValidator(ctx, validator))
+
+	updates := staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Len(t, updates, 1)
+	require.Equal(t, validator.VotingPower, updates[0].Power)
+	require.Len(t, app.StakingKeeper.GetValidatorSet(ctx).Validators, 5)
+}
@@ -189,7 +189,7 @@ func (k *Keeper) GetCurrentValidators(ctx sdk.Context) (validators []hmTypes.Val
 func (k *Keeper) GetTotalPower(ctx sdk.Context) (totalPower int64) {
 	k.IterateCurrentValidatorsAndApplyFn(ctx, func(validator *hmTypes.Validator) bool {
 		totalPower += validator.VotingPower
-		return true
+		return false
 	})
 	return
 }
@@ -504,3 +504,10 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	k.paramSubspace.Get(ctx, types.KeyBondDenom, &res)
 	return
 }
+
+// ProposerBonusPercent - share of the collected fees, in percent, paid to the block proposer
+func (k Keeper) ProposerBonusPercent(ctx sdk.Context) (res int64) {
+	res = types.DefaultProposerBonusPercent
+	k.paramSubspace.GetIfExists(ctx, types.ParamStoreKeyProposerBonusPercent, &res)
+	return
+}
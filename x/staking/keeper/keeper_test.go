@@ -340,6 +340,40 @@ func (suite *KeeperTestSuite) TestUpdateValidatorSetChange() {
 
 }
 
+func (suite *KeeperTestSuite) TestApplyAndReturnValidatorSetUpdates() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.StakingKeeper
+
+	// load 4 validators to state
+	checkPointSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	prevValSet := keeper.GetValidatorSet(ctx)
+
+	// no pending changes
+	updates, err := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	// add a new validator
+	valToBeAdded := stakingSim.GenRandomVal(1, 0, 10, 10, false, 1)[0]
+	err = keeper.AddValidator(ctx, valToBeAdded)
+	require.NoError(t, err)
+
+	updates, err = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	require.Equal(t, valToBeAdded.VotingPower, updates[0].Power)
+	require.Equal(t, hmCommonTypes.NewPubKeyFromHex(valToBeAdded.PubKey).TMProtoCryptoPubKey(), updates[0].PubKey)
+
+	currentValSet := keeper.GetValidatorSet(ctx)
+	require.Equal(t, len(prevValSet.Validators)+1, len(currentValSet.Validators), "Number of validators should be increased by 1")
+	require.True(t, currentValSet.HasAddress(valToBeAdded.GetSigner().Bytes()), "New Validator should be added")
+
+	// the set is stored, so the updates are only returned once
+	updates, err = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+}
+
 //
 func (suite *KeeperTestSuite) TestGetCurrentValidators() {
 	t, initApp, ctx := suite.T(), suite.app, suite.ctx
@@ -351,6 +385,19 @@ func (suite *KeeperTestSuite) TestGetCurrentValidators() {
 	require.Equal(t, validators[0], activeValidatorInfo)
 }
 
+func (suite *KeeperTestSuite) TestGetTotalPower() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.StakingKeeper
+	checkPointSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+
+	var totalPower int64
+	for _, validator := range keeper.GetCurrentValidators(ctx) {
+		totalPower += validator.VotingPower
+	}
+	require.Len(t, keeper.GetCurrentValidators(ctx), 4)
+	require.Equal(t, totalPower, keeper.GetTotalPower(ctx))
+}
+
 func (suite *KeeperTestSuite) TestGetCurrentProposer() {
 	t, intiApp, ctx := suite.T(), suite.app, suite.ctx
 	keeper := intiApp.StakingKeeper
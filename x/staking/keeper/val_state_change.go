@@ -3,9 +3,52 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmCommon "github.com/maticnetwork/heimdall/types/common"
 )
 
-// ApplyAndReturnValidatorSetUpdates applys and returns validator set for genutil module
-func (k Keeper) ApplyAndReturnValidatorSetUpdates(sdk.Context) (updates []abci.ValidatorUpdate, err error) {
-	return nil, nil
+// ApplyAndReturnValidatorSetUpdates applies the pending validator changes to the
+// current validator set, stores the new set and returns the updates to be sent
+// to tendermint. It is used by the staking end-blocker and the genutil module.
+func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []abci.ValidatorUpdate, err error) {
+	currentValidatorSet := k.GetValidatorSet(ctx)
+	allValidators := k.GetAllValidators(ctx)
+	ackCount := k.ModuleCommunicator.GetACKCount(ctx)
+
+	// get validator updates
+	setUpdates := helper.GetUpdatedValidators(
+		currentValidatorSet, // pointer to current validator set -- UpdateValidators will modify it
+		allValidators,       // All validators
+		ackCount,            // ack count
+	)
+
+	if len(setUpdates) == 0 {
+		return nil, nil
+	}
+
+	// create new validator set
+	if err := currentValidatorSet.UpdateWithChangeSet(setUpdates); err != nil {
+		return nil, err
+	}
+
+	// increment proposer priority
+	currentValidatorSet.IncrementProposerPriority(1)
+
+	k.Logger(ctx).Debug("Updated current validator set", "proposer", currentValidatorSet.GetProposer())
+
+	// save set in store
+	if err := k.UpdateValidatorSetInStore(ctx, currentValidatorSet); err != nil {
+		return nil, err
+	}
+
+	// convert updates to tendermint validator updates
+	for _, v := range setUpdates {
+		updates = append(updates, abci.ValidatorUpdate{
+			Power:  v.VotingPower,
+			PubKey: hmCommon.NewPubKeyFromHex(v.PubKey).TMProtoCryptoPubKey(),
+		})
+	}
+
+	return updates, nil
 }
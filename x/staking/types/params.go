@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -24,14 +26,14 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 func validateProposerBonusPercent(i interface{}) error {
-	// v, ok := i.(sdk.Coin)
-	// if !ok {
-	// 	return fmt.Errorf("invalid parameter type: %T", i)
-	// }
-
-	// if !v.IsValid() {
-	// 	return fmt.Errorf("invalid constant fee: %s", v)
-	// }
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 || v > 100 {
+		return fmt.Errorf("proposer bonus percent must be between 0 and 100: %d", v)
+	}
 
 	return nil
 }
@@ -0,0 +1,23 @@
+package topup
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/x/topup/keeper"
+)
+
+// BeginBlocker distributes the fees collected in the previous block between its
+// proposer and signers, and records the proposer of the current block.
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
+	// the previous proposer is only unknown at the first block
+	if previousProposer, ok := k.ChainKeeper.GetBlockProposer(ctx); ok {
+		k.AllocateTokens(ctx, previousProposer, req.LastCommitInfo.GetVotes())
+	}
+
+	if len(req.Header.ProposerAddress) != 0 {
+		k.ChainKeeper.SetBlockProposer(ctx, sdk.AccAddress(req.Header.ProposerAddress))
+	} else {
+		k.ChainKeeper.RemoveBlockProposer(ctx)
+	}
+}
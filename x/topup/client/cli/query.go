@@ -3,9 +3,12 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
 	"github.com/maticnetwork/heimdall/x/topup/types"
@@ -24,6 +27,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(
 		GetSequenceCmd(),
+		GetCmdQueryValidatorRewards(),
+		GetCmdQueryAllValidatorRewards(),
+		GetCmdQueryDividendAccountProof(),
 	)
 
 	return cmd
@@ -77,3 +83,114 @@ func GetSequenceCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryValidatorRewards implements the validator rewards query command.
+func GetCmdQueryValidatorRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-rewards [validator-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show fee rewards accrued by a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fee rewards credited to the dividend account of a validator.
+
+Example:
+$ %s query topup validator-rewards 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			valID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid validator id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorRewards(context.Background(), &types.QueryValidatorRewardsRequest{ValId: valID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.ValidatorRewards)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAllValidatorRewards implements the all validator rewards query command.
+func GetCmdQueryAllValidatorRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-validator-rewards",
+		Args:  cobra.NoArgs,
+		Short: "show fee rewards accrued by all validators",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllValidatorRewards(context.Background(), &types.QueryAllValidatorRewardsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDividendAccountProof implements the dividend account proof query command.
+func GetCmdQueryDividendAccountProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dividend-account-proof [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show merkle proof of a dividend account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the merkle proof of a dividend account against the dividend account root,
+to be submitted with claimFee on the root chain.
+
+Example:
+$ %s query topup dividend-account-proof 0x6c468cf8c9879006e22ec4029696e005c2319c9d
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryDividendAccountProof(context.Background(), &types.QueryDividendAccountProofRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Proof)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -20,6 +20,8 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	// new chains hash the dividend accounts as the root chain does
+	k.SetABIAccountLeaves(ctx)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/topup/types"
+)
+
+// GetValidatorRewardsKey returns the key of the rewards accrued by a validator
+func GetValidatorRewardsKey(valID hmTypes.ValidatorID) []byte {
+	return append(ValidatorRewardsKey, valID.Bytes()...)
+}
+
+// AllocateTokens distributes the fees collected in the previous block. The
+// proposer of that block receives the proposer bonus and the remaining fees
+// are split between the validators who signed it, proportional to their voting
+// power. The rewards are credited to the signer's dividend account.
+func (k Keeper) AllocateTokens(ctx sdk.Context, previousProposer sdk.AccAddress, votes []abci.VoteInfo) {
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	feesCollected := k.Bk.GetBalance(ctx, feeCollector, types.FeeToken).Amount
+	if !feesCollected.IsPositive() {
+		return
+	}
+
+	// fees are tracked in the dividend accounts from now on
+	if err := k.Bk.SubtractCoins(ctx, feeCollector, sdk.NewCoins(sdk.NewCoin(types.FeeToken, feesCollected))); err != nil {
+		k.Logger(ctx).Error("AllocateTokens | SubtractCoins", "error", err)
+		return
+	}
+
+	totalSigningPower := int64(0)
+	for _, vote := range votes {
+		if vote.SignedLastBlock {
+			totalSigningPower += vote.Validator.Power
+		}
+	}
+
+	// without signers the proposer receives all the fees
+	proposerReward := feesCollected
+	if totalSigningPower > 0 {
+		proposerReward = feesCollected.MulRaw(k.sk.ProposerBonusPercent(ctx)).QuoRaw(100)
+	}
+
+	remaining := feesCollected.Sub(proposerReward)
+	distributed := sdk.ZeroInt()
+	for _, vote := range votes {
+		if !vote.SignedLastBlock || totalSigningPower == 0 {
+			continue
+		}
+
+		reward := remaining.MulRaw(vote.Validator.Power).QuoRaw(totalSigningPower)
+		k.allocateToSigner(ctx, vote.Validator.Address, reward)
+		distributed = distributed.Add(reward)
+	}
+
+	// truncation dust goes to the proposer
+	proposerReward = proposerReward.Add(remaining.Sub(distributed))
+	k.allocateToSigner(ctx, previousProposer, proposerReward)
+}
+
+// allocateToSigner credits the reward to the dividend account of the signer
+// and to the rewards of its validator
+func (k Keeper) allocateToSigner(ctx sdk.Context, signer sdk.AccAddress, reward sdk.Int) {
+	if !reward.IsPositive() {
+		return
+	}
+
+	if err := k.AddFeeToDividendAccount(ctx, signer, reward.BigInt()); err != nil {
+		k.Logger(ctx).Error("allocateToSigner | AddFeeToDividendAccount", "signer", signer.String(), "error", err)
+		return
+	}
+
+	validator, err := k.sk.GetValidatorInfo(ctx, signer)
+	if err != nil {
+		k.Logger(ctx).Debug("Fee reward for unknown validator signer", "signer", signer.String())
+		return
+	}
+
+	k.SetValidatorRewards(ctx, validator.ID, k.GetValidatorRewards(ctx, validator.ID).Add(reward))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeReward,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyUser, signer.String()),
+			sdk.NewAttribute(types.AttributeKeyRewardAmount, reward.String()),
+		),
+	)
+}
+
+// GetValidatorRewards returns the fee rewards accrued by the validator
+func (k Keeper) GetValidatorRewards(ctx sdk.Context, valID hmTypes.ValidatorID) sdk.Int {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(GetValidatorRewardsKey(valID))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var rewards sdk.Int
+	if err := rewards.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return rewards
+}
+
+// SetValidatorRewards sets the fee rewards accrued by the validator
+func (k Keeper) SetValidatorRewards(ctx sdk.Context, valID hmTypes.ValidatorID, rewards sdk.Int) {
+	store := ctx.KVStore(k.key)
+	bz, err := rewards.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(GetValidatorRewardsKey(valID), bz)
+}
+
+// IterateValidatorRewards iterates over the rewards of all validators
+func (k Keeper) IterateValidatorRewards(ctx sdk.Context, f func(rewards types.ValidatorRewards) (stop bool)) {
+	store := ctx.KVStore(k.key)
+
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorRewardsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rewards sdk.Int
+		if err := rewards.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		valID, err := strconv.ParseUint(string(iterator.Key()[len(ValidatorRewardsKey):]), 10, 64)
+		if err != nil {
+			panic(err)
+		}
+
+		if f(types.ValidatorRewards{ValId: valID, Rewards: rewards}) {
+			return
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	checkpointSim "github.com/maticnetwork/heimdall/x/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/x/topup/keeper"
+	"github.com/maticnetwork/heimdall/x/topup/types"
+)
+
+func (suite *KeeperTestSuite) TestAllocateTokens() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+
+	valSet := checkpointSim.LoadValidatorSet(3, t, initApp.StakingKeeper, ctx, false, 10)
+	validators := valSet.Validators
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	err := initApp.BankKeeper.SetBalances(ctx, feeCollector, sdk.NewCoins(sdk.NewInt64Coin(types.FeeToken, 1000)))
+	require.NoError(t, err)
+
+	// the last validator did not sign the block
+	votes := make([]abci.VoteInfo, len(validators))
+	for i, validator := range validators {
+		votes[i] = abci.VoteInfo{
+			Validator:       abci.Validator{Address: validator.GetSigner(), Power: validator.VotingPower},
+			SignedLastBlock: i != len(validators)-1,
+		}
+	}
+
+	proposer := validators[0]
+	initApp.TopupKeeper.AllocateTokens(ctx, proposer.GetSigner(), votes)
+
+	// 10% proposer bonus, the remaining 900 split between two signers of equal power
+	expected := []int64{550, 450, 0}
+	for i, validator := range validators {
+		require.Equal(t, sdk.NewInt(expected[i]), initApp.TopupKeeper.GetValidatorRewards(ctx, validator.ID))
+
+		dividendAccount, err := initApp.TopupKeeper.GetDividendAccountByAddress(ctx, validator.GetSigner())
+		if expected[i] == 0 {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt(expected[i]).String(), dividendAccount.FeeAmount)
+	}
+
+	require.True(t, initApp.BankKeeper.GetBalance(ctx, feeCollector, types.FeeToken).IsZero())
+
+	// nothing is allocated once the fees are distributed
+	initApp.TopupKeeper.AllocateTokens(ctx, proposer.GetSigner(), votes)
+	require.Equal(t, sdk.NewInt(550), initApp.TopupKeeper.GetValidatorRewards(ctx, proposer.ID))
+}
+
+func (suite *KeeperTestSuite) TestAllocateTokensWithoutSigners() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+
+	valSet := checkpointSim.LoadValidatorSet(2, t, initApp.StakingKeeper, ctx, false, 10)
+	proposer := valSet.Validators[0]
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	err := initApp.BankKeeper.SetBalances(ctx, feeCollector, sdk.NewCoins(sdk.NewInt64Coin(types.FeeToken, 1001)))
+	require.NoError(t, err)
+
+	initApp.TopupKeeper.AllocateTokens(ctx, proposer.GetSigner(), nil)
+	require.Equal(t, sdk.NewInt(1001), initApp.TopupKeeper.GetValidatorRewards(ctx, proposer.ID))
+}
+
+func (suite *KeeperTestSuite) TestQueryValidatorRewards() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	querier := keeper.NewQueryServerImpl(initApp.TopupKeeper, &suite.contractCaller)
+
+	valSet := checkpointSim.LoadValidatorSet(2, t, initApp.StakingKeeper, ctx, false, 10)
+	validator := valSet.Validators[0]
+	initApp.TopupKeeper.SetValidatorRewards(ctx, validator.ID, sdk.NewInt(42))
+
+	res, err := querier.ValidatorRewards(sdk.WrapSDKContext(ctx), &types.QueryValidatorRewardsRequest{ValId: validator.ID.Uint64()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(42), res.ValidatorRewards.Rewards)
+
+	res, err = querier.ValidatorRewards(sdk.WrapSDKContext(ctx), &types.QueryValidatorRewardsRequest{ValId: valSet.Validators[1].ID.Uint64()})
+	require.NoError(t, err)
+	require.True(t, res.ValidatorRewards.Rewards.IsZero())
+
+	all, err := querier.AllValidatorRewards(context.Background(), nil)
+	require.Error(t, err)
+	require.Nil(t, all)
+
+	all, err = querier.AllValidatorRewards(sdk.WrapSDKContext(ctx), &types.QueryAllValidatorRewardsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ValidatorRewards{{ValId: validator.ID.Uint64(), Rewards: sdk.NewInt(42)}}, all.ValidatorRewards)
+}
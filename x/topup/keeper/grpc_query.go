@@ -10,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/helper"
+	hmValTypes "github.com/maticnetwork/heimdall/types"
 	hmTypes "github.com/maticnetwork/heimdall/types/common"
 	"github.com/maticnetwork/heimdall/x/topup/types"
 	"google.golang.org/grpc/codes"
@@ -82,8 +83,7 @@ func (k Querier) QueryDividendAccountRoot(c context.Context, req *types.QueryDiv
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	dividendAccounts := k.GetAllDividendAccounts(ctx)
-	accountRoot, err := checkpointTypes.GetAccountRootHash(dividendAccounts)
+	accountRoot, err := k.GetDividendAccountRootHash(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "could not fetch accountroothash")
 	}
@@ -127,3 +127,82 @@ func (k Querier) QueryDividendAccount(c context.Context, req *types.QueryDividen
 		DividendAccount: &dividendAccount,
 	}, nil
 }
+
+// QueryDividendAccountProof will return the merkle proof of the dividend account with given addr
+func (k Querier) QueryDividendAccountProof(c context.Context, req *types.QueryDividendAccountProofRequest) (*types.QueryDividendAccountProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	addr, err := sdk.AccAddressFromHex(req.GetAddress())
+	if err != nil || addr.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "invalid address format")
+	}
+
+	// the root chain can only verify proofs of the leaves in its encoding
+	if !k.HasABIAccountLeaves(ctx) {
+		return nil, status.Error(codes.FailedPrecondition, "dividend account leaves don't match the root chain encoding yet")
+	}
+
+	dividendAccount, err := k.GetDividendAccountByAddress(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "dividend account not found")
+	}
+
+	dividendAccounts := k.GetAllDividendAccounts(ctx)
+	accountRoot, err := checkpointTypes.GetAccountRootHash(dividendAccounts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not fetch accountroothash")
+	}
+
+	accountProof, index, err := checkpointTypes.GetAccountProof(dividendAccounts, addr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not fetch account proof")
+	}
+
+	siblings := make([]string, 0, len(accountProof)/32)
+	for i := 0; i+32 <= len(accountProof); i += 32 {
+		siblings = append(siblings, hmTypes.BytesToHeimdallHash(accountProof[i:i+32]).String())
+	}
+
+	return &types.QueryDividendAccountProofResponse{
+		Proof: types.DividendAccountProof{
+			DividendAccount: dividendAccount,
+			Index:           index,
+			Leaf:            hmValTypes.HexBytes(dividendAccount.LeafBytes()).String(),
+			Siblings:        siblings,
+			AccountProof:    hmValTypes.HexBytes(accountProof).String(),
+			AccountRootHash: hmTypes.BytesToHeimdallHash(accountRoot).String(),
+		},
+	}, nil
+}
+
+// ValidatorRewards will return the fee rewards accrued by the given validator
+func (k Querier) ValidatorRewards(c context.Context, req *types.QueryValidatorRewardsRequest) (*types.QueryValidatorRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rewards := k.GetValidatorRewards(ctx, hmValTypes.ValidatorID(req.GetValId()))
+	return &types.QueryValidatorRewardsResponse{
+		ValidatorRewards: types.ValidatorRewards{ValId: req.GetValId(), Rewards: rewards},
+	}, nil
+}
+
+// AllValidatorRewards will return the fee rewards accrued by all validators
+func (k Querier) AllValidatorRewards(c context.Context, req *types.QueryAllValidatorRewardsRequest) (*types.QueryAllValidatorRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	validatorRewards := []types.ValidatorRewards{}
+	k.IterateValidatorRewards(ctx, func(rewards types.ValidatorRewards) bool {
+		validatorRewards = append(validatorRewards, rewards)
+		return false
+	})
+
+	return &types.QueryAllValidatorRewardsResponse{ValidatorRewards: validatorRewards}, nil
+}
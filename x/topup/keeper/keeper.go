@@ -12,6 +12,7 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	chainKeeper "github.com/maticnetwork/heimdall/x/chainmanager/keeper"
+	checkpointTypes "github.com/maticnetwork/heimdall/x/checkpoint/types"
 	stakingKeeper "github.com/maticnetwork/heimdall/x/staking/keeper"
 	"github.com/maticnetwork/heimdall/x/topup/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -24,6 +25,8 @@ var (
 	TopupSequencePrefixKey = []byte{0x81}
 
 	DividendAccountMapKey = []byte{0x82} // prefix for each key for Dividend Account Map
+	ValidatorRewardsKey   = []byte{0x83} // prefix for each key for fee rewards accrued by a validator
+	ABIAccountLeavesKey   = []byte{0x84} // key to store if the dividend account leaves match the root chain claimFee encoding
 )
 
 // Keeper stores all related data
@@ -175,6 +178,31 @@ func (k *Keeper) GetAllDividendAccounts(ctx sdk.Context) (dividendAccounts []*hm
 	return
 }
 
+// SetABIAccountLeaves switches the leaves of the dividend account tree to the root chain
+// claimFee encoding, this changes the account root hash of the checkpoints
+func (k *Keeper) SetABIAccountLeaves(ctx sdk.Context) {
+	store := ctx.KVStore(k.key)
+	store.Set(ABIAccountLeavesKey, DefaultValue)
+}
+
+// HasABIAccountLeaves checks if the leaves of the dividend account tree match the root
+// chain claimFee encoding
+func (k *Keeper) HasABIAccountLeaves(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.key)
+	return store.Has(ABIAccountLeavesKey)
+}
+
+// GetDividendAccountRootHash returns the root hash of the dividend account tree, its
+// leaves keep the legacy encoding until they're switched to the root chain one
+func (k *Keeper) GetDividendAccountRootHash(ctx sdk.Context) ([]byte, error) {
+	dividendAccounts := k.GetAllDividendAccounts(ctx)
+	if !k.HasABIAccountLeaves(ctx) {
+		return checkpointTypes.GetLegacyAccountRootHash(dividendAccounts)
+	}
+
+	return checkpointTypes.GetAccountRootHash(dividendAccounts)
+}
+
 // AddFeeToDividendAccount adds fee to dividend account for withdrawal
 func (k *Keeper) AddFeeToDividendAccount(ctx sdk.Context, userAddress sdk.AccAddress, fee *big.Int) error {
 	// Get or create dividend account
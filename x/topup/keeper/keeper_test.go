@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 
 	"github.com/maticnetwork/heimdall/x/topup/test_helper"
@@ -14,8 +15,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
 	"github.com/maticnetwork/heimdall/types/simulation"
 	checkpointTypes "github.com/maticnetwork/heimdall/x/checkpoint/types"
+	"github.com/maticnetwork/heimdall/x/topup/keeper"
+	"github.com/maticnetwork/heimdall/x/topup/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -107,3 +111,84 @@ func (suite *KeeperTestSuite) TestDividendAccountTree() {
 	require.NotNil(t, leafHash)
 	require.NoError(t, err)
 }
+
+func (suite *KeeperTestSuite) TestDividendAccountProof() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	querier := keeper.NewQueryServerImpl(initApp.TopupKeeper, &suite.contractCaller)
+
+	accounts := simulation.RandomAccounts(rand.New(rand.NewSource(1)), 5)
+	for i, account := range accounts {
+		err := initApp.TopupKeeper.AddFeeToDividendAccount(ctx, account.Address, big.NewInt(int64(i+1)*1000))
+		require.NoError(t, err)
+	}
+
+	for _, account := range accounts {
+		res, err := querier.QueryDividendAccountProof(sdk.WrapSDKContext(ctx), &types.QueryDividendAccountProofRequest{Address: account.Address.String()})
+		require.NoError(t, err)
+
+		proof := res.Proof
+		require.Len(t, proof.Siblings, 3)
+		require.Equal(t, hmTypes.HexBytes(proof.DividendAccount.LeafBytes()).String(), proof.Leaf)
+
+		// leaf is abi.encode(user, accumFeeAmount)
+		leaf := common.FromHex(proof.Leaf)
+		require.Len(t, leaf, 64)
+		require.Equal(t, account.Address.Bytes(), leaf[12:32])
+
+		ok, err := checkpointTypes.VerifyAccountProof(common.FromHex(proof.AccountRootHash), proof.DividendAccount, proof.Index, common.FromHex(proof.AccountProof))
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		tampered := proof.DividendAccount
+		tampered.FeeAmount = "1"
+		ok, err = checkpointTypes.VerifyAccountProof(common.FromHex(proof.AccountRootHash), tampered, proof.Index, common.FromHex(proof.AccountProof))
+		require.NoError(t, err)
+		require.False(t, ok)
+	}
+
+	_, err := querier.QueryDividendAccountProof(sdk.WrapSDKContext(ctx), &types.QueryDividendAccountProofRequest{Address: "0x0000000000000000000000000000000000000001"})
+	require.Error(t, err)
+}
+
+func (suite *KeeperTestSuite) TestDividendAccountRootHashLeaves() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	tk := initApp.TopupKeeper
+	querier := keeper.NewQueryServerImpl(tk, &suite.contractCaller)
+
+	accounts := simulation.RandomAccounts(rand.New(rand.NewSource(1)), 3)
+	for i, account := range accounts {
+		err := tk.AddFeeToDividendAccount(ctx, account.Address, big.NewInt(int64(i+1)*1000))
+		require.NoError(t, err)
+	}
+
+	abiRoot, err := checkpointTypes.GetAccountRootHash(tk.GetAllDividendAccounts(ctx))
+	require.NoError(t, err)
+	legacyRoot, err := checkpointTypes.GetLegacyAccountRootHash(tk.GetAllDividendAccounts(ctx))
+	require.NoError(t, err)
+	require.NotEqual(t, abiRoot, legacyRoot)
+
+	// genesis switches new chains to the root chain encoding
+	require.True(t, tk.HasABIAccountLeaves(ctx))
+	root, err := tk.GetDividendAccountRootHash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, abiRoot, root)
+
+	// chains started before keep the legacy encoding until the upgrade
+	ctx.KVStore(initApp.GetKey(types.StoreKey)).Delete(keeper.ABIAccountLeavesKey)
+	require.False(t, tk.HasABIAccountLeaves(ctx))
+	root, err = tk.GetDividendAccountRootHash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, legacyRoot, root)
+
+	res, err := querier.QueryDividendAccountRoot(sdk.WrapSDKContext(ctx), &types.QueryDividendAccountRootRequest{})
+	require.NoError(t, err)
+	require.Equal(t, hmCommonTypes.BytesToHeimdallHash(legacyRoot).String(), res.AccountRootHash)
+
+	_, err = querier.QueryDividendAccountProof(sdk.WrapSDKContext(ctx), &types.QueryDividendAccountProofRequest{Address: accounts[0].Address.String()})
+	require.Error(t, err)
+
+	tk.SetABIAccountLeaves(ctx)
+	root, err = tk.GetDividendAccountRootHash(ctx)
+	require.NoError(t, err)
+	require.Equal(t, abiRoot, root)
+}
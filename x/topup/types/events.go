@@ -5,12 +5,15 @@ const (
 	EventTypeTopup       = "topup"
 	EventTypeFeeWithdraw = "fee-withdraw"
 	EventTypeTransfer    = "transfer"
+	EventTypeFeeReward   = "fee-reward"
 
 	AttributeKeyRecipient         = "recipient"
 	AttributeKeySender            = "sender"
 	AttributeKeyUser              = "user"
 	AttributeKeyTopupAmount       = "topup-amount"
 	AttributeKeyFeeWithdrawAmount = "fee-withdraw-amount"
+	AttributeKeyValidatorID       = "validator-id"
+	AttributeKeyRewardAmount      = "reward-amount"
 
 	AttributeValueCategory = ModuleName
 )
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -475,6 +476,401 @@ func (m *QueryDividendAccountResponse) GetDividendAccount() *types.DividendAccou
 	return nil
 }
 
+// DividendAccountProof defines the merkle proof of a dividend account, as
+// verified by claimFee on the root chain
+type DividendAccountProof struct {
+	DividendAccount types.DividendAccount `protobuf:"bytes,1,opt,name=dividend_account,json=dividendAccount,proto3" json:"dividend_account"`
+	// index of the account leaf in the account tree
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// hex encoded leaf, abi.encode(user, accumFeeAmount)
+	Leaf string `protobuf:"bytes,3,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	// hex encoded sibling hashes from the leaf up to the root
+	Siblings []string `protobuf:"bytes,4,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	// hex encoded concatenation of the sibling hashes
+	AccountProof    string `protobuf:"bytes,5,opt,name=account_proof,json=accountProof,proto3" json:"account_proof,omitempty"`
+	AccountRootHash string `protobuf:"bytes,6,opt,name=account_root_hash,json=accountRootHash,proto3" json:"account_root_hash,omitempty"`
+}
+
+func (m *DividendAccountProof) Reset()         { *m = DividendAccountProof{} }
+func (m *DividendAccountProof) String() string { return proto.CompactTextString(m) }
+func (*DividendAccountProof) ProtoMessage()    {}
+func (*DividendAccountProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc062043e57c0b9, []int{10}
+}
+func (m *DividendAccountProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DividendAccountProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DividendAccountProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DividendAccountProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DividendAccountProof.Merge(m, src)
+}
+func (m *DividendAccountProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *DividendAccountProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_DividendAccountProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DividendAccountProof proto.InternalMessageInfo
+
+func (m *DividendAccountProof) GetDividendAccount() types.DividendAccount {
+	if m != nil {
+		return m.DividendAccount
+	}
+	return types.DividendAccount{}
+}
+
+func (m *DividendAccountProof) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DividendAccountProof) GetLeaf() string {
+	if m != nil {
+		return m.Leaf
+	}
+	return ""
+}
+
+func (m *DividendAccountProof) GetSiblings() []string {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
+func (m *DividendAccountProof) GetAccountProof() string {
+	if m != nil {
+		return m.AccountProof
+	}
+	return ""
+}
+
+func (m *DividendAccountProof) GetAccountRootHash() string {
+	if m != nil {
+		return m.AccountRootHash
+	}
+	return ""
+}
+
+// QueryDividendAccountProofRequest request for query dividend account proof
+type QueryDividendAccountProofRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryDividendAccountProofRequest) Reset()         { *m = QueryDividendAccountProofRequest{} }
+func (m *QueryDividendAccountProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDividendAccountProofRequest) ProtoMessage()    {}
+func (*QueryDividendAccountProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc062043e57c0b9, []int{11}
+}
+func (m *QueryDividendAccountProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDividendAccountProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDividendAccountProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDividendAccountProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDividendAccountProofRequest.Merge(m, src)
+}
+func (m *QueryDividendAccountProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDividendAccountProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDividendAccountProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDividendAccountProofRequest proto.InternalMessageInfo
+
+func (m *QueryDividendAccountProofRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryDividendAccountProofResponse struct {
+	Proof DividendAccountProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
+}
+
+func (m *QueryDividendAccountProofResponse) Reset()         { *m = QueryDividendAccountProofResponse{} }
+func (m *QueryDividendAccountProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDividendAccountProofResponse) ProtoMessage()    {}
+func (*QueryDividendAccountProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc062043e57c0b9, []int{12}
+}
+func (m *QueryDividendAccountProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDividendAccountProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDividendAccountProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDividendAccountProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDividendAccountProofResponse.Merge(m, src)
+}
+func (m *QueryDividendAccountProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDividendAccountProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDividendAccountProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDividendAccountProofResponse proto.InternalMessageInfo
+
+func (m *QueryDividendAccountProofResponse) GetProof() DividendAccountProof {
+	if m != nil {
+		return m.Proof
+	}
+	return DividendAccountProof{}
+}
+
+// ValidatorRewards defines the fee rewards accrued by a validator
+type ValidatorRewards struct {
+	ValId   uint64                                 `protobuf:"varint,1,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`
+	Rewards github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=rewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"rewards"`
+}
+
+func (m *ValidatorRewards) Reset()         { *m = ValidatorRewards{} }
+func (m *ValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewards) ProtoMessage()    {}
+func (*ValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc062043e57c0b9, []int{13}
+}
+func (m *ValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewards.Merge(m, src)
+}
+func (m *ValidatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewards proto.InternalMessageInfo
+
+func (m *ValidatorRewards) GetValId() uint64 {
+	if m != nil {
+		return m.ValId
+	}
+	return 0
+}
+
+// QueryValidatorRewardsRequest request for query validator rewards
+type QueryValidatorRewardsRequest struct {
+	ValId uint64 `protobuf:"varint,1,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`
+}
+
+func (m *QueryValidatorRewardsRequest) Reset()         { *m = QueryValidatorRewardsRequest{} }
+func (m *QueryValidatorRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRewardsRequest) ProtoMessage()    {}
+func (*QueryValidatorRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc062043e57c0b9, []int{14}
+}
+func (m *QueryValidatorRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRewardsRequest.Merge(m, src)
+}
+func (m *QueryValidatorRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorRewardsRequest) GetValId() uint64 {
+	if m != nil {
+		return m.ValId
+	}
+	return 0
+}
+
+type QueryValidatorRewardsResponse struct {
+	ValidatorRewards ValidatorRewards `protobuf:"bytes,1,opt,name=validator_rewards,json=validatorRewards,proto3" json:"validator_rewards"`
+}
+
+func (m *QueryValidatorRewardsResponse) Reset()         { *m = QueryValidatorRewardsResponse{} }
+func (m *QueryValidatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRewardsResponse) ProtoMessage()    {}
+func (*QueryValidatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc062043e57c0b9, []int{15}
+}
+func (m *QueryValidatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRewardsResponse.Merge(m, src)
+}
+func (m *QueryValidatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorRewardsResponse) GetValidatorRewards() ValidatorRewards {
+	if m != nil {
+		return m.ValidatorRewards
+	}
+	return ValidatorRewards{}
+}
+
+// QueryAllValidatorRewardsRequest request for query rewards of all validators
+type QueryAllValidatorRewardsRequest struct {
+}
+
+func (m *QueryAllValidatorRewardsRequest) Reset()         { *m = QueryAllValidatorRewardsRequest{} }
+func (m *QueryAllValidatorRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllValidatorRewardsRequest) ProtoMessage()    {}
+func (*QueryAllValidatorRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc062043e57c0b9, []int{16}
+}
+func (m *QueryAllValidatorRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllValidatorRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllValidatorRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllValidatorRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllValidatorRewardsRequest.Merge(m, src)
+}
+func (m *QueryAllValidatorRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllValidatorRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllValidatorRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllValidatorRewardsRequest proto.InternalMessageInfo
+
+type QueryAllValidatorRewardsResponse struct {
+	ValidatorRewards []ValidatorRewards `protobuf:"bytes,1,rep,name=validator_rewards,json=validatorRewards,proto3" json:"validator_rewards"`
+}
+
+func (m *QueryAllValidatorRewardsResponse) Reset()         { *m = QueryAllValidatorRewardsResponse{} }
+func (m *QueryAllValidatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllValidatorRewardsResponse) ProtoMessage()    {}
+func (*QueryAllValidatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc062043e57c0b9, []int{17}
+}
+func (m *QueryAllValidatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllValidatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllValidatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllValidatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllValidatorRewardsResponse.Merge(m, src)
+}
+func (m *QueryAllValidatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllValidatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllValidatorRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllValidatorRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryAllValidatorRewardsResponse) GetValidatorRewards() []ValidatorRewards {
+	if m != nil {
+		return m.ValidatorRewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuerySequenceRequest)(nil), "heimdall.topup.v1beta1.QuerySequenceRequest")
 	proto.RegisterType((*QuerySequenceResponse)(nil), "heimdall.topup.v1beta1.QuerySequenceResponse")
@@ -486,6 +882,14 @@ func init() {
 	proto.RegisterType((*QueryDividendAccountsResponse)(nil), "heimdall.topup.v1beta1.QueryDividendAccountsResponse")
 	proto.RegisterType((*QueryDividendAccountRequest)(nil), "heimdall.topup.v1beta1.QueryDividendAccountRequest")
 	proto.RegisterType((*QueryDividendAccountResponse)(nil), "heimdall.topup.v1beta1.QueryDividendAccountResponse")
+	proto.RegisterType((*DividendAccountProof)(nil), "heimdall.topup.v1beta1.DividendAccountProof")
+	proto.RegisterType((*QueryDividendAccountProofRequest)(nil), "heimdall.topup.v1beta1.QueryDividendAccountProofRequest")
+	proto.RegisterType((*QueryDividendAccountProofResponse)(nil), "heimdall.topup.v1beta1.QueryDividendAccountProofResponse")
+	proto.RegisterType((*ValidatorRewards)(nil), "heimdall.topup.v1beta1.ValidatorRewards")
+	proto.RegisterType((*QueryValidatorRewardsRequest)(nil), "heimdall.topup.v1beta1.QueryValidatorRewardsRequest")
+	proto.RegisterType((*QueryValidatorRewardsResponse)(nil), "heimdall.topup.v1beta1.QueryValidatorRewardsResponse")
+	proto.RegisterType((*QueryAllValidatorRewardsRequest)(nil), "heimdall.topup.v1beta1.QueryAllValidatorRewardsRequest")
+	proto.RegisterType((*QueryAllValidatorRewardsResponse)(nil), "heimdall.topup.v1beta1.QueryAllValidatorRewardsResponse")
 }
 
 func init() {
@@ -493,47 +897,67 @@ func init() {
 }
 
 var fileDescriptor_4fc062043e57c0b9 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0xeb, 0xfe, 0xfa, 0x4b, 0xd2, 0x63, 0x68, 0x7b, 0x2a, 0x25, 0x72, 0x8b, 0x93, 0x5a,
-	0x48, 0x84, 0x3f, 0xb1, 0x95, 0x3f, 0xa5, 0x88, 0x0d, 0xc4, 0xd0, 0xa2, 0x0a, 0x84, 0xcb, 0xc4,
-	0x12, 0x5d, 0xe2, 0x93, 0x63, 0x70, 0x7c, 0x6e, 0xee, 0x5c, 0x5c, 0x21, 0x16, 0x5e, 0x01, 0x88,
-	0x99, 0xd7, 0xc1, 0xc4, 0xc6, 0xc0, 0x58, 0x89, 0x85, 0x11, 0x25, 0xbc, 0x0c, 0x06, 0xe4, 0xcb,
-	0xe3, 0x04, 0x59, 0x76, 0x49, 0x24, 0xa6, 0xf8, 0x2e, 0xcf, 0xf3, 0xfd, 0x7e, 0x2e, 0xf7, 0x7c,
-	0x1d, 0xa4, 0xf7, 0xa9, 0x3b, 0xb0, 0x89, 0xe7, 0x99, 0x82, 0x05, 0x61, 0x60, 0x9e, 0x36, 0xba,
-	0x54, 0x90, 0x86, 0x79, 0x12, 0xd2, 0xe1, 0x99, 0x11, 0x0c, 0x99, 0x60, 0x78, 0x2b, 0xa9, 0x31,
-	0x64, 0x8d, 0x01, 0x35, 0xea, 0x8e, 0xc3, 0x98, 0xe3, 0x51, 0x93, 0x04, 0xae, 0x49, 0x7c, 0x9f,
-	0x09, 0x22, 0x5c, 0xe6, 0xf3, 0x49, 0x97, 0xba, 0xe9, 0x30, 0x87, 0xc9, 0x47, 0x33, 0x7e, 0x82,
-	0xdd, 0x6b, 0x53, 0xbf, 0x2e, 0xe1, 0x74, 0x6a, 0x67, 0xbb, 0xa7, 0xae, 0x4d, 0x7d, 0x31, 0xa9,
-	0xd2, 0x8f, 0xd0, 0xe6, 0xd3, 0x18, 0xe0, 0x98, 0x9e, 0x84, 0xd4, 0xef, 0x51, 0x2b, 0xfe, 0xe4,
-	0x02, 0x5f, 0x41, 0x45, 0x11, 0x75, 0xfa, 0x84, 0xf7, 0xcb, 0x4a, 0x55, 0xa9, 0xad, 0x5a, 0x05,
-	0x11, 0x1d, 0x10, 0xde, 0xc7, 0xdb, 0x68, 0xd5, 0x63, 0x4e, 0xc7, 0xf5, 0x6d, 0x1a, 0x95, 0x97,
-	0xab, 0x4a, 0x6d, 0xc5, 0x2a, 0x79, 0xcc, 0x39, 0x8c, 0xd7, 0x7a, 0x0b, 0x5d, 0x4e, 0xa9, 0xf1,
-	0x80, 0xf9, 0x9c, 0x62, 0x15, 0x95, 0x38, 0xec, 0x49, 0xbd, 0x15, 0x6b, 0xba, 0xd6, 0x8f, 0xd1,
-	0xb6, 0x6c, 0x3a, 0xe4, 0x4f, 0x3c, 0xfb, 0x59, 0xf4, 0x6f, 0x48, 0xee, 0xa0, 0x9d, 0x6c, 0x51,
-	0x00, 0xda, 0x42, 0x05, 0x2e, 0x88, 0x08, 0xb9, 0x14, 0x2d, 0x59, 0xb0, 0xd2, 0x77, 0x51, 0x45,
-	0xf6, 0x3d, 0x9c, 0xfc, 0x4c, 0xf6, 0xfd, 0x5e, 0x8f, 0x85, 0xbe, 0xb0, 0x18, 0x13, 0x00, 0xa4,
-	0x3f, 0x46, 0xd5, 0xfc, 0x12, 0x90, 0xbf, 0x89, 0x36, 0xc8, 0x64, 0xbb, 0x33, 0x64, 0x4c, 0xfc,
-	0x89, 0xbf, 0x46, 0x66, 0xf5, 0xf1, 0x39, 0x74, 0x0d, 0x50, 0x53, 0x7a, 0x3c, 0xf1, 0x1b, 0xa0,
-	0xab, 0x39, 0xdf, 0x83, 0xd9, 0x11, 0xda, 0x80, 0x5b, 0xb5, 0x3b, 0x20, 0x1e, 0x1f, 0xeb, 0xbf,
-	0xda, 0xa5, 0x66, 0xc5, 0x98, 0x4d, 0xd4, 0x59, 0x40, 0xb9, 0x91, 0x86, 0x5e, 0xb7, 0x53, 0xaa,
-	0xfa, 0x3e, 0x5c, 0x47, 0xba, 0x12, 0xae, 0xa3, 0x8c, 0x8a, 0xc4, 0xb6, 0x87, 0x94, 0x73, 0x38,
-	0x4f, 0xb2, 0xd4, 0x5f, 0x64, 0x9f, 0x63, 0x8a, 0xf9, 0x08, 0xad, 0xa7, 0x31, 0xa5, 0xc4, 0x1c,
-	0x94, 0x6b, 0x29, 0xca, 0xe6, 0xaf, 0x02, 0xfa, 0x5f, 0x9a, 0xe1, 0xf7, 0x0a, 0x2a, 0x25, 0xb7,
-	0x8b, 0x6f, 0x1b, 0xd9, 0x01, 0x32, 0xb2, 0x66, 0x5c, 0xad, 0xcf, 0x59, 0x3d, 0xe1, 0xd7, 0x6b,
-	0x6f, 0xbf, 0xfd, 0xfc, 0xb0, 0xac, 0xe3, 0xaa, 0x99, 0x93, 0xe4, 0x64, 0xa2, 0xf1, 0x47, 0x05,
-	0x15, 0x61, 0xf0, 0x70, 0xeb, 0x42, 0x93, 0xec, 0x99, 0x57, 0xdb, 0x8b, 0x35, 0x01, 0xe0, 0x75,
-	0x09, 0xb8, 0x8b, 0x2b, 0x79, 0x80, 0x2e, 0x67, 0x9e, 0x2d, 0x22, 0xfc, 0x45, 0x41, 0xe5, 0xbc,
-	0x11, 0xc6, 0xfb, 0x17, 0x7a, 0xe7, 0xe7, 0x42, 0xbd, 0xbb, 0x78, 0x23, 0x80, 0xef, 0x49, 0x70,
-	0x13, 0xd7, 0xf3, 0xc0, 0x93, 0xeb, 0xaf, 0xc3, 0xdc, 0xd4, 0xe3, 0x50, 0xe1, 0x4f, 0x0a, 0xbc,
-	0x6e, 0xd2, 0xc9, 0xc0, 0xed, 0x45, 0x50, 0x92, 0xa0, 0xa9, 0x7b, 0x0b, 0x76, 0x01, 0x7d, 0x43,
-	0xd2, 0xdf, 0xc2, 0x37, 0xe6, 0xa5, 0xe7, 0xf8, 0xb3, 0x02, 0xaf, 0xdd, 0x94, 0xe8, 0x5f, 0xa6,
-	0x25, 0x3b, 0x92, 0x6a, 0x7b, 0xb1, 0x26, 0xc0, 0xbe, 0x27, 0xb1, 0xdb, 0xb8, 0x39, 0x2f, 0xb6,
-	0xf9, 0x1a, 0x92, 0xfe, 0xe6, 0xc1, 0xc1, 0xd7, 0x91, 0xa6, 0x9c, 0x8f, 0x34, 0xe5, 0xc7, 0x48,
-	0x53, 0xde, 0x8d, 0xb5, 0xa5, 0xf3, 0xb1, 0xb6, 0xf4, 0x7d, 0xac, 0x2d, 0x3d, 0x37, 0x1c, 0x57,
-	0xf4, 0xc3, 0xae, 0xd1, 0x63, 0x03, 0x73, 0x40, 0x84, 0xdb, 0xf3, 0xa9, 0x78, 0xc5, 0x86, 0x2f,
-	0x67, 0x26, 0x11, 0xd8, 0xc8, 0xa4, 0x77, 0x0b, 0xf2, 0x6f, 0xa8, 0xf5, 0x3b, 0x00, 0x00, 0xff,
-	0xff, 0xd8, 0x9c, 0xd9, 0xf3, 0x1e, 0x07, 0x00, 0x00,
+	// 957 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x8d, 0xed, 0x24, 0xaf, 0xa0, 0x26, 0x83, 0x53, 0xcc, 0x36, 0xb5, 0x9d, 0x05,
+	0x81, 0xf9, 0xe1, 0x5d, 0xe5, 0x17, 0x0d, 0x15, 0x07, 0x1a, 0x71, 0x88, 0x51, 0x05, 0x65, 0x8b,
+	0x38, 0xc0, 0xc1, 0x1a, 0x7b, 0xa6, 0xf6, 0xd2, 0xf5, 0x8e, 0xbb, 0x33, 0x76, 0x5d, 0x15, 0x84,
+	0xc4, 0x5f, 0x00, 0xe2, 0xcc, 0xdf, 0xc1, 0x01, 0x71, 0xe3, 0xd0, 0x0b, 0x52, 0x05, 0x17, 0xc4,
+	0xa1, 0x42, 0x09, 0xff, 0x07, 0x68, 0x67, 0x67, 0x1d, 0x6b, 0x3b, 0xeb, 0x78, 0xab, 0x9e, 0xbc,
+	0xb3, 0x7e, 0xef, 0xfb, 0x3e, 0xef, 0xcd, 0xf3, 0x7b, 0x32, 0x58, 0x7d, 0xea, 0x0d, 0x08, 0xf6,
+	0x7d, 0x47, 0xb0, 0xe1, 0x68, 0xe8, 0x8c, 0x77, 0x3a, 0x54, 0xe0, 0x1d, 0xe7, 0xde, 0x88, 0x86,
+	0x0f, 0xec, 0x61, 0xc8, 0x04, 0x43, 0x97, 0x13, 0x1b, 0x5b, 0xda, 0xd8, 0xca, 0xc6, 0xdc, 0xea,
+	0x31, 0xd6, 0xf3, 0xa9, 0x83, 0x87, 0x9e, 0x83, 0x83, 0x80, 0x09, 0x2c, 0x3c, 0x16, 0xf0, 0xd8,
+	0xcb, 0x2c, 0xf7, 0x58, 0x8f, 0xc9, 0x47, 0x27, 0x7a, 0x52, 0x6f, 0x5f, 0x9b, 0xc6, 0xeb, 0x60,
+	0x4e, 0xa7, 0xe1, 0x88, 0x37, 0xf6, 0x08, 0x0d, 0x44, 0x6c, 0x65, 0xdd, 0x84, 0xf2, 0xa7, 0x11,
+	0xc0, 0x6d, 0x7a, 0x6f, 0x44, 0x83, 0x2e, 0x75, 0xa3, 0x4f, 0x2e, 0xd0, 0xcb, 0xb0, 0x22, 0x26,
+	0xed, 0x3e, 0xe6, 0xfd, 0x8a, 0x51, 0x37, 0x1a, 0x6b, 0x6e, 0x49, 0x4c, 0x8e, 0x31, 0xef, 0xa3,
+	0x2b, 0xb0, 0xe6, 0xb3, 0x5e, 0xdb, 0x0b, 0x08, 0x9d, 0x54, 0x2e, 0xd4, 0x8d, 0x46, 0xc1, 0x5d,
+	0xf5, 0x59, 0xaf, 0x15, 0x9d, 0xad, 0x3d, 0xd8, 0x4c, 0xa9, 0xf1, 0x21, 0x0b, 0x38, 0x45, 0x26,
+	0xac, 0x72, 0xf5, 0x4e, 0xea, 0x15, 0xdc, 0xe9, 0xd9, 0xba, 0x0d, 0x57, 0xa4, 0x53, 0x8b, 0x7f,
+	0xe2, 0x93, 0xcf, 0x26, 0xcf, 0x87, 0xe4, 0x5d, 0xd8, 0xd2, 0x8b, 0x2a, 0xa0, 0xcb, 0x50, 0xe2,
+	0x02, 0x8b, 0x11, 0x97, 0xa2, 0xab, 0xae, 0x3a, 0x59, 0xdb, 0x50, 0x93, 0x7e, 0x1f, 0xc6, 0x65,
+	0x22, 0x37, 0xba, 0x5d, 0x36, 0x0a, 0x84, 0xcb, 0x98, 0x50, 0x40, 0xd6, 0xc7, 0x50, 0xcf, 0x36,
+	0x51, 0xf2, 0x6f, 0xc1, 0x06, 0x8e, 0x5f, 0xb7, 0x43, 0xc6, 0xc4, 0x2c, 0xfe, 0x25, 0x7c, 0x66,
+	0x1f, 0xe5, 0x61, 0x55, 0x61, 0x4b, 0xa7, 0xc7, 0x93, 0x78, 0x03, 0xb8, 0x9a, 0xf1, 0xbd, 0x0a,
+	0x76, 0x13, 0x36, 0xd4, 0xad, 0x92, 0xb6, 0x12, 0x8f, 0xd2, 0x5a, 0x6e, 0x5c, 0xdc, 0xad, 0xd9,
+	0x67, 0x1d, 0xf5, 0x60, 0x48, 0xb9, 0x9d, 0x86, 0x5e, 0x27, 0x29, 0x55, 0xeb, 0x9a, 0xba, 0x8e,
+	0xb4, 0xa5, 0xba, 0x8e, 0x0a, 0xac, 0x60, 0x42, 0x42, 0xca, 0xb9, 0xca, 0x27, 0x39, 0x5a, 0x5f,
+	0xe9, 0xf3, 0x98, 0x62, 0x7e, 0x04, 0xeb, 0x69, 0x4c, 0x29, 0xb1, 0x00, 0xe5, 0xa5, 0x14, 0xa5,
+	0xf5, 0x9f, 0x01, 0xe5, 0x94, 0xd1, 0xad, 0x90, 0xb1, 0x3b, 0xe8, 0xd6, 0x33, 0x07, 0x39, 0x2a,
+	0x3c, 0x7a, 0x52, 0x5b, 0x7a, 0x2a, 0x14, 0x2a, 0x43, 0x71, 0xb6, 0xc5, 0xe2, 0x03, 0x42, 0x50,
+	0xf0, 0x29, 0xbe, 0x53, 0x59, 0x96, 0x35, 0x90, 0xcf, 0xb2, 0xc9, 0xbd, 0x8e, 0xef, 0x05, 0x3d,
+	0x5e, 0x29, 0xd4, 0x97, 0x1b, 0x6b, 0xee, 0xf4, 0x8c, 0x5e, 0x85, 0x17, 0x93, 0x86, 0x18, 0x46,
+	0xa0, 0x95, 0xa2, 0x74, 0x7c, 0x01, 0xcf, 0xc2, 0x6b, 0xbb, 0xa6, 0xa4, 0xef, 0x9a, 0xf7, 0xf5,
+	0x5d, 0x28, 0x85, 0xce, 0xbf, 0xab, 0x01, 0x6c, 0xcf, 0xf1, 0x56, 0x17, 0x76, 0x0c, 0xc5, 0x98,
+	0x35, 0x2e, 0xe0, 0x3b, 0xb6, 0x7e, 0x3a, 0xd9, 0x3a, 0x11, 0x55, 0xcd, 0x58, 0xc0, 0xe2, 0xb0,
+	0xfe, 0x39, 0xf6, 0x3d, 0x82, 0x05, 0x0b, 0x5d, 0x7a, 0x1f, 0x87, 0x84, 0xa3, 0x4d, 0x28, 0x8d,
+	0xb1, 0xdf, 0xf6, 0x88, 0x1a, 0x08, 0xc5, 0x31, 0xf6, 0x5b, 0x04, 0x1d, 0xc3, 0x4a, 0x18, 0x5b,
+	0xc8, 0x82, 0xaf, 0x1d, 0xd9, 0x91, 0xd0, 0xdf, 0x4f, 0x6a, 0xaf, 0xf7, 0x3c, 0xd1, 0x1f, 0x75,
+	0xec, 0x2e, 0x1b, 0x38, 0x5d, 0xc6, 0x07, 0x8c, 0xab, 0x8f, 0x26, 0x27, 0x77, 0x9d, 0xf8, 0x4a,
+	0x5b, 0x81, 0x70, 0x13, 0x77, 0xeb, 0x40, 0xf5, 0x63, 0x3a, 0x72, 0x52, 0x1d, 0x3d, 0x80, 0xf5,
+	0x35, 0x5c, 0xcd, 0x70, 0x53, 0x65, 0xf9, 0x12, 0x36, 0xc6, 0xc9, 0x77, 0xed, 0x84, 0x35, 0x2e,
+	0x51, 0x23, 0xab, 0x44, 0x69, 0x31, 0x55, 0x9e, 0xf5, 0x71, 0xea, 0xfd, 0x74, 0xfe, 0xdc, 0xf0,
+	0xfd, 0x0c, 0x6e, 0xeb, 0x5b, 0xa8, 0x67, 0x9b, 0xcc, 0x67, 0x5c, 0x7e, 0x1e, 0x8c, 0xbb, 0xbf,
+	0x5f, 0x84, 0xa2, 0x24, 0x40, 0x3f, 0x18, 0xb0, 0x9a, 0x8c, 0x56, 0x94, 0xd9, 0x1f, 0xba, 0x05,
+	0x63, 0x36, 0x17, 0xb4, 0x8e, 0x13, 0xb2, 0x1a, 0xdf, 0xfd, 0xf9, 0xef, 0x8f, 0x17, 0x2c, 0x54,
+	0x77, 0x32, 0xd6, 0x68, 0xb2, 0x4e, 0xd0, 0x4f, 0x06, 0xac, 0xa8, 0xa9, 0x8f, 0xf6, 0xe6, 0x06,
+	0xd1, 0x2f, 0x1c, 0x73, 0x3f, 0x9f, 0x93, 0x02, 0x7c, 0x43, 0x02, 0x6e, 0xa3, 0x5a, 0x16, 0xa0,
+	0xc7, 0x99, 0x4f, 0xc4, 0x04, 0xfd, 0x66, 0x40, 0x25, 0x6b, 0x7f, 0xa0, 0x6b, 0x73, 0x63, 0x67,
+	0x2f, 0x25, 0xf3, 0x30, 0xbf, 0xa3, 0x02, 0x3f, 0x90, 0xe0, 0x0e, 0x6a, 0x66, 0x81, 0x27, 0x03,
+	0xb1, 0xa9, 0x46, 0x50, 0x33, 0x9a, 0x4d, 0xe8, 0x67, 0x03, 0x36, 0x75, 0xda, 0x1c, 0xed, 0xe7,
+	0x41, 0x49, 0xba, 0xda, 0x3c, 0xc8, 0xe9, 0xa5, 0xe8, 0x77, 0x24, 0xfd, 0xdb, 0xe8, 0xcd, 0x45,
+	0xe9, 0x39, 0xfa, 0xd5, 0x80, 0xb2, 0x4e, 0xf4, 0x9c, 0x6e, 0xd1, 0xef, 0x43, 0x73, 0x3f, 0x9f,
+	0x93, 0xc2, 0xbe, 0x2e, 0xb1, 0xf7, 0xd1, 0xee, 0xa2, 0xd8, 0xce, 0x43, 0x35, 0xba, 0xbf, 0x41,
+	0x7f, 0x18, 0xf0, 0x4a, 0xe6, 0xf0, 0x46, 0xb9, 0x1a, 0x61, 0x76, 0x5b, 0x98, 0xef, 0x3d, 0x83,
+	0xa7, 0x4a, 0xe7, 0x03, 0x99, 0xce, 0x75, 0x74, 0xb8, 0x70, 0x0f, 0xc9, 0xbd, 0x30, 0x93, 0xd4,
+	0x2f, 0x86, 0x66, 0x45, 0xcc, 0xaf, 0x6d, 0xc6, 0x7c, 0x34, 0x0f, 0x72, 0x7a, 0x2d, 0x7a, 0x25,
+	0xd3, 0x39, 0xd8, 0x54, 0x03, 0xd5, 0x79, 0x18, 0xef, 0x0f, 0x49, 0xff, 0x92, 0x66, 0x1c, 0x9f,
+	0xf3, 0x73, 0xce, 0x9e, 0xf1, 0xe6, 0x61, 0x7e, 0xc7, 0x45, 0x7f, 0x10, 0x4f, 0xa5, 0x71, 0x74,
+	0xfc, 0xe8, 0xa4, 0x6a, 0x3c, 0x3e, 0xa9, 0x1a, 0xff, 0x9c, 0x54, 0x8d, 0xef, 0x4f, 0xab, 0x4b,
+	0x8f, 0x4f, 0xab, 0x4b, 0x7f, 0x9d, 0x56, 0x97, 0xbe, 0xb0, 0x67, 0x76, 0xee, 0x00, 0x0b, 0xaf,
+	0x1b, 0x50, 0x71, 0x9f, 0x85, 0x77, 0xcf, 0xb4, 0x27, 0x4a, 0x5d, 0xee, 0xdf, 0x4e, 0x49, 0xfe,
+	0xa9, 0xd8, 0xfb, 0x7f, 0x00, 0x8e, 0x9a, 0x0c, 0xb6, 0xec, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -557,6 +981,13 @@ type QueryClient interface {
 	//
 	QueryDividendAccounts(ctx context.Context, in *QueryDividendAccountsRequest, opts ...grpc.CallOption) (*QueryDividendAccountsResponse, error)
 	QueryDividendAccount(ctx context.Context, in *QueryDividendAccountRequest, opts ...grpc.CallOption) (*QueryDividendAccountResponse, error)
+	// QueryDividendAccountProof will get the merkle proof of a dividend
+	// account against the dividend account root hash
+	QueryDividendAccountProof(ctx context.Context, in *QueryDividendAccountProofRequest, opts ...grpc.CallOption) (*QueryDividendAccountProofResponse, error)
+	// ValidatorRewards queries the fee rewards accrued by a validator
+	ValidatorRewards(ctx context.Context, in *QueryValidatorRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorRewardsResponse, error)
+	// AllValidatorRewards queries the fee rewards accrued by all validators
+	AllValidatorRewards(ctx context.Context, in *QueryAllValidatorRewardsRequest, opts ...grpc.CallOption) (*QueryAllValidatorRewardsResponse, error)
 }
 
 type queryClient struct {
@@ -612,6 +1043,33 @@ func (c *queryClient) QueryDividendAccount(ctx context.Context, in *QueryDividen
 	return out, nil
 }
 
+func (c *queryClient) QueryDividendAccountProof(ctx context.Context, in *QueryDividendAccountProofRequest, opts ...grpc.CallOption) (*QueryDividendAccountProofResponse, error) {
+	out := new(QueryDividendAccountProofResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.topup.v1beta1.Query/QueryDividendAccountProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorRewards(ctx context.Context, in *QueryValidatorRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorRewardsResponse, error) {
+	out := new(QueryValidatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.topup.v1beta1.Query/ValidatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllValidatorRewards(ctx context.Context, in *QueryAllValidatorRewardsRequest, opts ...grpc.CallOption) (*QueryAllValidatorRewardsResponse, error) {
+	out := new(QueryAllValidatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.topup.v1beta1.Query/AllValidatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Sequence query sequence no
@@ -623,6 +1081,13 @@ type QueryServer interface {
 	//
 	QueryDividendAccounts(context.Context, *QueryDividendAccountsRequest) (*QueryDividendAccountsResponse, error)
 	QueryDividendAccount(context.Context, *QueryDividendAccountRequest) (*QueryDividendAccountResponse, error)
+	// QueryDividendAccountProof will get the merkle proof of a dividend
+	// account against the dividend account root hash
+	QueryDividendAccountProof(context.Context, *QueryDividendAccountProofRequest) (*QueryDividendAccountProofResponse, error)
+	// ValidatorRewards queries the fee rewards accrued by a validator
+	ValidatorRewards(context.Context, *QueryValidatorRewardsRequest) (*QueryValidatorRewardsResponse, error)
+	// AllValidatorRewards queries the fee rewards accrued by all validators
+	AllValidatorRewards(context.Context, *QueryAllValidatorRewardsRequest) (*QueryAllValidatorRewardsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -644,6 +1109,15 @@ func (*UnimplementedQueryServer) QueryDividendAccounts(ctx context.Context, req
 func (*UnimplementedQueryServer) QueryDividendAccount(ctx context.Context, req *QueryDividendAccountRequest) (*QueryDividendAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryDividendAccount not implemented")
 }
+func (*UnimplementedQueryServer) QueryDividendAccountProof(ctx context.Context, req *QueryDividendAccountProofRequest) (*QueryDividendAccountProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDividendAccountProof not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorRewards(ctx context.Context, req *QueryValidatorRewardsRequest) (*QueryValidatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRewards not implemented")
+}
+func (*UnimplementedQueryServer) AllValidatorRewards(ctx context.Context, req *QueryAllValidatorRewardsRequest) (*QueryAllValidatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllValidatorRewards not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -739,15 +1213,69 @@ func _Query_QueryDividendAccount_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "heimdall.topup.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Sequence",
-			Handler:    _Query_Sequence_Handler,
-		},
-		{
+func _Query_QueryDividendAccountProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDividendAccountProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDividendAccountProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.topup.v1beta1.Query/QueryDividendAccountProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDividendAccountProof(ctx, req.(*QueryDividendAccountProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.topup.v1beta1.Query/ValidatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRewards(ctx, req.(*QueryValidatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.topup.v1beta1.Query/AllValidatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllValidatorRewards(ctx, req.(*QueryAllValidatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdall.topup.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Sequence",
+			Handler:    _Query_Sequence_Handler,
+		},
+		{
 			MethodName: "IsOldTx",
 			Handler:    _Query_IsOldTx_Handler,
 		},
@@ -763,6 +1291,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryDividendAccount",
 			Handler:    _Query_QueryDividendAccount_Handler,
 		},
+		{
+			MethodName: "QueryDividendAccountProof",
+			Handler:    _Query_QueryDividendAccountProof_Handler,
+		},
+		{
+			MethodName: "ValidatorRewards",
+			Handler:    _Query_ValidatorRewards_Handler,
+		},
+		{
+			MethodName: "AllValidatorRewards",
+			Handler:    _Query_AllValidatorRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdall/topup/v1beta1/query.proto",
@@ -1077,152 +1617,1103 @@ func (m *QueryDividendAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DividendAccountProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QuerySequenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.LogIndex != 0 {
-		n += 1 + sovQuery(uint64(m.LogIndex))
-	}
-	return n
+
+func (m *DividendAccountProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySequenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DividendAccountProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	if len(m.AccountRootHash) > 0 {
+		i -= len(m.AccountRootHash)
+		copy(dAtA[i:], m.AccountRootHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountRootHash)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-
-func (m *QueryIsOldTxSequenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.AccountProof) > 0 {
+		i -= len(m.AccountProof)
+		copy(dAtA[i:], m.AccountProof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountProof)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Siblings) > 0 {
+		for iNdEx := len(m.Siblings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Siblings[iNdEx])
+			copy(dAtA[i:], m.Siblings[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Siblings[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.LogIndex != 0 {
-		n += 1 + sovQuery(uint64(m.LogIndex))
+	if len(m.Leaf) > 0 {
+		i -= len(m.Leaf)
+		copy(dAtA[i:], m.Leaf)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Leaf)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.DividendAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIsOldTxSequenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDividendAccountProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDividendAccountProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDividendAccountProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status {
-		n += 2
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDividendAccountRootRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDividendAccountProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDividendAccountRootResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDividendAccountProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDividendAccountProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.AccountRootHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDividendAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDividendAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.DividendAccounts) > 0 {
-		for _, e := range m.DividendAccounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Rewards.Size()
+		i -= size
+		if _, err := m.Rewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if m.ValId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDividendAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ValId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDividendAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DividendAccount != nil {
-		l = m.DividendAccount.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryValidatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryValidatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozQuery(x uint64) (n int) {
+
+func (m *QueryValidatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ValidatorRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllValidatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllValidatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllValidatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllValidatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllValidatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllValidatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorRewards) > 0 {
+		for iNdEx := len(m.ValidatorRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySequenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovQuery(uint64(m.LogIndex))
+	}
+	return n
+}
+
+func (m *QuerySequenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryIsOldTxSequenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovQuery(uint64(m.LogIndex))
+	}
+	return n
+}
+
+func (m *QueryIsOldTxSequenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDividendAccountRootRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDividendAccountRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountRootHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDividendAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDividendAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DividendAccounts) > 0 {
+		for _, e := range m.DividendAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDividendAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDividendAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DividendAccount != nil {
+		l = m.DividendAccount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DividendAccountProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DividendAccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	l = len(m.Leaf)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Siblings) > 0 {
+		for _, s := range m.Siblings {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.AccountProof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AccountRootHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDividendAccountProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDividendAccountProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValId != 0 {
+		n += 1 + sovQuery(uint64(m.ValId))
+	}
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValId != 0 {
+		n += 1 + sovQuery(uint64(m.ValId))
+	}
+	return n
+}
+
+func (m *QueryValidatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValidatorRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllValidatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllValidatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorRewards) > 0 {
+		for _, e := range m.ValidatorRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QuerySequenceRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySequenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySequenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySequenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsOldTxSequenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsOldTxSequenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsOldTxSequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsOldTxSequenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsOldTxSequenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsOldTxSequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDividendAccountRootRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDividendAccountRootRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDividendAccountRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDividendAccountRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDividendAccountRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDividendAccountRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRootHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountRootHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDividendAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDividendAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDividendAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDividendAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1245,15 +2736,102 @@ func (m *QuerySequenceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySequenceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDividendAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDividendAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DividendAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DividendAccounts = append(m.DividendAccounts, &types.DividendAccount{})
+			if err := m.DividendAccounts[len(m.DividendAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDividendAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDividendAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDividendAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1281,13 +2859,66 @@ func (m *QuerySequenceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LogIndex = 0
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDividendAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDividendAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDividendAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DividendAccount", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1297,11 +2928,28 @@ func (m *QuerySequenceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogIndex |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DividendAccount == nil {
+				m.DividendAccount = &types.DividendAccount{}
+			}
+			if err := m.DividendAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1326,7 +2974,7 @@ func (m *QuerySequenceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
+func (m *DividendAccountProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1349,17 +2997,69 @@ func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySequenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DividendAccountProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DividendAccountProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DividendAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DividendAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Sequence = 0
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1369,67 +3069,59 @@ func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryIsOldTxSequenceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Leaf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsOldTxSequenceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsOldTxSequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Siblings = append(m.Siblings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountProof", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1457,13 +3149,13 @@ func (m *QueryIsOldTxSequenceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.AccountProof = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRootHash", wireType)
 			}
-			m.LogIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1473,11 +3165,24 @@ func (m *QueryIsOldTxSequenceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountRootHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1502,7 +3207,7 @@ func (m *QueryIsOldTxSequenceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsOldTxSequenceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDividendAccountProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1525,17 +3230,17 @@ func (m *QueryIsOldTxSequenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsOldTxSequenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDividendAccountProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsOldTxSequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDividendAccountProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1545,12 +3250,24 @@ func (m *QueryIsOldTxSequenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1575,7 +3292,7 @@ func (m *QueryIsOldTxSequenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDividendAccountRootRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDividendAccountProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1598,12 +3315,45 @@ func (m *QueryDividendAccountRootRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDividendAccountRootRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDividendAccountProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDividendAccountRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDividendAccountProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1628,7 +3378,7 @@ func (m *QueryDividendAccountRootRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDividendAccountRootResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1651,15 +3401,34 @@ func (m *QueryDividendAccountRootResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDividendAccountRootResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDividendAccountRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValId", wireType)
+			}
+			m.ValId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountRootHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1687,7 +3456,9 @@ func (m *QueryDividendAccountRootResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountRootHash = string(dAtA[iNdEx:postIndex])
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1713,7 +3484,7 @@ func (m *QueryDividendAccountRootResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDividendAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1736,12 +3507,31 @@ func (m *QueryDividendAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDividendAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDividendAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValId", wireType)
+			}
+			m.ValId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1766,7 +3556,7 @@ func (m *QueryDividendAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDividendAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1789,15 +3579,15 @@ func (m *QueryDividendAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDividendAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDividendAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DividendAccounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1824,8 +3614,7 @@ func (m *QueryDividendAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DividendAccounts = append(m.DividendAccounts, &types.DividendAccount{})
-			if err := m.DividendAccounts[len(m.DividendAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1853,7 +3642,7 @@ func (m *QueryDividendAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDividendAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllValidatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1876,44 +3665,12 @@ func (m *QueryDividendAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDividendAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllValidatorRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDividendAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllValidatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1938,7 +3695,7 @@ func (m *QueryDividendAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDividendAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllValidatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1961,15 +3718,15 @@ func (m *QueryDividendAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDividendAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllValidatorRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDividendAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllValidatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DividendAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1996,10 +3753,8 @@ func (m *QueryDividendAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DividendAccount == nil {
-				m.DividendAccount = &types.DividendAccount{}
-			}
-			if err := m.DividendAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorRewards = append(m.ValidatorRewards, ValidatorRewards{})
+			if err := m.ValidatorRewards[len(m.ValidatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
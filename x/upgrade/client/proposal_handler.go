@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/maticnetwork/heimdall/x/gov/client"
+	"github.com/maticnetwork/heimdall/x/upgrade/client/cli"
+	"github.com/maticnetwork/heimdall/x/upgrade/client/rest"
+)
+
+// ProposalHandler is the software upgrade proposal handler.
+var ProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitUpgradeProposal, rest.ProposalRESTHandler)
+
+// CancelProposalHandler is the cancel software upgrade proposal handler.
+var CancelProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitCancelUpgradeProposal, rest.ProposalCancelRESTHandler)
@@ -0,0 +1,42 @@
+package upgrade
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	govtypes "github.com/maticnetwork/heimdall/x/gov/types"
+)
+
+// NewSoftwareUpgradeProposalHandler creates a governance handler to manage new proposal types.
+// It enables SoftwareUpgradeProposal to propose an Upgrade, and CancelSoftwareUpgradeProposal
+// to abort a previously voted upgrade.
+func NewSoftwareUpgradeProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SoftwareUpgradeProposal:
+			return handleSoftwareUpgradeProposal(ctx, k, c)
+
+		case *types.CancelSoftwareUpgradeProposal:
+			return handleCancelSoftwareUpgradeProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized software upgrade proposal content type: %T", c)
+		}
+	}
+}
+
+func handleSoftwareUpgradeProposal(ctx sdk.Context, k keeper.Keeper, p *types.SoftwareUpgradeProposal) error {
+	// all validators have to halt at the same block, so only height based plans are accepted
+	if !p.Plan.Time.IsZero() || p.Plan.Height <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "upgrade plan must be scheduled at a height")
+	}
+
+	return k.ScheduleUpgrade(ctx, p.Plan)
+}
+
+func handleCancelSoftwareUpgradeProposal(ctx sdk.Context, k keeper.Keeper, _ *types.CancelSoftwareUpgradeProposal) error {
+	k.ClearUpgradePlan(ctx)
+	return nil
+}
@@ -0,0 +1,120 @@
+package upgrade_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmosupgrade "github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	govtypes "github.com/maticnetwork/heimdall/x/gov/types"
+	"github.com/maticnetwork/heimdall/x/upgrade"
+)
+
+// HandlerTestSuite integrate test suite context object
+type HandlerTestSuite struct {
+	suite.Suite
+
+	app        *app.HeimdallApp
+	ctx        sdk.Context
+	keeper     upgradekeeper.Keeper
+	govHandler govtypes.Handler
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	// use a keeper with a temporary home so that halting does not write the
+	// upgrade info into the default node home
+	suite.keeper = upgradekeeper.NewKeeper(
+		map[int64]bool{},
+		suite.app.GetKey(types.StoreKey),
+		suite.app.AppCodec(),
+		suite.T().TempDir(),
+	)
+	suite.govHandler = upgrade.NewSoftwareUpgradeProposalHandler(suite.keeper)
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) TestScheduleUpgrade() {
+	t, ctx := suite.T(), suite.ctx
+
+	content := types.NewSoftwareUpgradeProposal("title", "description", types.Plan{Name: "v2", Height: 20})
+	require.True(t, govtypes.IsValidProposalType(content.ProposalType()))
+	require.NoError(t, suite.govHandler(ctx, content))
+
+	plan, ok := suite.keeper.GetUpgradePlan(ctx)
+	require.True(t, ok)
+	require.Equal(t, "v2", plan.Name)
+	require.Equal(t, int64(20), plan.Height)
+
+	// a plan in the past is rejected
+	content = types.NewSoftwareUpgradeProposal("title", "description", types.Plan{Name: "v3", Height: 5})
+	require.Error(t, suite.govHandler(ctx, content))
+
+	// time based plans are rejected
+	content = types.NewSoftwareUpgradeProposal("title", "description", types.Plan{Name: "v3", Time: time.Now().Add(time.Hour)})
+	require.Error(t, suite.govHandler(ctx, content))
+
+	// cancel clears the plan
+	require.NoError(t, suite.govHandler(ctx, types.NewCancelSoftwareUpgradeProposal("title", "description")))
+	_, ok = suite.keeper.GetUpgradePlan(ctx)
+	require.False(t, ok)
+}
+
+func (suite *HandlerTestSuite) TestHaltAtPlanHeight() {
+	t, ctx := suite.T(), suite.ctx
+
+	content := types.NewSoftwareUpgradeProposal("title", "description", types.Plan{Name: "v2", Height: 20, Info: "info"})
+	require.NoError(t, suite.govHandler(ctx, content))
+
+	// nothing happens before the planned height
+	require.NotPanics(t, func() {
+		cosmosupgrade.BeginBlocker(suite.keeper, ctx.WithBlockHeight(19), abci.RequestBeginBlock{})
+	})
+
+	// halt at the planned height when there is no handler for the upgrade
+	require.PanicsWithValue(t, `UPGRADE "v2" NEEDED at height: 20: info`, func() {
+		cosmosupgrade.BeginBlocker(suite.keeper, ctx.WithBlockHeight(20), abci.RequestBeginBlock{})
+	})
+
+	upgradeInfo, err := suite.keeper.ReadUpgradeInfoFromDisk()
+	require.NoError(t, err)
+	require.Equal(t, "v2", upgradeInfo.Name)
+	require.Equal(t, int64(20), upgradeInfo.Height)
+}
+
+func (suite *HandlerTestSuite) TestApplyUpgrade() {
+	t, ctx := suite.T(), suite.ctx
+
+	content := types.NewSoftwareUpgradeProposal("title", "description", types.Plan{Name: "v2", Height: 20})
+	require.NoError(t, suite.govHandler(ctx, content))
+
+	called := false
+	suite.keeper.SetUpgradeHandler("v2", func(ctx sdk.Context, plan types.Plan) {
+		called = true
+	})
+
+	// a binary with the handler must not run before the planned height
+	require.Panics(t, func() {
+		cosmosupgrade.BeginBlocker(suite.keeper, ctx.WithBlockHeight(19), abci.RequestBeginBlock{})
+	})
+
+	cosmosupgrade.BeginBlocker(suite.keeper, ctx.WithBlockHeight(20), abci.RequestBeginBlock{})
+	require.True(t, called)
+	require.Equal(t, int64(20), suite.keeper.GetDoneHeight(ctx, "v2"))
+
+	_, ok := suite.keeper.GetUpgradePlan(ctx)
+	require.False(t, ok)
+}